@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/cmd/autocomplete"
 	"github.com/google/uuid"
@@ -18,6 +19,14 @@ import (
 type ServerApi struct {
 	Url string `json:"url"`
 	Key string `json:"key"`
+	// Set for short-lived keys from 'daytona login'
+	KeyExpiresAt *time.Time `json:"keyExpiresAt,omitempty"`
+	RefreshToken string     `json:"refreshToken,omitempty"`
+}
+
+// Keys from 'daytona login' are refreshed a minute before they expire
+func (a ServerApi) IsKeyExpiring() bool {
+	return a.RefreshToken != "" && a.KeyExpiresAt != nil && time.Until(*a.KeyExpiresAt) < time.Minute
 }
 
 type Profile struct {
@@ -22,9 +22,15 @@ func (m *mockSshServer) Start() error {
 	return args.Error(0)
 }
 
+func (m *mockSshServer) ActiveSessions() uint32 {
+	args := m.Called()
+	return args.Get(0).(uint32)
+}
+
 func NewMockSshServer() *mockSshServer {
 	mockSshServer := new(mockSshServer)
 	mockSshServer.On("Start").Return(SshServerStartError)
+	mockSshServer.On("ActiveSessions").Return(uint32(0)).Maybe()
 
 	return mockSshServer
 }
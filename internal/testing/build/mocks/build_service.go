@@ -38,6 +38,11 @@ func (m *MockBuildService) List(filter *build.Filter) ([]*build.Build, error) {
 	return args.Get(0).([]*build.Build), args.Error(1)
 }
 
+func (m *MockBuildService) Cancel(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockBuildService) Delete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -7,17 +7,22 @@ package build
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/daytonaio/daytona/pkg/build"
 )
 
 type InMemoryBuildStore struct {
 	builds map[string]*build.Build
+	// States of the builds when they were last saved. Stored builds are shared with the callers
+	// so their state might have been changed without saving them.
+	savedStates map[string]build.BuildState
 }
 
 func NewInMemoryBuildStore() build.Store {
 	return &InMemoryBuildStore{
-		builds: make(map[string]*build.Build),
+		builds:      make(map[string]*build.Build),
+		savedStates: make(map[string]build.BuildState),
 	}
 }
 
@@ -44,11 +49,35 @@ func (s *InMemoryBuildStore) List(filter *build.Filter) ([]*build.Build, error)
 
 func (s *InMemoryBuildStore) Save(result *build.Build) error {
 	s.builds[result.Id] = result
+	s.savedStates[result.Id] = result.State
 	return nil
 }
 
+func (s *InMemoryBuildStore) CompareAndSave(result *build.Build, expectedStates ...build.BuildState) error {
+	state, ok := s.savedStates[result.Id]
+	if !ok {
+		return build.ErrBuildNotFound
+	}
+
+	if !slices.Contains(expectedStates, state) {
+		return build.ErrBuildStateChanged
+	}
+
+	return s.Save(result)
+}
+
+func (s *InMemoryBuildStore) FindSbom(buildId string) (*build.BuildSbom, error) {
+	b, ok := s.builds[buildId]
+	if !ok || b.Artifact == nil || b.Artifact.Sbom == nil || b.Artifact.Sbom.Document == "" {
+		return nil, build.ErrSbomNotFound
+	}
+
+	return b.Artifact.Sbom, nil
+}
+
 func (s *InMemoryBuildStore) Delete(id string) error {
 	delete(s.builds, id)
+	delete(s.savedStates, id)
 	return nil
 }
 
@@ -52,6 +52,11 @@ func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image
 	return args.Get(0).(io.ReadCloser), args.Error(1)
 }
 
+func (m *MockApiClient) ImageRemove(ctx context.Context, imageID string, options image.RemoveOptions) ([]image.DeleteResponse, error) {
+	args := m.Called(ctx, imageID, options)
+	return args.Get(0).([]image.DeleteResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerLogs(ctx context.Context, container string, options container.LogsOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, container, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -67,6 +72,11 @@ func (m *MockApiClient) ContainerRemove(ctx context.Context, container string, o
 	return args.Error(0)
 }
 
+func (m *MockApiClient) ContainerRename(ctx context.Context, container string, newContainerName string) error {
+	args := m.Called(ctx, container, newContainerName)
+	return args.Error(0)
+}
+
 func (m *MockApiClient) ContainerStart(ctx context.Context, container string, startOptions container.StartOptions) error {
 	args := m.Called(ctx, container, startOptions)
 	return args.Error(0)
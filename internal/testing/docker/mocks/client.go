@@ -79,3 +79,28 @@ func (c *MockClient) GetContainerLogs(containerName string, logWriter io.Writer)
 	args := c.Called(containerName, logWriter)
 	return args.Error(0)
 }
+
+func (c *MockClient) SnapshotProject(p *project.Project, snapshotName string, logWriter io.Writer) error {
+	args := c.Called(p, snapshotName, logWriter)
+	return args.Error(0)
+}
+
+func (c *MockClient) RestoreProject(p *project.Project, snapshotName string, logWriter io.Writer) error {
+	args := c.Called(p, snapshotName, logWriter)
+	return args.Error(0)
+}
+
+func (c *MockClient) CloneProject(source *project.Project, p *project.Project, snapshotName string, logWriter io.Writer) error {
+	args := c.Called(source, p, snapshotName, logWriter)
+	return args.Error(0)
+}
+
+func (c *MockClient) DeleteProjectSnapshot(p *project.Project, snapshotName string) error {
+	args := c.Called(p, snapshotName)
+	return args.Error(0)
+}
+
+func (c *MockClient) ListProjectSnapshots(p *project.Project) ([]string, error) {
+	args := c.Called(p)
+	return args.Get(0).([]string), args.Error(1)
+}
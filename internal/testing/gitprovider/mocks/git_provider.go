@@ -81,8 +81,8 @@ func (m *MockGitProvider) GetDefaultBranch(staticContext *gitprovider.StaticGitC
 	return args.Get(0).(*string), args.Error(1)
 }
 
-func (m *MockGitProvider) RegisterPrebuildWebhook(repo *gitprovider.GitRepository, endpointUrl string) (string, error) {
-	args := m.Called(repo, endpointUrl)
+func (m *MockGitProvider) RegisterPrebuildWebhook(repo *gitprovider.GitRepository, endpointUrl string, secret string) (string, error) {
+	args := m.Called(repo, endpointUrl, secret)
 	return args.String(0), args.Error(1)
 }
 
@@ -91,6 +91,11 @@ func (m *MockGitProvider) GetPrebuildWebhook(repo *gitprovider.GitRepository, en
 	return args.Get(0).(*string), args.Error(1)
 }
 
+func (m *MockGitProvider) UpdatePrebuildWebhook(repo *gitprovider.GitRepository, id string, endpointUrl string, secret string) error {
+	args := m.Called(repo, id, endpointUrl, secret)
+	return args.Error(0)
+}
+
 func (m *MockGitProvider) UnregisterPrebuildWebhook(repo *gitprovider.GitRepository, id string) error {
 	args := m.Called(repo, id)
 	return args.Error(0)
@@ -101,7 +106,22 @@ func (m *MockGitProvider) GetCommitsRange(repo *gitprovider.GitRepository, initi
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	args := m.Called(request, secret)
+	return args.Error(0)
+}
+
 func (m *MockGitProvider) ParseEventData(request *http.Request) (*gitprovider.GitEventData, error) {
 	args := m.Called(request)
 	return args.Get(0).(*gitprovider.GitEventData), args.Error(1)
 }
+
+func (m *MockGitProvider) SupportsCommitStatus() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockGitProvider) SetCommitStatus(repo *gitprovider.GitRepository, sha string, state gitprovider.CommitStatus, description, targetUrl string) error {
+	args := m.Called(repo, sha, state, description, targetUrl)
+	return args.Error(0)
+}
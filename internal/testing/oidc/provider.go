@@ -0,0 +1,205 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+const keyId = "mock"
+
+// MockOidcProvider is an OIDC provider that supports the device authorization and refresh token grants.
+// Device logins stay pending until they are approved or denied.
+type MockOidcProvider struct {
+	ClientId string
+	// Claims added to the ID tokens next to the registered claims
+	Claims map[string]interface{}
+
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	logins    map[string]string
+	refreshes map[string]bool
+	counter   int
+	mutex     sync.Mutex
+}
+
+func NewMockOidcProvider(clientId string, claims map[string]interface{}) (*MockOidcProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &MockOidcProvider{
+		ClientId:  clientId,
+		Claims:    claims,
+		key:       key,
+		logins:    make(map[string]string),
+		refreshes: make(map[string]bool),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/keys", p.handleKeys)
+	mux.HandleFunc("/device", p.handleDevice)
+	mux.HandleFunc("/token", p.handleToken)
+
+	p.server = httptest.NewServer(mux)
+	return p, nil
+}
+
+func (p *MockOidcProvider) Issuer() string {
+	return p.server.URL
+}
+
+func (p *MockOidcProvider) Close() {
+	p.server.Close()
+}
+
+func (p *MockOidcProvider) Approve(deviceCode string) {
+	p.setLogin(deviceCode, "approved")
+}
+
+func (p *MockOidcProvider) Deny(deviceCode string) {
+	p.setLogin(deviceCode, "access_denied")
+}
+
+func (p *MockOidcProvider) setLogin(deviceCode, state string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.logins[deviceCode] = state
+}
+
+func (p *MockOidcProvider) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.Issuer(),
+		"authorization_endpoint":                p.Issuer() + "/authorize",
+		"device_authorization_endpoint":         p.Issuer() + "/device",
+		"token_endpoint":                        p.Issuer() + "/token",
+		"jwks_uri":                              p.Issuer() + "/keys",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *MockOidcProvider) handleKeys(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: &p.key.PublicKey, KeyID: keyId, Algorithm: string(jose.RS256), Use: "sig"}},
+	})
+}
+
+func (p *MockOidcProvider) handleDevice(w http.ResponseWriter, r *http.Request) {
+	p.mutex.Lock()
+	p.counter++
+	deviceCode := fmt.Sprintf("device-code-%d", p.counter)
+	p.logins[deviceCode] = "authorization_pending"
+	p.mutex.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"device_code":      deviceCode,
+		"user_code":        "ABCD-EFGH",
+		"verification_uri": p.Issuer() + "/activate",
+		"expires_in":       600,
+		"interval":         1,
+	})
+}
+
+func (p *MockOidcProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	err := r.ParseForm()
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	switch r.Form.Get("grant_type") {
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		state, ok := p.logins[r.Form.Get("device_code")]
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "expired_token"})
+			return
+		}
+		if state != "approved" {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": state})
+			return
+		}
+		delete(p.logins, r.Form.Get("device_code"))
+	case "refresh_token":
+		if !p.refreshes[r.Form.Get("refresh_token")] {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		delete(p.refreshes, r.Form.Get("refresh_token"))
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	idToken, err := p.signIdToken()
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	p.counter++
+	refreshToken := fmt.Sprintf("refresh-token-%d", p.counter)
+	p.refreshes[refreshToken] = true
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  "access-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"refresh_token": refreshToken,
+		"id_token":      idToken,
+	})
+}
+
+func (p *MockOidcProvider) signIdToken() (string, error) {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: p.key}, (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", keyId))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss": p.Issuer(),
+		"aud": p.ClientId,
+		"sub": "mock-subject",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range p.Claims {
+		claims[k] = v
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := signer.Sign(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return signature.CompactSerialize()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
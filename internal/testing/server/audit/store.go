@@ -0,0 +1,65 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type InMemoryAuditStore struct {
+	auditLogs []*audit.AuditLog
+}
+
+func NewInMemoryAuditStore() audit.Store {
+	return &InMemoryAuditStore{
+		auditLogs: []*audit.AuditLog{},
+	}
+}
+
+func (s *InMemoryAuditStore) List(filter *audit.Filter) ([]*audit.AuditLog, error) {
+	auditLogs := []*audit.AuditLog{}
+
+	for _, a := range s.auditLogs {
+		if filter != nil {
+			if filter.ApiKeyName != nil && a.ApiKeyName != *filter.ApiKeyName {
+				continue
+			}
+			if filter.Method != nil && a.Method != *filter.Method {
+				continue
+			}
+			if filter.ResourceId != nil && a.ResourceId != *filter.ResourceId {
+				continue
+			}
+			if filter.Outcome != nil && a.Outcome != *filter.Outcome {
+				continue
+			}
+			if filter.Since != nil && a.CreatedAt.Before(*filter.Since) {
+				continue
+			}
+			if filter.Until != nil && a.CreatedAt.After(*filter.Until) {
+				continue
+			}
+		}
+		auditLogs = append(auditLogs, a)
+	}
+
+	sort.SliceStable(auditLogs, func(i, j int) bool {
+		return auditLogs[i].CreatedAt.After(auditLogs[j].CreatedAt)
+	})
+
+	if filter != nil && filter.Limit != nil && len(auditLogs) > *filter.Limit {
+		auditLogs = auditLogs[:*filter.Limit]
+	}
+
+	return auditLogs, nil
+}
+
+func (s *InMemoryAuditStore) Save(auditLog *audit.AuditLog) error {
+	s.auditLogs = append(s.auditLogs, auditLog)
+	return nil
+}
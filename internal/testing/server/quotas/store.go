@@ -0,0 +1,87 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quotas
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/quota"
+)
+
+type InMemoryQuotaStore struct {
+	quotas map[string]*quota.Quota
+	mutex  sync.RWMutex
+}
+
+func NewInMemoryQuotaStore() quota.Store {
+	return &InMemoryQuotaStore{
+		quotas: make(map[string]*quota.Quota),
+	}
+}
+
+func (s *InMemoryQuotaStore) List() ([]*quota.Quota, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	quotas := []*quota.Quota{}
+	for _, q := range s.quotas {
+		quotas = append(quotas, q)
+	}
+
+	sort.SliceStable(quotas, func(i, j int) bool {
+		if quotas[i].ApiKeyName != quotas[j].ApiKeyName {
+			return quotas[i].ApiKeyName < quotas[j].ApiKeyName
+		}
+		return quotas[i].UserId < quotas[j].UserId
+	})
+
+	return quotas, nil
+}
+
+func (s *InMemoryQuotaStore) Find(apiKeyName string) (*quota.Quota, error) {
+	return s.find(&quota.Quota{ApiKeyName: apiKeyName})
+}
+
+func (s *InMemoryQuotaStore) FindForUser(userId string) (*quota.Quota, error) {
+	return s.find(&quota.Quota{UserId: userId})
+}
+
+func (s *InMemoryQuotaStore) find(q *quota.Quota) (*quota.Quota, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	q, ok := s.quotas[getKey(q)]
+	if !ok {
+		return nil, quota.ErrQuotaNotFound
+	}
+
+	return q, nil
+}
+
+func (s *InMemoryQuotaStore) Save(q *quota.Quota) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.quotas[getKey(q)] = q
+	return nil
+}
+
+func (s *InMemoryQuotaStore) Delete(q *quota.Quota) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.quotas[getKey(q)]; !ok {
+		return quota.ErrQuotaNotFound
+	}
+
+	delete(s.quotas, getKey(q))
+	return nil
+}
+
+func getKey(q *quota.Quota) string {
+	return q.ApiKeyName + "/" + q.UserId
+}
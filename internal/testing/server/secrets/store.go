@@ -0,0 +1,72 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type InMemorySecretStore struct {
+	secrets map[string]*secret.Secret
+	mutex   sync.RWMutex
+}
+
+func NewInMemorySecretStore() secret.Store {
+	return &InMemorySecretStore{
+		secrets: make(map[string]*secret.Secret),
+	}
+}
+
+func (s *InMemorySecretStore) List() ([]*secret.Secret, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	secrets := []*secret.Secret{}
+	for _, sec := range s.secrets {
+		secrets = append(secrets, sec)
+	}
+
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+
+	return secrets, nil
+}
+
+func (s *InMemorySecretStore) Find(name string) (*secret.Secret, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	sec, ok := s.secrets[name]
+	if !ok {
+		return nil, secret.ErrSecretNotFound
+	}
+
+	return sec, nil
+}
+
+func (s *InMemorySecretStore) Save(sec *secret.Secret) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.secrets[sec.Name] = sec
+	return nil
+}
+
+func (s *InMemorySecretStore) Delete(sec *secret.Secret) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.secrets[sec.Name]; !ok {
+		return secret.ErrSecretNotFound
+	}
+
+	delete(s.secrets, sec.Name)
+	return nil
+}
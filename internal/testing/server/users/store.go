@@ -0,0 +1,86 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type InMemoryUserStore struct {
+	users map[string]*user.User
+	mutex sync.RWMutex
+}
+
+func NewInMemoryUserStore() user.Store {
+	return &InMemoryUserStore{
+		users: make(map[string]*user.User),
+	}
+}
+
+func (s *InMemoryUserStore) List() ([]*user.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	users := []*user.User{}
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+
+	return users, nil
+}
+
+func (s *InMemoryUserStore) Find(idOrName string) (*user.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, u := range s.users {
+		if u.Id == idOrName || u.Name == idOrName {
+			return u, nil
+		}
+	}
+
+	return nil, user.ErrUserNotFound
+}
+
+func (s *InMemoryUserStore) FindByIdentity(issuer, subject string) (*user.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, u := range s.users {
+		if u.Issuer == issuer && u.Subject == subject {
+			return u, nil
+		}
+	}
+
+	return nil, user.ErrUserNotFound
+}
+
+func (s *InMemoryUserStore) Save(u *user.User) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.users[u.Id] = u
+	return nil
+}
+
+func (s *InMemoryUserStore) Delete(u *user.User) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.users[u.Id]; !ok {
+		return user.ErrUserNotFound
+	}
+
+	delete(s.users, u.Id)
+	return nil
+}
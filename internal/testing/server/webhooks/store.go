@@ -0,0 +1,129 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type InMemoryWebhookStore struct {
+	webhooks map[string]*webhook.Webhook
+	mutex    sync.RWMutex
+}
+
+func NewInMemoryWebhookStore() webhook.Store {
+	return &InMemoryWebhookStore{
+		webhooks: make(map[string]*webhook.Webhook),
+	}
+}
+
+func (s *InMemoryWebhookStore) List() ([]*webhook.Webhook, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	webhooks := []*webhook.Webhook{}
+	for _, w := range s.webhooks {
+		webhooks = append(webhooks, w)
+	}
+
+	sort.SliceStable(webhooks, func(i, j int) bool {
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+
+	return webhooks, nil
+}
+
+func (s *InMemoryWebhookStore) Find(id string) (*webhook.Webhook, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	w, ok := s.webhooks[id]
+	if !ok {
+		return nil, webhook.ErrWebhookNotFound
+	}
+
+	return w, nil
+}
+
+func (s *InMemoryWebhookStore) Save(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.webhooks[w.Id] = w
+	return nil
+}
+
+func (s *InMemoryWebhookStore) Delete(w *webhook.Webhook) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.webhooks[w.Id]; !ok {
+		return webhook.ErrWebhookNotFound
+	}
+
+	delete(s.webhooks, w.Id)
+	return nil
+}
+
+type InMemoryWebhookDeliveryStore struct {
+	deliveries []*webhook.Delivery
+	mutex      sync.RWMutex
+}
+
+func NewInMemoryWebhookDeliveryStore() webhook.DeliveryStore {
+	return &InMemoryWebhookDeliveryStore{
+		deliveries: []*webhook.Delivery{},
+	}
+}
+
+func (s *InMemoryWebhookDeliveryStore) List(filter *webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	deliveries := []*webhook.Delivery{}
+	for _, d := range s.deliveries {
+		if filter != nil && filter.WebhookId != nil && d.WebhookId != *filter.WebhookId {
+			continue
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].CreatedAt.After(deliveries[j].CreatedAt)
+	})
+
+	if filter != nil && filter.Limit != nil && len(deliveries) > *filter.Limit {
+		deliveries = deliveries[:*filter.Limit]
+	}
+
+	return deliveries, nil
+}
+
+func (s *InMemoryWebhookDeliveryStore) Save(delivery *webhook.Delivery) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.deliveries = append(s.deliveries, delivery)
+	return nil
+}
+
+func (s *InMemoryWebhookDeliveryStore) DeleteForWebhook(webhookId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	deliveries := []*webhook.Delivery{}
+	for _, d := range s.deliveries {
+		if d.WebhookId != webhookId {
+			deliveries = append(deliveries, d)
+		}
+	}
+	s.deliveries = deliveries
+
+	return nil
+}
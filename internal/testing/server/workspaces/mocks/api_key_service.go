@@ -7,6 +7,7 @@ package mocks
 
 import (
 	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -23,6 +24,19 @@ func (s *mockApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (st
 	return args.String(0), args.Error(1)
 }
 
+func (s *mockApiKeyService) GenerateClientKey(name string, req dto.GenerateApiKeyDTO) (string, error) {
+	args := s.Called(name, req)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GetApiKey(apiKey string) (*apikey.ApiKey, error) {
+	args := s.Called(apiKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*apikey.ApiKey), args.Error(1)
+}
+
 func (s *mockApiKeyService) IsProjectApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
@@ -43,7 +57,22 @@ func (s *mockApiKeyService) ListClientKeys() ([]*apikey.ApiKey, error) {
 	return args.Get(0).([]*apikey.ApiKey), args.Error(1)
 }
 
+func (s *mockApiKeyService) PurgeExpiredKeys() error {
+	args := s.Called()
+	return args.Error(0)
+}
+
 func (s *mockApiKeyService) Revoke(name string) error {
 	args := s.Called(name)
 	return args.Error(0)
 }
+
+func (s *mockApiKeyService) StartExpiredKeyPurger() error {
+	args := s.Called()
+	return args.Error(0)
+}
+
+func (s *mockApiKeyService) UpdateLastUsed(apiKey string) error {
+	args := s.Called(apiKey)
+	return args.Error(0)
+}
@@ -37,11 +37,21 @@ func (m *MockBuildService) List(filter *build.Filter) ([]*build.Build, error) {
 	return args.Get(0).([]*build.Build), args.Error(1)
 }
 
+func (m *MockBuildService) FindSbom(buildId string) (*build.BuildSbom, error) {
+	args := m.Called(buildId)
+	return args.Get(0).(*build.BuildSbom), args.Error(1)
+}
+
 func (m *MockBuildService) MarkForDeletion(filter *build.Filter, force bool) []error {
 	args := m.Called(filter, force)
 	return args.Get(0).([]error)
 }
 
+func (m *MockBuildService) Cancel(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockBuildService) Delete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
@@ -74,3 +74,24 @@ func (b *MockBuilder) GetImageName(build build.Build) (string, error) {
 	args := b.Called(build)
 	return args.String(0), args.Error(1)
 }
+
+func (b *MockBuilder) Cancel(build build.Build) error {
+	args := b.Called(build)
+	return args.Error(0)
+}
+
+func (b *MockBuilder) GetLayerCacheStats() *build.LayerCacheStats {
+	args := b.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*build.LayerCacheStats)
+}
+
+func (b *MockBuilder) GetArtifact(buildToInspect build.Build) (*build.BuildArtifact, error) {
+	args := b.Called(buildToInspect)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*build.BuildArtifact), args.Error(1)
+}
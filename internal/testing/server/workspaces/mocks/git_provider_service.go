@@ -99,6 +99,11 @@ func (m *MockGitProviderService) GetPrebuildWebhook(gitProviderId string, repo *
 	return args.Get(0).(*string), args.Error(1)
 }
 
+func (m *MockGitProviderService) UpdatePrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string, endpointUrl string) error {
+	args := m.Called(gitProviderId, repo, id, endpointUrl)
+	return args.Error(0)
+}
+
 func (m *MockGitProviderService) UnregisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string) error {
 	args := m.Called(gitProviderId, repo, id)
 	return args.Error(0)
@@ -7,6 +7,7 @@ package mocks
 
 import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/stretchr/testify/mock"
@@ -65,6 +66,11 @@ func (m *mockProjectConfigService) DeletePrebuild(projectConfigName string, id s
 	return args.Get(0).([]error)
 }
 
+func (m *mockProjectConfigService) UpdatePrebuildWebhooks() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
 func (m *mockProjectConfigService) StartRetentionPoller() error {
 	args := m.Called()
 	return args.Error(0)
@@ -75,6 +81,11 @@ func (m *mockProjectConfigService) EnforceRetentionPolicy() error {
 	return args.Error(0)
 }
 
+func (m *mockProjectConfigService) PruneBuilds(dryRun bool, ownerId *string) (*build_dto.BuildPruneResult, error) {
+	args := m.Called(dryRun, ownerId)
+	return args.Get(0).(*build_dto.BuildPruneResult), args.Error(1)
+}
+
 func (m *mockProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) error {
 	args := m.Called(data)
 	return args.Error(0)
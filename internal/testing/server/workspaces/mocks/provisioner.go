@@ -67,3 +67,28 @@ func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
+
+func (p *mockProvisioner) SnapshotProject(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	args := p.Called(proj, target, snapshotName)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) RestoreProject(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	args := p.Called(proj, target, snapshotName)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) CloneProject(source *project.Project, proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	args := p.Called(source, proj, target, snapshotName)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) DeleteSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	args := p.Called(proj, target, snapshotName)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) ListSnapshots(proj *project.Project, target *provider.ProviderTarget) ([]string, error) {
+	args := p.Called(proj, target)
+	return args.Get(0).([]string), args.Error(1)
+}
@@ -0,0 +1,70 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/snapshot"
+)
+
+type InMemorySnapshotStore struct {
+	snapshots map[string]*snapshot.Snapshot
+}
+
+func NewInMemorySnapshotStore() snapshot.Store {
+	return &InMemorySnapshotStore{
+		snapshots: make(map[string]*snapshot.Snapshot),
+	}
+}
+
+func (s *InMemorySnapshotStore) List(filter *snapshot.Filter) ([]*snapshot.Snapshot, error) {
+	return s.processFilters(filter), nil
+}
+
+func (s *InMemorySnapshotStore) Find(filter *snapshot.Filter) (*snapshot.Snapshot, error) {
+	snapshots := s.processFilters(filter)
+	if len(snapshots) == 0 {
+		return nil, snapshot.ErrSnapshotNotFound
+	}
+
+	return snapshots[0], nil
+}
+
+func (s *InMemorySnapshotStore) Save(snap *snapshot.Snapshot) error {
+	s.snapshots[getKey(snap)] = snap
+	return nil
+}
+
+func (s *InMemorySnapshotStore) Delete(snap *snapshot.Snapshot) error {
+	delete(s.snapshots, getKey(snap))
+	return nil
+}
+
+func (s *InMemorySnapshotStore) processFilters(filter *snapshot.Filter) []*snapshot.Snapshot {
+	result := []*snapshot.Snapshot{}
+
+	for _, snap := range s.snapshots {
+		if filter != nil {
+			if filter.WorkspaceId != nil && snap.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && snap.ProjectName != *filter.ProjectName {
+				continue
+			}
+			if filter.Name != nil && snap.Name != *filter.Name {
+				continue
+			}
+		}
+		result = append(result, snap)
+	}
+
+	return result
+}
+
+func getKey(snap *snapshot.Snapshot) string {
+	return fmt.Sprintf("%s/%s/%s", snap.WorkspaceId, snap.ProjectName, snap.Name)
+}
@@ -44,6 +44,13 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		activeProfile = *profile
 	}
 
+	if activeProfile.Api.IsKeyExpiring() {
+		err = refreshLogin(c, &activeProfile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	serverUrl := activeProfile.Api.Url
 	apiKey := activeProfile.Api.Key
 
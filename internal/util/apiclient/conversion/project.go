@@ -33,9 +33,11 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 	if projectDTO.State != nil {
 		uptime := projectDTO.State.Uptime
 		projectState = &project.ProjectState{
-			UpdatedAt: projectDTO.State.UpdatedAt,
-			Uptime:    uint64(uptime),
-			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
+			UpdatedAt:      projectDTO.State.UpdatedAt,
+			Uptime:         uint64(uptime),
+			GitStatus:      ToGitStatus(projectDTO.State.GitStatus),
+			ActiveSessions: uint32(projectDTO.State.GetActiveSessions()),
+			LastActivityAt: projectDTO.State.GetLastActivityAt(),
 		}
 	}
 
@@ -47,6 +49,20 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 				FilePath: projectDTO.BuildConfig.Devcontainer.FilePath,
 			}
 		}
+		if projectDTO.BuildConfig.Dockerfile != nil {
+			projectBuild.Dockerfile = &buildconfig.DockerfileConfig{
+				Path:      projectDTO.BuildConfig.Dockerfile.Path,
+				Context:   projectDTO.BuildConfig.Dockerfile.GetContext(),
+				BuildArgs: projectDTO.BuildConfig.Dockerfile.BuildArgs,
+				Target:    projectDTO.BuildConfig.Dockerfile.GetTarget(),
+			}
+		}
+		if projectDTO.BuildConfig.Nix != nil {
+			projectBuild.Nix = &buildconfig.NixConfig{
+				FilePath: projectDTO.BuildConfig.Nix.FilePath,
+				DevShell: projectDTO.BuildConfig.Nix.GetDevShell(),
+			}
+		}
 	}
 
 	project := &project.Project{
@@ -157,6 +173,7 @@ func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *conf
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		EnvVars:             createProjectConfigDto.EnvVars,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		Resources:           createProjectConfigDto.Resources,
 	}
 
 	result.RepositoryUrl = createProjectConfigDto.RepositoryUrl
@@ -179,6 +196,7 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 		Repository:          createProjectDto.Source.Repository,
 		EnvVars:             createProjectDto.EnvVars,
 		GitProviderConfigId: createProjectDto.GitProviderConfigId,
+		Resources:           createProjectDto.Resources,
 	}
 
 	if createProjectDto.Image != nil {
@@ -199,6 +217,7 @@ func CreateConfigDtoToProject(createProjectConfigDto pc_dto.CreateProjectConfigD
 		User:                *createProjectConfigDto.User,
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		Resources:           createProjectConfigDto.Resources,
 		Repository: &gitprovider.GitRepository{
 			Url: createProjectConfigDto.RepositoryUrl,
 		},
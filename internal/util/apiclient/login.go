@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// GetLoginApiClient returns a client without an API key for the public login routes of the server
+func GetLoginApiClient(serverUrl string) *apiclient.APIClient {
+	clientConfig := apiclient.NewConfiguration()
+	clientConfig.Servers = apiclient.ServerConfigurations{
+		{
+			URL: serverUrl,
+		},
+	}
+
+	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
+
+	client := apiclient.NewAPIClient(clientConfig)
+	client.GetConfig().HTTPClient = &http.Client{
+		Transport: http.DefaultTransport,
+	}
+
+	return client
+}
+
+// SaveLogin stores the short-lived API key and refresh token of an OIDC login in the profile
+func SaveLogin(c *config.Config, profile *config.Profile, login *apiclient.LoginDTO) error {
+	expiresAt, err := time.Parse(time.RFC3339, login.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	profile.Api.Key = login.ApiKey
+	profile.Api.KeyExpiresAt = &expiresAt
+	if login.RefreshToken != nil {
+		profile.Api.RefreshToken = *login.RefreshToken
+	}
+
+	return c.EditProfile(*profile)
+}
+
+func refreshLogin(c *config.Config, profile *config.Profile) error {
+	login, res, err := GetLoginApiClient(profile.Api.Url).AuthAPI.RefreshOidcLogin(context.Background()).Refresh(apiclient.RefreshLoginDTO{
+		RefreshToken: profile.Api.RefreshToken,
+	}).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to refresh the login of profile %s, run 'daytona login' again: %w", profile.Name, HandleErrorResponse(res, err))
+	}
+
+	return SaveLogin(c, profile, login)
+}
@@ -6,6 +6,8 @@ package apiclient
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -18,12 +20,24 @@ import (
 
 var workspaceLogsStarted bool
 
-func ReadWorkspaceLogs(ctx context.Context, activeProfile config.Profile, workspaceId string, projectNames []string, follow, showWorkspaceLogs bool, from *time.Time) {
-	var wg sync.WaitGroup
-	query := ""
+// Returns the query of log requests. A nil tail reads all entries.
+func GetLogQuery(follow bool, since string, tail *int) string {
+	query := url.Values{}
 	if follow {
-		query = "follow=true"
+		query.Set("follow", "true")
+	}
+	if since != "" {
+		query.Set("since", since)
 	}
+	if tail != nil {
+		query.Set("tail", strconv.Itoa(*tail))
+	}
+
+	return query.Encode()
+}
+
+func ReadWorkspaceLogs(ctx context.Context, activeProfile config.Profile, workspaceId string, projectNames []string, query string, showWorkspaceLogs bool, from *time.Time) {
+	var wg sync.WaitGroup
 
 	if !showWorkspaceLogs {
 		workspaceLogsStarted = true
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import "sync"
+
+// KeyedMutex serializes operations that share the same key while operations with different keys run concurrently.
+// The zero value is ready to use.
+type KeyedMutex struct {
+	mutexes sync.Map
+}
+
+// Lock locks the mutex of the key and returns the function that unlocks it
+func (m *KeyedMutex) Lock(key string) func() {
+	value, _ := m.mutexes.LoadOrStore(key, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+
+	return mutex.Unlock
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 
@@ -41,37 +42,125 @@ func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byt
 	}
 }
 
+type JSONLogReadOptions struct {
+	Follow bool
+	// Entries written before this time are skipped. Entries without a time are always read.
+	Since *time.Time
+	// Only the last [Tail] entries already written to the log are read before following
+	Tail *int
+	// Following stops once IsDone returns true and the remaining entries have been read
+	IsDone func() bool
+}
+
+const followPollInterval = 250 * time.Millisecond
+
 func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
+	ReadJSONLogWithOptions(ctx, logReader, JSONLogReadOptions{Follow: follow}, c, errChan)
+}
+
+func ReadJSONLogWithOptions(ctx context.Context, logReader io.Reader, opts JSONLogReadOptions, c chan interface{}, errChan chan error) {
 	reader := bufio.NewReader(logReader)
+
+	// Entries are held back until the existing log has been read so that only the tail is sent
+	var tailEntries []logs.LogEntry
+	readingTail := opts.Tail != nil
+
+	done := false
+	pending := ""
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
-			line, readErr := reader.ReadString('\n')
-			if line != "" {
-				stripped := strings.TrimSuffix(line, logs.LogDelimiter)
-				var logEntry logs.LogEntry
-
-				err := json.Unmarshal([]byte(stripped), &logEntry)
-				if err != nil {
-					log.Trace("Failed to parse log entry: ", err, string(stripped))
-				}
+		}
+
+		line, readErr := reader.ReadString('\n')
+		pending += line
+
+		// Partially written entries are completed on the next read when following
+		if pending != "" && (readErr == nil || !opts.Follow || done) {
+			logEntry, ok := parseLogEntry(pending, opts.Since)
+			pending = ""
 
+			if ok && readingTail {
+				tailEntries = append(tailEntries, logEntry)
+				if len(tailEntries) > *opts.Tail {
+					tailEntries = tailEntries[1:]
+				}
+			} else if ok {
 				c <- logEntry
 			}
-			if readErr != nil {
-				if readErr != io.EOF {
-					c <- logs.LogEntry{}
-					errChan <- readErr
-				} else if !follow {
-					c <- logs.LogEntry{}
-					errChan <- io.EOF
-					return
-				}
+		}
+
+		if readErr == nil {
+			continue
+		}
+
+		if readErr != io.EOF {
+			c <- logs.LogEntry{}
+			errChan <- readErr
+			return
+		}
+
+		if readingTail {
+			for _, logEntry := range tailEntries {
+				c <- logEntry
 			}
+			tailEntries = nil
+			readingTail = false
+		}
+
+		if !opts.Follow || done {
+			c <- logs.LogEntry{}
+			errChan <- io.EOF
+			return
+		}
+
+		// Read once more after the log source is done to get the entries written in the meantime
+		if opts.IsDone != nil && opts.IsDone() {
+			done = true
+			continue
 		}
+
+		time.Sleep(followPollInterval)
+	}
+}
+
+func parseLogEntry(line string, since *time.Time) (logs.LogEntry, bool) {
+	stripped := strings.TrimSuffix(line, logs.LogDelimiter)
+
+	var logEntry logs.LogEntry
+	err := json.Unmarshal([]byte(stripped), &logEntry)
+	if err != nil {
+		log.Trace("Failed to parse log entry: ", err, stripped)
+		return logEntry, false
 	}
+
+	if since != nil && logEntry.Time != "" {
+		entryTime, err := time.Parse(time.RFC3339Nano, logEntry.Time)
+		if err == nil && entryTime.Before(*since) {
+			return logEntry, false
+		}
+	}
+
+	return logEntry, true
+}
+
+// Parses the since filter of log requests which is either a timestamp or a duration relative to now
+func ParseLogSince(since string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, since)
+	if err == nil {
+		return &t, nil
+	}
+
+	d, err := time.ParseDuration(since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since value %s: expected a RFC3339 timestamp or a duration", since)
+	}
+
+	t = time.Now().Add(-d)
+	return &t, nil
 }
 
 func ReadCompressedFile(filePath string) (io.Reader, error) {
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLogEntry(t *testing.T, w io.Writer, msg string, entryTime time.Time) {
+	b, err := json.Marshal(logs.LogEntry{
+		Msg:  msg,
+		Time: entryTime.Format(time.RFC3339),
+	})
+	require.Nil(t, err)
+
+	_, err = w.Write(append(b, []byte(logs.LogDelimiter)...))
+	require.Nil(t, err)
+}
+
+func readMessages(t *testing.T, logReader io.Reader, opts JSONLogReadOptions) []string {
+	c := make(chan interface{})
+	errChan := make(chan error)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go ReadJSONLogWithOptions(ctx, logReader, opts, c, errChan)
+
+	messages := []string{}
+	for {
+		select {
+		case entry := <-c:
+			if logEntry := entry.(logs.LogEntry); logEntry != (logs.LogEntry{}) {
+				messages = append(messages, logEntry.Msg)
+			}
+		case err := <-errChan:
+			require.ErrorIs(t, err, io.EOF)
+			return messages
+		case <-ctx.Done():
+			t.Fatal("timed out reading logs")
+		}
+	}
+}
+
+func TestReadJSONLogWithOptions(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "log")
+	logFile, err := os.Create(logFilePath)
+	require.Nil(t, err)
+	defer logFile.Close()
+
+	now := time.Now()
+	writeLogEntry(t, logFile, "first", now.Add(-time.Hour))
+	writeLogEntry(t, logFile, "second", now.Add(-time.Minute))
+	writeLogEntry(t, logFile, "third", now)
+
+	t.Run("tail", func(t *testing.T) {
+		logReader, err := os.Open(logFilePath)
+		require.Nil(t, err)
+		defer logReader.Close()
+
+		tail := 2
+		require.Equal(t, []string{"second", "third"}, readMessages(t, logReader, JSONLogReadOptions{Tail: &tail}))
+	})
+
+	t.Run("since", func(t *testing.T) {
+		logReader, err := os.Open(logFilePath)
+		require.Nil(t, err)
+		defer logReader.Close()
+
+		since := now.Add(-10 * time.Minute)
+		require.Equal(t, []string{"second", "third"}, readMessages(t, logReader, JSONLogReadOptions{Since: &since}))
+	})
+
+	t.Run("follow until done", func(t *testing.T) {
+		logReader, err := os.Open(logFilePath)
+		require.Nil(t, err)
+		defer logReader.Close()
+
+		tail := 1
+		var done atomic.Bool
+
+		go func() {
+			time.Sleep(2 * followPollInterval)
+			writeLogEntry(t, logFile, "fourth", time.Now())
+			done.Store(true)
+		}()
+
+		messages := readMessages(t, logReader, JSONLogReadOptions{
+			Follow: true,
+			Tail:   &tail,
+			IsDone: done.Load,
+		})
+		require.Equal(t, []string{"third", "fourth"}, messages)
+	})
+}
+
+func TestParseLogSince(t *testing.T) {
+	since, err := ParseLogSince("2024-01-02T13:23:37Z")
+	require.Nil(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 13, 23, 37, 0, time.UTC), *since)
+
+	since, err = ParseLogSince("10m")
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(-10*time.Minute), *since, time.Second)
+
+	_, err = ParseLogSince("yesterday")
+	require.NotNil(t, err)
+}
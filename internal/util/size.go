@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import "fmt"
+
+// Formats the size in bytes with decimal units, e.g. 1.5 GB
+func FormatSize(size int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+
+	value := float64(size)
+	unit := 0
+	for value >= 1000 && unit < len(units)-1 {
+		value /= 1000
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[unit])
+	}
+
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
@@ -264,9 +264,11 @@ func (a *Agent) updateProjectState() error {
 	}
 
 	uptime := a.uptime()
+	activeSessions := int32(a.Ssh.ActiveSessions())
 	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
-		Uptime:    uptime,
-		GitStatus: conversion.ToGitStatusDTO(gitStatus),
+		Uptime:         uptime,
+		GitStatus:      conversion.ToGitStatusDTO(gitStatus),
+		ActiveSessions: &activeSessions,
 	}).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
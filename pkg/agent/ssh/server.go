@@ -6,9 +6,11 @@ package ssh
 import (
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/exec"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"unsafe"
 
@@ -24,6 +26,12 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	activeSessions    atomic.Int32
+}
+
+// ActiveSessions returns the number of currently open SSH connections
+func (s *Server) ActiveSessions() uint32 {
+	return uint32(s.activeSessions.Load())
 }
 
 func (s *Server) Start() error {
@@ -74,6 +82,14 @@ func (s *Server) Start() error {
 		SessionRequestCallback: func(sess ssh.Session, requestType string) bool {
 			return true
 		},
+		ConnCallback: func(ctx ssh.Context, conn net.Conn) net.Conn {
+			s.activeSessions.Add(1)
+			go func() {
+				<-ctx.Done()
+				s.activeSessions.Add(-1)
+			}()
+			return conn
+		},
 	}
 
 	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
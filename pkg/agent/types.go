@@ -13,6 +13,7 @@ import (
 
 type SshServer interface {
 	Start() error
+	ActiveSessions() uint32
 }
 
 type TailscaleServer interface {
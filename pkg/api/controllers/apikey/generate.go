@@ -4,11 +4,16 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,8 +22,10 @@ import (
 //	@Tags			apiKey
 //	@Summary		Generate an API key
 //	@Description	Generate an API key
+//	@Accept			json
 //	@Produce		plain
-//	@Param			apiKeyName	path		string	true	"API key name"
+//	@Param			apiKeyName	path		string				true	"API key name"
+//	@Param			apiKey		body		GenerateApiKeyDTO	false	"API key options"
 //	@Success		200			{string}	apiKey
 //	@Router			/apikey/{apiKeyName} [post]
 //
@@ -26,10 +33,38 @@ import (
 func GenerateApiKey(ctx *gin.Context) {
 	apiKeyName := ctx.Param("apiKeyName")
 
+	var req dto.GenerateApiKeyDTO
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil && !errors.Is(err, io.EOF) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	if req.Role == "" {
+		req.Role = apikey.ApiKeyRoleAdmin
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	if req.UserId != nil {
+		u, err := server.UserService.Find(*req.UserId)
+		if err != nil {
+			if user.IsUserNotFound(err) {
+				ctx.AbortWithError(http.StatusNotFound, err)
+				return
+			}
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find user: %w", err))
+			return
+		}
+		req.UserId = &u.Id
+	}
+
+	response, err := server.ApiKeyService.GenerateClientKey(apiKeyName, req)
 	if err != nil {
+		if apikeys.IsInvalidApiKeyRole(err) || apikeys.IsInvalidExpiry(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get API keys: %w", err))
 		return
 	}
@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAuditLogs 			godoc
+//
+//	@Tags			audit
+//	@Summary		List audit logs
+//	@Description	List audit logs of mutating API operations, newest first
+//	@Produce		json
+//	@Param			apiKeyName	query	string	false	"API key name"
+//	@Param			method		query	string	false	"HTTP method"
+//	@Param			resourceId	query	string	false	"Resource ID"
+//	@Param			outcome		query	string	false	"Outcome (success or failure)"
+//	@Param			since		query	string	false	"Only return logs created at or after this time (RFC3339)"
+//	@Param			until		query	string	false	"Only return logs created at or before this time (RFC3339)"
+//	@Param			limit		query	int		false	"Maximum number of logs to return"
+//	@Success		200			{array}	AuditLog
+//	@Router			/audit [get]
+//
+//	@id				ListAuditLogs
+func ListAuditLogs(ctx *gin.Context) {
+	filter, err := getAuditFilter(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	auditLogs, err := server.AuditService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list audit logs: %w", err))
+		return
+	}
+
+	ctx.JSON(200, auditLogs)
+}
+
+func getAuditFilter(ctx *gin.Context) (*audit.Filter, error) {
+	filter := &audit.Filter{}
+
+	if apiKeyName := ctx.Query("apiKeyName"); apiKeyName != "" {
+		filter.ApiKeyName = &apiKeyName
+	}
+
+	if method := ctx.Query("method"); method != "" {
+		filter.Method = &method
+	}
+
+	if resourceId := ctx.Query("resourceId"); resourceId != "" {
+		filter.ResourceId = &resourceId
+	}
+
+	if outcomeQuery := ctx.Query("outcome"); outcomeQuery != "" {
+		outcome := audit.Outcome(outcomeQuery)
+		if outcome != audit.OutcomeSuccess && outcome != audit.OutcomeFailure {
+			return nil, errors.New("invalid value for outcome, must be success or failure")
+		}
+		filter.Outcome = &outcome
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.Parse(time.RFC3339, sinceQuery)
+		if err != nil {
+			return nil, errors.New("invalid value for since, must be an RFC3339 timestamp")
+		}
+		filter.Since = &since
+	}
+
+	if untilQuery := ctx.Query("until"); untilQuery != "" {
+		until, err := time.Parse(time.RFC3339, untilQuery)
+		if err != nil {
+			return nil, errors.New("invalid value for until, must be an RFC3339 timestamp")
+		}
+		filter.Until = &until
+	}
+
+	if limitQuery := ctx.Query("limit"); limitQuery != "" {
+		limit, err := strconv.Atoi(limitQuery)
+		if err != nil || limit < 1 {
+			return nil, errors.New("invalid value for limit, must be a positive integer")
+		}
+		filter.Limit = &limit
+	}
+
+	return filter, nil
+}
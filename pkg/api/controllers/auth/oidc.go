@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/auth"
+	"github.com/daytonaio/daytona/pkg/server/auth/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// StartOidcDeviceLogin 			godoc
+//
+//	@Tags			auth
+//	@Summary		Start an OIDC device login
+//	@Description	Start a device authorization at the OIDC provider of the server. The user logs in at the verification URI with the user code.
+//	@Produce		json
+//	@Success		200	{object}	DeviceLoginDTO
+//	@Router			/auth/oidc/device [post]
+//
+//	@id				StartOidcDeviceLogin
+func StartOidcDeviceLogin(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	deviceLogin, err := server.AuthService.StartDeviceLogin(ctx.Request.Context())
+	if err != nil {
+		handleLoginError(ctx, err)
+		return
+	}
+
+	ctx.JSON(200, deviceLogin)
+}
+
+// CompleteOidcDeviceLogin 			godoc
+//
+//	@Tags			auth
+//	@Summary		Complete an OIDC device login
+//	@Description	Poll for the completion of a device login. Responds with 202 while the login is pending and 429 when polled too frequently.
+//	@Accept			json
+//	@Produce		json
+//	@Param			deviceLogin	body		CompleteDeviceLoginDTO	true	"Device login"
+//	@Success		200			{object}	LoginDTO
+//	@Success		202
+//	@Router			/auth/oidc/token [post]
+//
+//	@id				CompleteOidcDeviceLogin
+func CompleteOidcDeviceLogin(ctx *gin.Context) {
+	var req dto.CompleteDeviceLoginDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	login, err := server.AuthService.CompleteDeviceLogin(ctx.Request.Context(), req.DeviceCode)
+	if err != nil {
+		if auth.IsAuthorizationPending(err) {
+			ctx.Status(http.StatusAccepted)
+			return
+		}
+		handleLoginError(ctx, err)
+		return
+	}
+
+	ctx.JSON(200, login)
+}
+
+// RefreshOidcLogin 			godoc
+//
+//	@Tags			auth
+//	@Summary		Refresh an OIDC login
+//	@Description	Get a new short-lived API key with the refresh token of a previous login
+//	@Accept			json
+//	@Produce		json
+//	@Param			refresh	body		RefreshLoginDTO	true	"Refresh token"
+//	@Success		200		{object}	LoginDTO
+//	@Router			/auth/oidc/refresh [post]
+//
+//	@id				RefreshOidcLogin
+func RefreshOidcLogin(ctx *gin.Context) {
+	var req dto.RefreshLoginDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	login, err := server.AuthService.RefreshLogin(ctx.Request.Context(), req.RefreshToken)
+	if err != nil {
+		handleLoginError(ctx, err)
+		return
+	}
+
+	ctx.JSON(200, login)
+}
+
+func handleLoginError(ctx *gin.Context, err error) {
+	switch {
+	case auth.IsOidcNotConfigured(err):
+		ctx.AbortWithError(http.StatusNotFound, err)
+	case auth.IsSlowDown(err):
+		ctx.AbortWithError(http.StatusTooManyRequests, err)
+	case auth.IsLoginDenied(err):
+		ctx.AbortWithError(http.StatusUnauthorized, err)
+	case auth.IsNoRole(err):
+		ctx.AbortWithError(http.StatusForbidden, err)
+	default:
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to log in: %w", err))
+	}
+}
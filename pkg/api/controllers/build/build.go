@@ -9,10 +9,12 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build/dto"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	builds_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
@@ -47,6 +49,11 @@ func CreateBuild(ctx *gin.Context) {
 		return
 	}
 
+	if !controllers.CanAccess(ctx, projectConfig.OwnerId) {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to get project config: %w", config.ErrProjectConfigNotFound))
+		return
+	}
+
 	gitProvider, _, err := s.GitProviderService.GetGitProviderForUrl(projectConfig.RepositoryUrl)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for url: %s", err.Error()))
@@ -62,16 +69,28 @@ func CreateBuild(ctx *gin.Context) {
 		return
 	}
 
+	// Builds requested through the API are run ahead of builds triggered by git events
 	newBuildDto := builds_dto.BuildCreationData{
-		Image:       projectConfig.Image,
-		User:        projectConfig.User,
-		BuildConfig: projectConfig.BuildConfig,
-		Repository:  repo,
-		EnvVars:     createBuildDto.EnvVars,
+		Image:             projectConfig.Image,
+		User:              projectConfig.User,
+		BuildConfig:       projectConfig.BuildConfig,
+		Repository:        repo,
+		EnvVars:           createBuildDto.EnvVars,
+		ProjectConfigName: projectConfig.Name,
+		Priority:          build.BuildPriorityHigh,
 	}
 
 	if createBuildDto.PrebuildId != nil {
 		newBuildDto.PrebuildId = *createBuildDto.PrebuildId
+
+		prebuild, err := s.ProjectConfigService.FindPrebuild(&config.ProjectConfigFilter{
+			Name: &projectConfig.Name,
+		}, &config.PrebuildFilter{
+			Id: createBuildDto.PrebuildId,
+		})
+		if err == nil {
+			newBuildDto.Timeout = prebuild.BuildTimeout
+		}
 	}
 
 	buildId, err := s.BuildService.Create(newBuildDto)
@@ -114,6 +133,35 @@ func GetBuild(ctx *gin.Context) {
 	ctx.JSON(200, b)
 }
 
+// GetBuildSbom godoc
+//
+//	@Tags			build
+//	@Summary		Get build SBOM
+//	@Description	Get the SBOM of the build artifact including the SBOM document
+//	@Produce		json
+//	@Param			buildId	path		string	true	"Build ID"
+//	@Success		200		{object}	BuildSbom
+//	@Router			/build/{buildId}/sbom [get]
+//
+//	@id				GetBuildSbom
+func GetBuildSbom(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	sbom, err := server.BuildService.FindSbom(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) || build.IsSbomNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find build sbom: %w", err))
+		return
+	}
+
+	ctx.JSON(200, sbom)
+}
+
 // ListBuilds godoc
 //
 //	@Tags			build
@@ -133,14 +181,86 @@ func ListBuilds(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, builds)
+	ctx.JSON(200, filterAccessibleBuilds(ctx, builds))
+}
+
+// CancelBuild godoc
+//
+//	@Tags			build
+//	@Summary		Cancel build
+//	@Description	Cancel a queued or running build
+//	@Param			buildId	path	string	true	"Build ID"
+//	@Success		204
+//	@Router			/build/{buildId}/cancel [post]
+//
+//	@id				CancelBuild
+func CancelBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	err := server.BuildService.Cancel(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if builds.IsBuildNotCancellable(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to cancel build: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// PruneBuilds godoc
+//
+//	@Tags			build
+//	@Summary		Prune builds
+//	@Description	Mark the published builds that exceed the retention of their prebuild for deletion
+//	@Produce		json
+//	@Param			dryRun	query		bool	false	"List the builds that would be pruned without deleting them"
+//	@Success		200		{object}	BuildPruneResult
+//	@Router			/build/prune [post]
+//
+//	@id				PruneBuilds
+func PruneBuilds(ctx *gin.Context) {
+	dryRunQuery := ctx.Query("dryRun")
+	var dryRun bool
+	var err error
+
+	if dryRunQuery != "" {
+		dryRun, err = strconv.ParseBool(dryRunQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for dryRun flag"))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	// Only the builds of the project configs of the user are pruned unless the API key can access all users
+	var ownerId *string
+	if !controllers.CanAccessAllUsers(ctx) {
+		userId := ctx.GetString("apiKeyUserId")
+		ownerId = &userId
+	}
+
+	result, err := server.ProjectConfigService.PruneBuilds(dryRun, ownerId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to prune builds: %w", err))
+		return
+	}
+
+	ctx.JSON(200, result)
 }
 
 // DeleteAllBuilds godoc
 //
 //	@Tags			build
 //	@Summary		Delete ALL builds
-//	@Description	Delete ALL builds
+//	@Description	Delete ALL builds the API key can access
 //	@Param			force	query	bool	false	"Force"
 //	@Success		204
 //	@Router			/build [delete]
@@ -161,7 +281,22 @@ func DeleteAllBuilds(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	errs := server.BuildService.MarkForDeletion(nil, force)
+	var errs []error
+	if controllers.CanAccessAllUsers(ctx) {
+		errs = server.BuildService.MarkForDeletion(nil, force)
+	} else {
+		builds, err := server.BuildService.List(nil)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list builds: %w", err))
+			return
+		}
+
+		for _, b := range filterAccessibleBuilds(ctx, builds) {
+			errs = append(errs, server.BuildService.MarkForDeletion(&build.Filter{
+				Id: &b.Id,
+			}, force)...)
+		}
+	}
 	if len(errs) > 0 {
 		for _, err := range errs {
 			_ = ctx.Error(err)
@@ -242,7 +377,7 @@ func DeleteBuildsFromPrebuild(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	// Fail if prebuild does not exist
-	_, err = server.ProjectConfigService.FindPrebuild(nil, &config.PrebuildFilter{
+	prebuild, err := server.ProjectConfigService.FindPrebuild(nil, &config.PrebuildFilter{
 		Id: &prebuildId,
 	})
 	if err != nil {
@@ -250,6 +385,19 @@ func DeleteBuildsFromPrebuild(ctx *gin.Context) {
 		return
 	}
 
+	projectConfig, err := server.ProjectConfigService.Find(&config.ProjectConfigFilter{
+		Name: &prebuild.ProjectConfigName,
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find project config: %w", err))
+		return
+	}
+
+	if !controllers.CanAccess(ctx, projectConfig.OwnerId) {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to find prebuild: %w", config.ErrPrebuildNotFound))
+		return
+	}
+
 	errs := server.BuildService.MarkForDeletion(&build.Filter{
 		PrebuildIds: &[]string{prebuildId},
 	}, force)
@@ -263,3 +411,16 @@ func DeleteBuildsFromPrebuild(ctx *gin.Context) {
 
 	ctx.Status(204)
 }
+
+func filterAccessibleBuilds(ctx *gin.Context, builds []*build.Build) []*build.Build {
+	getOwnerId := controllers.NewBuildOwnerResolver()
+
+	accessibleBuilds := []*build.Build{}
+	for _, b := range builds {
+		if controllers.CanAccessBuild(ctx, b, getOwnerId) {
+			accessibleBuilds = append(accessibleBuilds, b)
+		}
+	}
+
+	return accessibleBuilds
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// StreamEvents writes workspace, project and build events to a websocket.
+// Recently published events are always sent first. If follow is set, the connection
+// stays open and new events are sent as they are published.
+func StreamEvents(ginCtx *gin.Context) {
+	resourceTypes, err := getResourceTypes(ginCtx.Query("resourceType"))
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	follow := ginCtx.Query("follow") == "true"
+
+	var scope *apikey.ApiKeyScope
+	if s, ok := ginCtx.Value("apiKeyScope").(*apikey.ApiKeyScope); ok {
+		scope = s
+	}
+
+	getOwnerId := newEventOwnerResolver()
+
+	matches := func(event events.Event) bool {
+		if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, event.ResourceType) {
+			return false
+		}
+
+		// Events of resources without a known owner are only sent to API keys that can access the resources of all users
+		if !controllers.CanAccessAllUsers(ginCtx) {
+			ownerId, err := getOwnerId(event)
+			if err != nil {
+				log.Trace(err)
+				return false
+			}
+
+			if !controllers.CanAccess(ginCtx, ownerId) {
+				return false
+			}
+		}
+
+		if event.WorkspaceId != "" && scope != nil {
+			return scope.AllowsWorkspace(event.WorkspaceId)
+		}
+
+		return true
+	}
+
+	eventBus := server.GetInstance(nil).EventBus
+
+	// Subscribe before reading the recent events so that no event is missed in between
+	eventCh, unsubscribe := eventBus.Subscribe()
+	defer unsubscribe()
+
+	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	defer func() {
+		err := ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+		if err != nil {
+			log.Trace(err)
+		}
+		ws.Close()
+	}()
+
+	sent := map[string]bool{}
+	for _, event := range eventBus.Recent() {
+		if !matches(event) {
+			continue
+		}
+
+		err = ws.WriteJSON(event)
+		if err != nil {
+			log.Trace(err)
+			return
+		}
+		sent[event.Id] = true
+	}
+
+	if !follow {
+		return
+	}
+
+	// Buffered so that the reader does not block forever when the stream has already ended
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			_, _, err := ws.ReadMessage()
+			if err != nil {
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ginCtx.Request.Context().Done():
+			return
+		case err := <-readErr:
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseAbnormalClosure) {
+				log.Error(err)
+			}
+			return
+		case event, ok := <-eventCh:
+			if !ok {
+				return
+			}
+
+			if sent[event.Id] || !matches(event) {
+				continue
+			}
+
+			err = ws.WriteJSON(event)
+			if err != nil {
+				log.Trace(err)
+				return
+			}
+		}
+	}
+}
+
+// Returns a function that returns the owner of the workspace or build of an event.
+// The owners of builds are cached since every build publishes several events.
+func newEventOwnerResolver() func(event events.Event) (string, error) {
+	buildOwners := map[string]string{}
+	getBuildOwnerId := controllers.NewBuildOwnerResolver()
+
+	return func(event events.Event) (string, error) {
+		if event.ResourceType != events.ResourceTypeBuild {
+			return event.OwnerId, nil
+		}
+
+		ownerId, ok := buildOwners[event.ResourceId]
+		if ok {
+			return ownerId, nil
+		}
+
+		b, err := server.GetInstance(nil).BuildService.Find(&build.Filter{
+			Id: &event.ResourceId,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		ownerId, err = getBuildOwnerId(b)
+		if err != nil {
+			return "", err
+		}
+
+		buildOwners[event.ResourceId] = ownerId
+		return ownerId, nil
+	}
+}
+
+func getResourceTypes(query string) ([]events.ResourceType, error) {
+	resourceTypes := []events.ResourceType{}
+
+	if query == "" {
+		return resourceTypes, nil
+	}
+
+	for _, t := range strings.Split(query, ",") {
+		resourceType := events.ResourceType(strings.TrimSpace(t))
+		if !slices.Contains(events.ResourceTypes, resourceType) {
+			return nil, fmt.Errorf("invalid resource type %s", resourceType)
+		}
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	return resourceTypes, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/gin-gonic/gin"
 )
 
@@ -40,12 +41,17 @@ func ListGitProviders(ctx *gin.Context) {
 		return
 	}
 
+	gitProviders := []*gitprovider.GitProviderConfig{}
 	for _, provider := range response {
+		if !controllers.CanAccess(ctx, provider.OwnerId) {
+			continue
+		}
 		provider.Token = ""
 		provider.SigningKey = nil
+		gitProviders = append(gitProviders, provider)
 	}
 
-	ctx.JSON(200, response)
+	ctx.JSON(200, gitProviders)
 }
 
 // ListGitProvidersForUrl 			godoc
@@ -76,14 +82,21 @@ func ListGitProvidersForUrl(ctx *gin.Context) {
 		return
 	}
 
+	ownedGitProviders := []*gitprovider.GitProviderConfig{}
+	for _, gitProvider := range gitProviders {
+		if controllers.CanAccess(ctx, gitProvider.OwnerId) {
+			ownedGitProviders = append(ownedGitProviders, gitProvider)
+		}
+	}
+
 	apiKeyType, ok := ctx.Get("apiKeyType")
 	if !ok || apiKeyType == apikey.ApiKeyTypeClient {
-		for _, gitProvider := range gitProviders {
+		for _, gitProvider := range ownedGitProviders {
 			gitProvider.Token = ""
 		}
 	}
 
-	ctx.JSON(200, gitProviders)
+	ctx.JSON(200, ownedGitProviders)
 }
 
 // GetGitProvider 			godoc
@@ -194,6 +207,18 @@ func SetGitProvider(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
+	gitProviderConfig.OwnerId = user.UserId(ctx.Request.Context())
+	if gitProviderConfig.Id != "" {
+		existingConfig, err := server.GitProviderService.GetConfig(gitProviderConfig.Id)
+		if err == nil {
+			if !controllers.CanAccess(ctx, existingConfig.OwnerId) {
+				ctx.AbortWithError(http.StatusForbidden, fmt.Errorf("git provider %s is owned by another user", gitProviderConfig.Id))
+				return
+			}
+			gitProviderConfig.OwnerId = existingConfig.OwnerId
+		}
+	}
+
 	err = server.GitProviderService.SetGitProviderConfig(&gitProviderConfig)
 	if err != nil {
 		statusCode, message, codeErr := controllers.GetHTTPStatusCodeAndMessageFromError(err)
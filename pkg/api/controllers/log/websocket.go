@@ -6,11 +6,14 @@ package log
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -108,6 +111,40 @@ func readLog[T any](ginCtx *gin.Context, logReader io.Reader, readFunc func(cont
 	}
 }
 
+// Parses the follow, since and tail query params of JSON log requests
+func getJSONLogReadOptions(ginCtx *gin.Context) (util.JSONLogReadOptions, error) {
+	opts := util.JSONLogReadOptions{
+		Follow: ginCtx.Query("follow") == "true",
+	}
+
+	sinceQuery := ginCtx.Query("since")
+	if sinceQuery != "" {
+		since, err := util.ParseLogSince(sinceQuery)
+		if err != nil {
+			return opts, err
+		}
+		opts.Since = since
+	}
+
+	tailQuery := ginCtx.Query("tail")
+	if tailQuery != "" {
+		tail, err := strconv.Atoi(tailQuery)
+		if err != nil || tail < 0 {
+			return opts, fmt.Errorf("invalid tail value %s: expected a non-negative number", tailQuery)
+		}
+		opts.Tail = &tail
+	}
+
+	return opts, nil
+}
+
+func readJSONLogWithOptions(opts util.JSONLogReadOptions) func(context.Context, io.Reader, bool, chan interface{}, chan error) {
+	return func(ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
+		opts.Follow = follow
+		util.ReadJSONLogWithOptions(ctx, logReader, opts, c, errChan)
+	}
+}
+
 func ReadServerLog(ginCtx *gin.Context) {
 	s := server.GetInstance(nil)
 
@@ -148,13 +185,25 @@ func ReadWorkspaceLog(ginCtx *gin.Context) {
 	retryQuery := ginCtx.DefaultQuery("retry", "true")
 	retry := retryQuery == "true"
 
+	opts, err := getJSONLogReadOptions(ginCtx)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
+	// Following stops once the workspace is removed
+	opts.IsDone = func() bool {
+		_, err := server.WorkspaceService.GetWorkspace(ginCtx.Request.Context(), workspaceId, false)
+		return err != nil
+	}
+
 	if retry {
 		for {
 			wsLogReader, err := server.WorkspaceService.GetWorkspaceLogReader(workspaceId)
 			if err == nil {
-				readLog(ginCtx, wsLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, wsLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -167,7 +216,7 @@ func ReadWorkspaceLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, wsLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, wsLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 }
 
 func ReadProjectLog(ginCtx *gin.Context) {
@@ -176,13 +225,35 @@ func ReadProjectLog(ginCtx *gin.Context) {
 	retryQuery := ginCtx.DefaultQuery("retry", "true")
 	retry := retryQuery == "true"
 
+	opts, err := getJSONLogReadOptions(ginCtx)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
+	// Following stops once the project or its workspace is removed
+	opts.IsDone = func() bool {
+		w, err := server.WorkspaceService.GetWorkspace(ginCtx.Request.Context(), workspaceId, false)
+		if err != nil {
+			return true
+		}
+
+		for _, project := range w.Projects {
+			if project.Name == projectName {
+				return false
+			}
+		}
+
+		return true
+	}
+
 	if retry {
 		for {
 			projectLogReader, err := server.WorkspaceService.GetProjectLogReader(workspaceId, projectName)
 			if err == nil {
-				readLog(ginCtx, projectLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, projectLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -195,7 +266,7 @@ func ReadProjectLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, projectLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, projectLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 }
 
 func ReadBuildLog(ginCtx *gin.Context) {
@@ -203,14 +274,32 @@ func ReadBuildLog(ginCtx *gin.Context) {
 	retryQuery := ginCtx.DefaultQuery("retry", "true")
 	retry := retryQuery == "true"
 
+	opts, err := getJSONLogReadOptions(ginCtx)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	server := server.GetInstance(nil)
 
+	// Following stops once the build reaches a state in which it no longer writes logs
+	opts.IsDone = func() bool {
+		b, err := server.BuildService.Find(&build.Filter{
+			Id: &buildId,
+		})
+		if err != nil {
+			return build.IsBuildNotFound(err)
+		}
+
+		return b.State.IsFinished()
+	}
+
 	if retry {
 		for {
 			buildLogReader, err := server.BuildService.GetBuildLogReader(buildId)
 
 			if err == nil {
-				readLog(ginCtx, buildLogReader, util.ReadJSONLog, writeJSONToWs)
+				readLog(ginCtx, buildLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 				return
 			}
 			time.Sleep(TIMEOUT)
@@ -223,5 +312,5 @@ func ReadBuildLog(ginCtx *gin.Context) {
 		return
 	}
 
-	readLog(ginCtx, buildLogReader, util.ReadJSONLog, writeJSONToWs)
+	readLog(ginCtx, buildLogReader, readJSONLogWithOptions(opts), writeJSONToWs)
 }
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/gin-gonic/gin"
+)
+
+// CanAccess returns true if the API key of the request can access a resource with the given owner.
+// Admin API keys and the API keys of workspaces and projects can access the resources of all users.
+func CanAccess(ctx *gin.Context, ownerId string) bool {
+	if CanAccessAllUsers(ctx) {
+		return true
+	}
+
+	return user.CanAccess(ownerId, ctx.GetString("apiKeyUserId"))
+}
+
+// CanAccessAllUsers returns true if the API key of the request can access the resources of all users
+func CanAccessAllUsers(ctx *gin.Context) bool {
+	role, ok := ctx.Value("apiKeyRole").(apikey.ApiKeyRole)
+	return !ok || role == apikey.ApiKeyRoleAdmin
+}
+
+// NewBuildOwnerResolver returns a function that resolves the owner of a build, which is the owner of the project config
+// the build was created from. Builds without a project config are shared. The owners are cached per project config.
+func NewBuildOwnerResolver() func(b *build.Build) (string, error) {
+	projectConfigOwners := map[string]string{}
+
+	return func(b *build.Build) (string, error) {
+		if b.ProjectConfigName == "" {
+			return "", nil
+		}
+
+		ownerId, ok := projectConfigOwners[b.ProjectConfigName]
+		if ok {
+			return ownerId, nil
+		}
+
+		projectConfig, err := server.GetInstance(nil).ProjectConfigService.Find(&config.ProjectConfigFilter{
+			Name: &b.ProjectConfigName,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		projectConfigOwners[b.ProjectConfigName] = projectConfig.OwnerId
+		return projectConfig.OwnerId, nil
+	}
+}
+
+// CanAccessBuild returns true if the API key of the request can access the build.
+// Builds whose owner can not be resolved, e.g. because their project config was removed, can only be accessed by admins.
+func CanAccessBuild(ctx *gin.Context, b *build.Build, getOwnerId func(b *build.Build) (string, error)) bool {
+	if CanAccessAllUsers(ctx) {
+		return true
+	}
+
+	ownerId, err := getOwnerId(b)
+	if err != nil {
+		return false
+	}
+
+	return CanAccess(ctx, ownerId)
+}
@@ -4,12 +4,15 @@
 package prebuild
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
-	_ "github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // ProcessGitEvent 			godoc
@@ -27,6 +30,10 @@ func ProcessGitEvent(ctx *gin.Context) {
 
 	gitProvider, err := server.GitProviderService.GetGitProviderForHttpRequest(ctx.Request)
 	if err != nil {
+		if gitprovider.IsInvalidWebhookSignature(err) {
+			ctx.AbortWithError(http.StatusUnauthorized, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for request: %s", err.Error()))
 		return
 	}
@@ -41,6 +48,16 @@ func ProcessGitEvent(ctx *gin.Context) {
 		return
 	}
 
+	if gitEventData.IsPullRequest() {
+		// Creating and removing workspaces takes longer than git providers wait for a webhook response
+		go func(data gitprovider.GitEventData) {
+			err := server.WorkspaceService.ProcessPullRequestEvent(context.Background(), data)
+			if err != nil {
+				log.Error(err)
+			}
+		}(*gitEventData)
+	}
+
 	err = server.ProjectConfigService.ProcessGitEvent(*gitEventData)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to process git event: %s", err.Error()))
@@ -12,8 +12,11 @@ import (
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
@@ -84,6 +87,11 @@ func GetDefaultProjectConfig(ctx *gin.Context) {
 		return
 	}
 
+	if !controllers.CanAccess(ctx, projectConfigs.OwnerId) {
+		ctx.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
 	ctx.JSON(200, projectConfigs)
 }
 
@@ -106,7 +114,24 @@ func ListProjectConfigs(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, projectConfigs)
+	if scope, ok := ctx.Value("apiKeyScope").(*apikey.ApiKeyScope); ok {
+		scopedProjectConfigs := []*config.ProjectConfig{}
+		for _, pc := range projectConfigs {
+			if scope.AllowsProjectConfig(pc.Name) {
+				scopedProjectConfigs = append(scopedProjectConfigs, pc)
+			}
+		}
+		projectConfigs = scopedProjectConfigs
+	}
+
+	ownedProjectConfigs := []*config.ProjectConfig{}
+	for _, pc := range projectConfigs {
+		if controllers.CanAccess(ctx, pc.OwnerId) {
+			ownedProjectConfigs = append(ownedProjectConfigs, pc)
+		}
+	}
+
+	ctx.JSON(200, ownedProjectConfigs)
 }
 
 // SetProjectConfig godoc
@@ -132,6 +157,22 @@ func SetProjectConfig(ctx *gin.Context) {
 
 	projectConfig := conversion.ToProjectConfig(req)
 
+	existingProjectConfig, err := s.ProjectConfigService.Find(&config.ProjectConfigFilter{
+		Name: &projectConfig.Name,
+	})
+	if err == nil {
+		if !controllers.CanAccess(ctx, existingProjectConfig.OwnerId) {
+			ctx.AbortWithError(http.StatusForbidden, fmt.Errorf("project config %s is owned by another user", projectConfig.Name))
+			return
+		}
+		projectConfig.OwnerId = existingProjectConfig.OwnerId
+	} else if config.IsProjectConfigNotFound(err) {
+		projectConfig.OwnerId = user.UserId(ctx.Request.Context())
+	} else {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find project config: %s", err.Error()))
+		return
+	}
+
 	err = s.ProjectConfigService.Save(projectConfig)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to save project config: %s", err.Error()))
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -39,5 +40,5 @@ func GetTargetManifest(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, manifest)
+	ctx.JSON(200, provider.WithResourceLimits(manifest))
 }
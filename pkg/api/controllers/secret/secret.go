@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/secrets/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSecrets 			godoc
+//
+//	@Tags			secret
+//	@Summary		List secrets
+//	@Description	List secrets. Secret values are never returned.
+//	@Produce		json
+//	@Success		200	{array}	Secret
+//	@Router			/secret [get]
+//
+//	@id				ListSecrets
+func ListSecrets(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	secrets, err := server.SecretService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list secrets: %w", err))
+		return
+	}
+
+	ownedSecrets := []*secret.Secret{}
+	for _, s := range secrets {
+		if controllers.CanAccess(ctx, s.OwnerId) {
+			ownedSecrets = append(ownedSecrets, s)
+		}
+	}
+
+	ctx.JSON(200, ownedSecrets)
+}
+
+// SetSecret 			godoc
+//
+//	@Tags			secret
+//	@Summary		Set a secret
+//	@Description	Create or update a secret. Secrets are referenced in project config env vars as ${secret:NAME}.
+//	@Accept			json
+//	@Produce		json
+//	@Param			secretName	path		string			true	"Secret name"
+//	@Param			secret		body		SetSecretDTO	true	"Secret"
+//	@Success		200			{object}	Secret
+//	@Router			/secret/{secretName} [put]
+//
+//	@id				SetSecret
+func SetSecret(ctx *gin.Context) {
+	secretName := ctx.Param("secretName")
+
+	var req dto.SetSecretDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	s, err := server.SecretService.Set(ctx.Request.Context(), secretName, req)
+	if err != nil {
+		if secrets.IsInvalidSecretName(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set secret: %w", err))
+		return
+	}
+
+	ctx.JSON(200, s)
+}
+
+// DeleteSecret 			godoc
+//
+//	@Tags			secret
+//	@Summary		Delete a secret
+//	@Description	Delete a secret
+//	@Param			secretName	path	string	true	"Secret name"
+//	@Success		204
+//	@Router			/secret/{secretName} [delete]
+//
+//	@id				DeleteSecret
+func DeleteSecret(ctx *gin.Context) {
+	secretName := ctx.Param("secretName")
+
+	server := server.GetInstance(nil)
+
+	err := server.SecretService.Delete(secretName)
+	if err != nil {
+		if secret.IsSecretNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete secret: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/gin-gonic/gin"
+)
+
+// ListQuotas 			godoc
+//
+//	@Tags			server
+//	@Summary		List quotas
+//	@Description	List the quotas and the usage of the API keys and users they limit
+//	@Produce		json
+//	@Success		200	{array}	QuotaDTO
+//	@Router			/server/quota [get]
+//
+//	@id				ListQuotas
+func ListQuotas(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	quotas, err := server.QuotaService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list quotas: %w", err))
+		return
+	}
+
+	ctx.JSON(200, quotas)
+}
+
+// SetQuota 			godoc
+//
+//	@Tags			server
+//	@Summary		Set a quota
+//	@Description	Set the quota of a client API key, of a user or the default quota with the API key name "*"
+//	@Accept			json
+//	@Produce		json
+//	@Param			quota	body		Quota	true	"Quota"
+//	@Success		200		{object}	Quota
+//	@Router			/server/quota [put]
+//
+//	@id				SetQuota
+func SetQuota(ctx *gin.Context) {
+	var req quota.Quota
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	q, err := server.QuotaService.Set(req)
+	if err != nil {
+		if quota.IsInvalidQuota(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		if quotas.IsApiKeyNotFound(err) || user.IsUserNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set quota: %w", err))
+		return
+	}
+
+	ctx.JSON(200, q)
+}
+
+// DeleteQuota 			godoc
+//
+//	@Tags			server
+//	@Summary		Delete a quota
+//	@Description	Delete the quota of a client API key or the default quota with the API key name "*"
+//	@Param			apiKeyName	path	string	true	"API key name"
+//	@Success		204
+//	@Router			/server/quota/{apiKeyName} [delete]
+//
+//	@id				DeleteQuota
+func DeleteQuota(ctx *gin.Context) {
+	apiKeyName := ctx.Param("apiKeyName")
+
+	server := server.GetInstance(nil)
+
+	err := server.QuotaService.Delete(apiKeyName)
+	if err != nil {
+		if quota.IsQuotaNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete quota: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// DeleteUserQuota 			godoc
+//
+//	@Tags			server
+//	@Summary		Delete the quota of a user
+//	@Description	Delete the quota of a user
+//	@Param			userId	path	string	true	"User ID"
+//	@Success		204
+//	@Router			/server/quota/user/{userId} [delete]
+//
+//	@id				DeleteUserQuota
+func DeleteUserQuota(ctx *gin.Context) {
+	userId := ctx.Param("userId")
+
+	server := server.GetInstance(nil)
+
+	err := server.QuotaService.DeleteForUser(userId)
+	if err != nil {
+		if quota.IsQuotaNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete quota: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
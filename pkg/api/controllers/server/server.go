@@ -28,7 +28,7 @@ func GetConfig(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, config)
+	ctx.JSON(200, config.Redacted())
 }
 
 // SetConfig 			godoc
@@ -51,13 +51,27 @@ func SetConfig(ctx *gin.Context) {
 		return
 	}
 
+	existingConfig, err := server.GetConfig()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get config: %w", err))
+		return
+	}
+
+	// Clients that are not aware of the database config should not reset it
+	if c.Database == nil {
+		c.Database = existingConfig.Database
+	}
+
+	// Clients send back the redacted values they got from GetConfig
+	c.RestoreRedacted(existingConfig)
+
 	err = server.Save(c)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to save config: %w", err))
 		return
 	}
 
-	ctx.JSON(200, c)
+	ctx.JSON(200, c.Redacted())
 }
 
 // GenerateNetworkKey 		godoc
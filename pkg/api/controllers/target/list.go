@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -51,7 +52,7 @@ func ListTargets(ctx *gin.Context) {
 			continue
 		}
 
-		for name, property := range *manifest {
+		for name, property := range *provider.WithResourceLimits(manifest) {
 			if property.InputMasked {
 				delete(opts, name)
 			}
@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/server/users/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/gin-gonic/gin"
+)
+
+// ListUsers 			godoc
+//
+//	@Tags			user
+//	@Summary		List users
+//	@Description	List users
+//	@Produce		json
+//	@Success		200	{array}	User
+//	@Router			/user [get]
+//
+//	@id				ListUsers
+func ListUsers(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	users, err := server.UserService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list users: %w", err))
+		return
+	}
+
+	ctx.JSON(200, users)
+}
+
+// CreateUser 			godoc
+//
+//	@Tags			user
+//	@Summary		Create a user
+//	@Description	Create a user
+//	@Accept			json
+//	@Produce		json
+//	@Param			user	body		CreateUserDTO	true	"User"
+//	@Success		200		{object}	User
+//	@Router			/user [post]
+//
+//	@id				CreateUser
+func CreateUser(ctx *gin.Context) {
+	var req dto.CreateUserDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	u, err := server.UserService.Create(req)
+	if err != nil {
+		if users.IsInvalidUserName(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		if users.IsUserAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create user: %w", err))
+		return
+	}
+
+	ctx.JSON(200, u)
+}
+
+// DeleteUser 			godoc
+//
+//	@Tags			user
+//	@Summary		Delete a user
+//	@Description	Delete a user and revoke its API keys. Resources owned by the user are kept.
+//	@Param			userId	path	string	true	"User ID or name"
+//	@Success		204
+//	@Router			/user/{userId} [delete]
+//
+//	@id				DeleteUser
+func DeleteUser(ctx *gin.Context) {
+	userId := ctx.Param("userId")
+
+	server := server.GetInstance(nil)
+
+	err := server.UserService.Delete(userId)
+	if err != nil {
+		if user.IsUserNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete user: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
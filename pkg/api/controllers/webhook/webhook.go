@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/server/webhooks/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+// ListWebhooks 			godoc
+//
+//	@Tags			webhook
+//	@Summary		List webhooks
+//	@Description	List webhooks
+//	@Produce		json
+//	@Success		200	{array}	Webhook
+//	@Router			/webhook [get]
+//
+//	@id				ListWebhooks
+func ListWebhooks(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	webhooks, err := server.WebhookService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list webhooks: %w", err))
+		return
+	}
+
+	ctx.JSON(200, webhooks)
+}
+
+// CreateWebhook 			godoc
+//
+//	@Tags			webhook
+//	@Summary		Create a webhook
+//	@Description	Create a webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			webhook	body		CreateWebhookDTO	true	"Create webhook"
+//	@Success		200		{object}	Webhook
+//	@Router			/webhook [post]
+//
+//	@id				CreateWebhook
+func CreateWebhook(ctx *gin.Context) {
+	var req dto.CreateWebhookDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WebhookService.Create(req)
+	if err != nil {
+		if webhooks.IsInvalidWebhookUrl(err) || webhooks.IsInvalidWebhookSecret(err) || webhooks.IsInvalidEventType(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create webhook: %w", err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+// DeleteWebhook 			godoc
+//
+//	@Tags			webhook
+//	@Summary		Delete a webhook
+//	@Description	Delete a webhook and its delivery log
+//	@Param			webhookId	path	string	true	"Webhook ID"
+//	@Success		204
+//	@Router			/webhook/{webhookId} [delete]
+//
+//	@id				DeleteWebhook
+func DeleteWebhook(ctx *gin.Context) {
+	webhookId := ctx.Param("webhookId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WebhookService.Delete(webhookId)
+	if err != nil {
+		if webhook.IsWebhookNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to delete webhook: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// ListWebhookDeliveries 			godoc
+//
+//	@Tags			webhook
+//	@Summary		List webhook deliveries
+//	@Description	List delivery attempts of a webhook, newest first
+//	@Produce		json
+//	@Param			webhookId	path	string	true	"Webhook ID"
+//	@Param			limit		query	int		false	"Maximum number of deliveries to return"
+//	@Success		200			{array}	WebhookDelivery
+//	@Router			/webhook/{webhookId}/deliveries [get]
+//
+//	@id				ListWebhookDeliveries
+func ListWebhookDeliveries(ctx *gin.Context) {
+	webhookId := ctx.Param("webhookId")
+
+	var limit *int
+	if limitQuery := ctx.Query("limit"); limitQuery != "" {
+		l, err := strconv.Atoi(limitQuery)
+		if err != nil || l < 1 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid value for limit, must be a positive integer"))
+			return
+		}
+		limit = &l
+	}
+
+	server := server.GetInstance(nil)
+
+	deliveries, err := server.WebhookService.ListDeliveries(webhookId, limit)
+	if err != nil {
+		if webhook.IsWebhookNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list webhook deliveries: %w", err))
+		return
+	}
+
+	ctx.JSON(200, deliveries)
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// CloneWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Clone a workspace
+//	@Description	Create a workspace from the current state of an existing workspace
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			workspace	body	CloneWorkspaceDTO	true	"Clone workspace"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/clone [post]
+//
+//	@id				CloneWorkspace
+func CloneWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var cloneWorkspaceReq dto.CloneWorkspaceDTO
+	err := ctx.BindJSON(&cloneWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.CloneWorkspace(ctx.Request.Context(), workspaceId, cloneWorkspaceReq)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		if workspaces.IsWorkspaceAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
+			return
+		}
+		if gitprovider.IsGitProviderNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		if quota.IsQuotaExceeded(err) {
+			ctx.AbortWithError(http.StatusForbidden, err)
+			return
+		}
+		if workspaces.IsInvalidWorkspaceName(err) || workspaces.IsInvalidIdleTimeout(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to clone workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/quota"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
@@ -40,6 +42,18 @@ func CreateWorkspace(ctx *gin.Context) {
 			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
 			return
 		}
+		if gitprovider.IsGitProviderNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		if quota.IsQuotaExceeded(err) {
+			ctx.AbortWithError(http.StatusForbidden, err)
+			return
+		}
+		if workspaces.IsInvalidIdleTimeout(err) || workspaces.IsInvalidProjectResources(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %w", err))
 		return
 	}
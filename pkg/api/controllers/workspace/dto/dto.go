@@ -8,6 +8,11 @@ import (
 )
 
 type SetProjectState struct {
-	Uptime    uint64             `json:"uptime" validate:"required"`
-	GitStatus *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	Uptime         uint64             `json:"uptime" validate:"required"`
+	GitStatus      *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	ActiveSessions uint32             `json:"activeSessions,omitempty" validate:"optional"`
 } // @name SetProjectState
+
+type CreateSnapshotDTO struct {
+	Name string `json:"name,omitempty" validate:"optional"`
+} // @name CreateSnapshotDTO
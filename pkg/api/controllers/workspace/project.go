@@ -40,9 +40,10 @@ func SetProjectState(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &project.ProjectState{
-		Uptime:    setProjectStateDTO.Uptime,
-		UpdatedAt: time.Now().Format(time.RFC1123),
-		GitStatus: setProjectStateDTO.GitStatus,
+		Uptime:         setProjectStateDTO.Uptime,
+		UpdatedAt:      time.Now().Format(time.RFC1123),
+		GitStatus:      setProjectStateDTO.GitStatus,
+		ActiveSessions: setProjectStateDTO.ActiveSessions,
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
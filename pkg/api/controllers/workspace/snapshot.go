@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/snapshot"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSnapshots 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List project snapshots
+//	@Description	List project snapshots
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Produce		json
+//	@Success		200	{array}	Snapshot
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshots [get]
+//
+//	@id				ListSnapshots
+func ListSnapshots(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	snapshots, err := server.WorkspaceService.ListSnapshots(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list snapshots of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, snapshots)
+}
+
+// CreateSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Create project snapshot
+//	@Description	Create project snapshot
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			snapshot	body	CreateSnapshotDTO	true	"Create snapshot"
+//	@Produce		json
+//	@Success		200	{object}	Snapshot
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshots [post]
+//
+//	@id				CreateSnapshot
+func CreateSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.CreateSnapshotDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	s, err := server.WorkspaceService.SnapshotProject(ctx.Request.Context(), workspaceId, projectId, req.Name)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		if workspaces.IsInvalidSnapshotName(err) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+		if workspaces.IsSnapshotAlreadyExists(err) {
+			ctx.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create snapshot of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, s)
+}
+
+// RestoreSnapshot 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Restore project snapshot
+//	@Description	Restore project from a snapshot
+//	@Param			workspaceId		path	string	true	"Workspace ID or Name"
+//	@Param			projectId		path	string	true	"Project ID"
+//	@Param			snapshotName	path	string	true	"Snapshot name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/snapshots/{snapshotName}/restore [post]
+//
+//	@id				RestoreSnapshot
+func RestoreSnapshot(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	snapshotName := ctx.Param("snapshotName")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RestoreProject(ctx.Request.Context(), workspaceId, projectId, snapshotName)
+	if err != nil {
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || snapshot.IsSnapshotNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to restore project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
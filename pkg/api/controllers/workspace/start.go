@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/quota"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -28,6 +29,10 @@ func StartWorkspace(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StartWorkspace(ctx.Request.Context(), workspaceId)
 	if err != nil {
+		if quota.IsQuotaExceeded(err) {
+			ctx.AbortWithError(http.StatusForbidden, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start workspace %s: %w", workspaceId, err))
 		return
 	}
@@ -54,6 +59,10 @@ func StartProject(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StartProject(ctx.Request.Context(), workspaceId, projectId)
 	if err != nil {
+		if quota.IsQuotaExceeded(err) {
+			ctx.AbortWithError(http.StatusForbidden, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start project %s: %w", projectId, err))
 		return
 	}
@@ -17,6 +17,8 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // GetProjectDir 			godoc
@@ -66,6 +68,11 @@ func forwardRequestToToolbox(ctx *gin.Context) {
 		return
 	}
 
+	err = server.WorkspaceService.RecordProjectActivity(w.Id, projectId)
+	if err != nil {
+		log.Error(err)
+	}
+
 	var client *http.Client
 
 	projectHostname := project.GetProjectHostname(w.Id, projectId)
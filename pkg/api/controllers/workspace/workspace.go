@@ -9,7 +9,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/gin-gonic/gin"
 )
 
@@ -82,7 +85,24 @@ func ListWorkspaces(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(200, workspaceList)
+	if scope, ok := ctx.Value("apiKeyScope").(*apikey.ApiKeyScope); ok {
+		scopedWorkspaceList := []dto.WorkspaceDTO{}
+		for _, w := range workspaceList {
+			if scope.AllowsWorkspace(w.Id) {
+				scopedWorkspaceList = append(scopedWorkspaceList, w)
+			}
+		}
+		workspaceList = scopedWorkspaceList
+	}
+
+	ownedWorkspaceList := []dto.WorkspaceDTO{}
+	for _, w := range workspaceList {
+		if controllers.CanAccess(ctx, w.OwnerId) {
+			ownedWorkspaceList = append(ownedWorkspaceList, w)
+		}
+	}
+
+	ctx.JSON(200, ownedWorkspaceList)
 }
 
 // RemoveWorkspace 			godoc
@@ -42,6 +42,9 @@ const docTemplate = `{
         "/apikey/{apiKeyName}": {
             "post": {
                 "description": "Generate an API key",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "text/plain"
                 ],
@@ -57,6 +60,14 @@ const docTemplate = `{
                         "name": "apiKeyName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "API key options",
+                        "name": "apiKey",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/GenerateApiKeyDTO"
+                        }
                     }
                 ],
                 "responses": {
@@ -91,6 +102,168 @@ const docTemplate = `{
                 }
             }
         },
+        "/audit": {
+            "get": {
+                "description": "List audit logs of mutating API operations, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "List audit logs",
+                "operationId": "ListAuditLogs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key name",
+                        "name": "apiKeyName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "HTTP method",
+                        "name": "method",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Outcome (success or failure)",
+                        "name": "outcome",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only return logs created at or after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only return logs created at or before this time (RFC3339)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of logs to return",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuditLog"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/device": {
+            "post": {
+                "description": "Start a device authorization at the OIDC provider of the server. The user logs in at the verification URI with the user code.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Start an OIDC device login",
+                "operationId": "StartOidcDeviceLogin",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/DeviceLoginDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/refresh": {
+            "post": {
+                "description": "Get a new short-lived API key with the refresh token of a previous login",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Refresh an OIDC login",
+                "operationId": "RefreshOidcLogin",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RefreshLoginDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LoginDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/oidc/token": {
+            "post": {
+                "description": "Poll for the completion of a device login. Responds with 202 while the login is pending and 429 when polled too frequently.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Complete an OIDC device login",
+                "operationId": "CompleteOidcDeviceLogin",
+                "parameters": [
+                    {
+                        "description": "Device login",
+                        "name": "deviceLogin",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CompleteDeviceLoginDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/LoginDTO"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted"
+                    }
+                }
+            }
+        },
         "/build": {
             "get": {
                 "description": "List builds",
@@ -145,7 +318,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete ALL builds",
+                "description": "Delete ALL builds the API key can access",
                 "tags": [
                     "build"
                 ],
@@ -196,6 +369,35 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/prune": {
+            "post": {
+                "description": "Mark the published builds that exceed the retention of their prebuild for deletion",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "Prune builds",
+                "operationId": "PruneBuilds",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "List the builds that would be pruned without deleting them",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildPruneResult"
+                        }
+                    }
+                }
+            }
+        },
         "/build/{buildId}": {
             "get": {
                 "description": "Get build data",
@@ -254,6 +456,60 @@ const docTemplate = `{
                 }
             }
         },
+        "/build/{buildId}/cancel": {
+            "post": {
+                "description": "Cancel a queued or running build",
+                "tags": [
+                    "build"
+                ],
+                "summary": "Cancel build",
+                "operationId": "CancelBuild",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/build/{buildId}/sbom": {
+            "get": {
+                "description": "Get the SBOM of the build artifact including the SBOM document",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build"
+                ],
+                "summary": "Get build SBOM",
+                "operationId": "GetBuildSbom",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BuildSbom"
+                        }
+                    }
+                }
+            }
+        },
         "/container-registry": {
             "get": {
                 "description": "List container registries",
@@ -1410,28 +1666,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/server/config": {
+        "/secret": {
             "get": {
-                "description": "Get the server configuration",
+                "description": "List secrets. Secret values are never returned.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "secret"
                 ],
-                "summary": "Get the server configuration",
-                "operationId": "GetConfig",
+                "summary": "List secrets",
+                "operationId": "ListSecrets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ServerConfig"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Secret"
+                            }
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Set the server configuration",
+            }
+        },
+        "/secret/{secretName}": {
+            "put": {
+                "description": "Create or update a secret. Secrets are referenced in project config env vars as ${secret:NAME}.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1439,18 +1700,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "secret"
                 ],
-                "summary": "Set the server configuration",
-                "operationId": "SetConfig",
+                "summary": "Set a secret",
+                "operationId": "SetSecret",
                 "parameters": [
                     {
-                        "description": "Server configuration",
-                        "name": "config",
+                        "type": "string",
+                        "description": "Secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Secret",
+                        "name": "secret",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ServerConfig"
+                            "$ref": "#/definitions/SetSecretDTO"
                         }
                     }
                 ],
@@ -1458,41 +1726,117 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ServerConfig"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 }
+            },
+            "delete": {
+                "description": "Delete a secret",
+                "tags": [
+                    "secret"
+                ],
+                "summary": "Delete a secret",
+                "operationId": "DeleteSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
             }
         },
-        "/server/logs": {
+        "/server/config": {
             "get": {
-                "description": "List server log files",
+                "description": "Get the server configuration",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "server"
                 ],
-                "summary": "List server log files",
-                "operationId": "GetServerLogFiles",
+                "summary": "Get the server configuration",
+                "operationId": "GetConfig",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/ServerConfig"
                         }
                     }
                 }
-            }
-        },
-        "/server/network-key": {
+            },
             "post": {
-                "description": "Generate a new authentication key",
-                "produces": [
-                    "application/json"
+                "description": "Set the server configuration",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Set the server configuration",
+                "operationId": "SetConfig",
+                "parameters": [
+                    {
+                        "description": "Server configuration",
+                        "name": "config",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ServerConfig"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ServerConfig"
+                        }
+                    }
+                }
+            }
+        },
+        "/server/logs": {
+            "get": {
+                "description": "List server log files",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "List server log files",
+                "operationId": "GetServerLogFiles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/server/network-key": {
+            "post": {
+                "description": "Generate a new authentication key",
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
                     "server"
@@ -1509,6 +1853,111 @@ const docTemplate = `{
                 }
             }
         },
+        "/server/quota": {
+            "get": {
+                "description": "List the quotas and the usage of the API keys and users they limit",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "List quotas",
+                "operationId": "ListQuotas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/QuotaDTO"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set the quota of a client API key, of a user or the default quota with the API key name \"*\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Set a quota",
+                "operationId": "SetQuota",
+                "parameters": [
+                    {
+                        "description": "Quota",
+                        "name": "quota",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Quota"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Quota"
+                        }
+                    }
+                }
+            }
+        },
+        "/server/quota/user/{userId}": {
+            "delete": {
+                "description": "Delete the quota of a user",
+                "tags": [
+                    "server"
+                ],
+                "summary": "Delete the quota of a user",
+                "operationId": "DeleteUserQuota",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/server/quota/{apiKeyName}": {
+            "delete": {
+                "description": "Delete the quota of a client API key or the default quota with the API key name \"*\"",
+                "tags": [
+                    "server"
+                ],
+                "summary": "Delete a quota",
+                "operationId": "DeleteQuota",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key name",
+                        "name": "apiKeyName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/target": {
             "get": {
                 "description": "List targets",
@@ -1605,6 +2054,207 @@ const docTemplate = `{
                 }
             }
         },
+        "/user": {
+            "get": {
+                "description": "List users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List users",
+                "operationId": "ListUsers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/User"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Create a user",
+                "operationId": "CreateUser",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userId}": {
+            "delete": {
+                "description": "Delete a user and revoke its API keys. Resources owned by the user are kept.",
+                "tags": [
+                    "user"
+                ],
+                "summary": "Delete a user",
+                "operationId": "DeleteUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID or name",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/webhook": {
+            "get": {
+                "description": "List webhooks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhooks",
+                "operationId": "ListWebhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Webhook"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a webhook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Create a webhook",
+                "operationId": "CreateWebhook",
+                "parameters": [
+                    {
+                        "description": "Create webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWebhookDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}": {
+            "delete": {
+                "description": "Delete a webhook and its delivery log",
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Delete a webhook",
+                "operationId": "DeleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/webhook/{webhookId}/deliveries": {
+            "get": {
+                "description": "List delivery attempts of a webhook, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "List webhook deliveries",
+                "operationId": "ListWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of deliveries to return",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/workspace": {
             "get": {
                 "description": "List workspaces",
@@ -1731,6 +2381,45 @@ const docTemplate = `{
                 }
             }
         },
+        "/workspace/{workspaceId}/clone": {
+            "post": {
+                "description": "Create a workspace from the current state of an existing workspace",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Clone a workspace",
+                "operationId": "CloneWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clone workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CloneWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
         "/workspace/{workspaceId}/start": {
             "post": {
                 "description": "Start workspace",
@@ -1766,8 +2455,130 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/snapshots": {
+            "get": {
+                "description": "List project snapshots",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List project snapshots",
+                "operationId": "ListSnapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Snapshot"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create project snapshot",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Create project snapshot",
+                "operationId": "CreateSnapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create snapshot",
+                        "name": "snapshot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSnapshotDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Snapshot"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/snapshots/{snapshotName}/restore": {
+            "post": {
+                "description": "Restore project from a snapshot",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Restore project snapshot",
+                "operationId": "RestoreSnapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot name",
+                        "name": "snapshotName",
                         "in": "path",
                         "required": true
                     }
@@ -3238,15 +4049,103 @@ const docTemplate = `{
                 "type"
             ],
             "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
                 "keyHash": {
                     "type": "string"
                 },
+                "lastUsedAt": {
+                    "type": "string"
+                },
                 "name": {
                     "description": "Project or client name",
                     "type": "string"
                 },
+                "role": {
+                    "$ref": "#/definitions/apikey.ApiKeyRole"
+                },
+                "scope": {
+                    "$ref": "#/definitions/ApiKeyScope"
+                },
                 "type": {
                     "$ref": "#/definitions/apikey.ApiKeyType"
+                },
+                "userId": {
+                    "description": "ID of the user that owns the client API key",
+                    "type": "string"
+                }
+            }
+        },
+        "ApiKeyScope": {
+            "type": "object",
+            "properties": {
+                "projectConfigNames": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workspaceIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "AuditLog": {
+            "type": "object",
+            "required": [
+                "apiKeyName",
+                "apiKeyType",
+                "createdAt",
+                "duration",
+                "id",
+                "method",
+                "outcome",
+                "path",
+                "resourceId",
+                "route",
+                "statusCode"
+            ],
+            "properties": {
+                "apiKeyName": {
+                    "type": "string"
+                },
+                "apiKeyType": {
+                    "$ref": "#/definitions/apikey.ApiKeyType"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Request duration in milliseconds",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "$ref": "#/definitions/audit.Outcome"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "resourceId": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
                 }
             }
         },
@@ -3263,6 +4162,9 @@ const docTemplate = `{
                 "updatedAt"
             ],
             "properties": {
+                "artifact": {
+                    "$ref": "#/definitions/BuildArtifact"
+                },
                 "buildConfig": {
                     "$ref": "#/definitions/BuildConfig"
                 },
@@ -3284,15 +4186,30 @@ const docTemplate = `{
                 "image": {
                     "type": "string"
                 },
+                "layerCache": {
+                    "$ref": "#/definitions/LayerCacheStats"
+                },
                 "prebuildId": {
                     "type": "string"
                 },
+                "priority": {
+                    "$ref": "#/definitions/build.BuildPriority"
+                },
+                "projectConfigName": {
+                    "type": "string"
+                },
+                "queuePosition": {
+                    "type": "integer"
+                },
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
                 "state": {
                     "$ref": "#/definitions/build.BuildState"
                 },
+                "timeout": {
+                    "type": "integer"
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -3301,6 +4218,33 @@ const docTemplate = `{
                 }
             }
         },
+        "BuildArtifact": {
+            "type": "object",
+            "required": [
+                "commitSha",
+                "compressedSize",
+                "imageDigest"
+            ],
+            "properties": {
+                "baseImage": {
+                    "type": "string"
+                },
+                "commitSha": {
+                    "type": "string"
+                },
+                "compressedSize": {
+                    "description": "Sum of the compressed layer sizes and the image config size in bytes",
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "imageDigest": {
+                    "type": "string"
+                },
+                "sbom": {
+                    "$ref": "#/definitions/BuildSbom"
+                }
+            }
+        },
         "BuildConfig": {
             "type": "object",
             "properties": {
@@ -3309,6 +4253,55 @@ const docTemplate = `{
                 },
                 "devcontainer": {
                     "$ref": "#/definitions/DevcontainerConfig"
+                },
+                "dockerfile": {
+                    "$ref": "#/definitions/DockerfileConfig"
+                },
+                "nix": {
+                    "$ref": "#/definitions/NixConfig"
+                }
+            }
+        },
+        "BuildPruneResult": {
+            "type": "object",
+            "required": [
+                "builds",
+                "dryRun",
+                "reclaimedSpace"
+            ],
+            "properties": {
+                "builds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PrunedBuild"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "reclaimedSpace": {
+                    "description": "Compressed size in bytes of the pruned images that are not shared with remaining builds",
+                    "type": "integer",
+                    "format": "int64"
+                }
+            }
+        },
+        "BuildSbom": {
+            "type": "object",
+            "required": [
+                "format",
+                "packages"
+            ],
+            "properties": {
+                "document": {
+                    "description": "Stored separately from the build and only returned by the build SBOM endpoint",
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "packages": {
+                    "type": "integer"
                 }
             }
         },
@@ -3338,6 +4331,35 @@ const docTemplate = `{
                 "CloneTargetCommit"
             ]
         },
+        "CloneWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "idleTimeout": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "CompleteDeviceLoginDTO": {
+            "type": "object",
+            "required": [
+                "deviceCode"
+            ],
+            "properties": {
+                "deviceCode": {
+                    "type": "string"
+                }
+            }
+        },
         "CompletionContext": {
             "type": "object",
             "required": [
@@ -3465,15 +4487,27 @@ const docTemplate = `{
                 "branch": {
                     "type": "string"
                 },
+                "buildTimeout": {
+                    "type": "integer"
+                },
                 "commitInterval": {
                     "type": "integer"
                 },
+                "forkPullRequests": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
+                "pullRequests": {
+                    "type": "boolean"
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "reviewWorkspace": {
+                    "type": "boolean"
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -3511,6 +4545,9 @@ const docTemplate = `{
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ProjectResources"
+                },
                 "user": {
                     "type": "string"
                 }
@@ -3542,77 +4579,208 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ProjectResources"
+                },
                 "source": {
                     "$ref": "#/definitions/CreateProjectSourceDTO"
                 },
-                "user": {
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateProjectSourceDTO": {
+            "type": "object",
+            "required": [
+                "repository"
+            ],
+            "properties": {
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                }
+            }
+        },
+        "CreateProviderTargetDTO": {
+            "type": "object",
+            "required": [
+                "name",
+                "options",
+                "providerInfo"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "string"
+                },
+                "providerInfo": {
+                    "$ref": "#/definitions/provider.ProviderInfo"
+                }
+            }
+        },
+        "CreateSnapshotDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateUserDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateWebhookDTO": {
+            "type": "object",
+            "required": [
+                "secret",
+                "url"
+            ],
+            "properties": {
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/events.EventType"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name",
+                "projects",
+                "target"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "idleTimeout": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreateProjectDTO"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "DatabaseConfig": {
+            "type": "object",
+            "required": [
+                "driver",
+                "dsn"
+            ],
+            "properties": {
+                "driver": {
+                    "description": "sqlite or postgres",
+                    "type": "string"
+                },
+                "dsn": {
+                    "description": "Database file path for sqlite or connection string for postgres. The password is redacted in API responses",
                     "type": "string"
                 }
             }
         },
-        "CreateProjectSourceDTO": {
+        "DevcontainerConfig": {
             "type": "object",
             "required": [
-                "repository"
+                "filePath"
             ],
             "properties": {
-                "repository": {
-                    "$ref": "#/definitions/GitRepository"
+                "filePath": {
+                    "type": "string"
                 }
             }
         },
-        "CreateProviderTargetDTO": {
+        "DeviceLoginDTO": {
             "type": "object",
             "required": [
-                "name",
-                "options",
-                "providerInfo"
+                "deviceCode",
+                "expiresAt",
+                "interval",
+                "userCode",
+                "verificationUri"
             ],
             "properties": {
-                "name": {
+                "deviceCode": {
                     "type": "string"
                 },
-                "options": {
+                "expiresAt": {
                     "type": "string"
                 },
-                "providerInfo": {
-                    "$ref": "#/definitions/provider.ProviderInfo"
+                "interval": {
+                    "description": "Seconds to wait between polls for the login to complete",
+                    "type": "integer"
+                },
+                "userCode": {
+                    "description": "Code the user enters at the verification URI",
+                    "type": "string"
+                },
+                "verificationUri": {
+                    "type": "string"
+                },
+                "verificationUriComplete": {
+                    "type": "string"
                 }
             }
         },
-        "CreateWorkspaceDTO": {
+        "DockerfileConfig": {
             "type": "object",
             "required": [
-                "id",
-                "name",
-                "projects",
-                "target"
+                "path"
             ],
             "properties": {
-                "id": {
-                    "type": "string"
+                "buildArgs": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "name": {
+                "context": {
                     "type": "string"
                 },
-                "projects": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CreateProjectDTO"
-                    }
+                "path": {
+                    "type": "string"
                 },
                 "target": {
                     "type": "string"
                 }
             }
         },
-        "DevcontainerConfig": {
+        "EncryptionConfig": {
             "type": "object",
-            "required": [
-                "filePath"
-            ],
             "properties": {
-                "filePath": {
+                "keyCommand": {
+                    "description": "Command used instead of the key file, e.g. a wrapper around a KMS. It is called with an additional encrypt\nor decrypt argument, reads a base64 encoded key from stdin and writes the base64 encoded result to stdout",
+                    "type": "string"
+                },
+                "keyFile": {
+                    "description": "Path of the key file. Defaults to encryption.key in the server config directory, which is generated\non first start if the database has no encrypted credentials yet",
                     "type": "string"
                 }
             }
@@ -3728,6 +4896,24 @@ const docTemplate = `{
                 }
             }
         },
+        "GenerateApiKeyDTO": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/apikey.ApiKeyRole"
+                },
+                "scope": {
+                    "$ref": "#/definitions/ApiKeyScope"
+                },
+                "userId": {
+                    "description": "ID or name of the user that owns the API key",
+                    "type": "string"
+                }
+            }
+        },
         "GetRepositoryContext": {
             "type": "object",
             "required": [
@@ -3934,6 +5120,10 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "ownerId": {
+                    "description": "ID of the user that owns the Git provider config",
+                    "type": "string"
+                },
                 "providerId": {
                     "type": "string"
                 },
@@ -4115,6 +5305,26 @@ const docTemplate = `{
                 }
             }
         },
+        "LayerCacheStats": {
+            "type": "object",
+            "required": [
+                "cacheImage",
+                "cachedSteps",
+                "totalSteps"
+            ],
+            "properties": {
+                "cacheImage": {
+                    "description": "Image in the builder registry that the layer cache was imported from",
+                    "type": "string"
+                },
+                "cachedSteps": {
+                    "type": "integer"
+                },
+                "totalSteps": {
+                    "type": "integer"
+                }
+            }
+        },
         "ListBranchResponse": {
             "type": "object",
             "required": [
@@ -4158,6 +5368,35 @@ const docTemplate = `{
                 }
             }
         },
+        "LoginDTO": {
+            "type": "object",
+            "required": [
+                "apiKey",
+                "expiresAt",
+                "role",
+                "user"
+            ],
+            "properties": {
+                "apiKey": {
+                    "description": "Short-lived client API key of the user",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "description": "Refresh token of the OIDC provider used to get a new API key before it expires",
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/apikey.ApiKeyRole"
+                },
+                "user": {
+                    "description": "Name of the user that logged in",
+                    "type": "string"
+                }
+            }
+        },
         "LspCompletionParams": {
             "type": "object",
             "required": [
@@ -4312,6 +5551,65 @@ const docTemplate = `{
                 }
             }
         },
+        "NixConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "devShell": {
+                    "type": "string"
+                },
+                "filePath": {
+                    "type": "string"
+                }
+            }
+        },
+        "OidcConfig": {
+            "type": "object",
+            "required": [
+                "clientId",
+                "issuer"
+            ],
+            "properties": {
+                "apiKeyTtl": {
+                    "description": "Lifetime of the API keys minted on login in minutes. Defaults to 60",
+                    "type": "integer"
+                },
+                "clientId": {
+                    "type": "string"
+                },
+                "clientSecret": {
+                    "type": "string"
+                },
+                "defaultRole": {
+                    "description": "Role of users without a mapped group. Users without a mapped group are denied if empty",
+                    "type": "string"
+                },
+                "groupsClaim": {
+                    "description": "ID token claim that lists the groups of the user. Defaults to groups",
+                    "type": "string"
+                },
+                "issuer": {
+                    "description": "URL of the OpenID Connect issuer",
+                    "type": "string"
+                },
+                "roleMapping": {
+                    "description": "Maps groups to API key roles. Users in several mapped groups get the most privileged role (admin, developer, ci, read-only)",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "scopes": {
+                    "description": "Additional scopes to request next to openid, e.g. offline_access for refresh tokens",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "Position": {
             "type": "object",
             "required": [
@@ -4340,15 +5638,31 @@ const docTemplate = `{
                 "branch": {
                     "type": "string"
                 },
+                "buildTimeout": {
+                    "description": "Minutes after which builds of the prebuild are stopped - overrides the server build timeout",
+                    "type": "integer"
+                },
                 "commitInterval": {
                     "type": "integer"
                 },
+                "forkPullRequests": {
+                    "description": "Also targets pull requests from forks. Their code is not trusted and is built and run with the configuration of the project config",
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
+                "pullRequests": {
+                    "description": "Targets pull requests instead of pushes - Branch then holds the base branch and an empty Branch matches all pull requests",
+                    "type": "boolean"
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "reviewWorkspace": {
+                    "description": "Creates a workspace for each matching pull request and removes it once the pull request is closed",
+                    "type": "boolean"
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -4369,18 +5683,30 @@ const docTemplate = `{
                 "branch": {
                     "type": "string"
                 },
+                "buildTimeout": {
+                    "type": "integer"
+                },
                 "commitInterval": {
                     "type": "integer"
                 },
+                "forkPullRequests": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
                 "projectConfigName": {
                     "type": "string"
                 },
+                "pullRequests": {
+                    "type": "boolean"
+                },
                 "retention": {
                     "type": "integer"
                 },
+                "reviewWorkspace": {
+                    "type": "boolean"
+                },
                 "triggerFiles": {
                     "type": "array",
                     "items": {
@@ -4436,6 +5762,9 @@ const docTemplate = `{
                 "repository": {
                     "$ref": "#/definitions/GitRepository"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ProjectResources"
+                },
                 "state": {
                     "$ref": "#/definitions/ProjectState"
                 },
@@ -4482,6 +5811,10 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "ownerId": {
+                    "description": "ID of the user that owns the project config",
+                    "type": "string"
+                },
                 "prebuilds": {
                     "type": "array",
                     "items": {
@@ -4491,6 +5824,9 @@ const docTemplate = `{
                 "repositoryUrl": {
                     "type": "string"
                 },
+                "resources": {
+                    "$ref": "#/definitions/ProjectResources"
+                },
                 "user": {
                     "type": "string"
                 }
@@ -4513,87 +5849,249 @@ const docTemplate = `{
                 "workspaceId"
             ],
             "properties": {
-                "created": {
+                "created": {
+                    "type": "string"
+                },
+                "isRunning": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providerMetadata": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectResources": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "description": "Number of CPU cores, fractional values limit the container to a share of a core",
+                    "type": "number"
+                },
+                "disk": {
+                    "description": "Disk quota in GB",
+                    "type": "integer"
+                },
+                "memory": {
+                    "description": "Memory limit in MB",
+                    "type": "integer"
+                },
+                "pidsLimit": {
+                    "description": "Maximum number of processes in the container",
+                    "type": "integer"
+                }
+            }
+        },
+        "ProjectState": {
+            "type": "object",
+            "required": [
+                "updatedAt",
+                "uptime"
+            ],
+            "properties": {
+                "activeSessions": {
+                    "type": "integer"
+                },
+                "gitStatus": {
+                    "$ref": "#/definitions/GitStatus"
+                },
+                "lastActivityAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "uptime": {
+                    "type": "integer"
+                }
+            }
+        },
+        "Provider": {
+            "type": "object",
+            "required": [
+                "name",
+                "version"
+            ],
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProviderTarget": {
+            "type": "object",
+            "required": [
+                "isDefault",
+                "name",
+                "options",
+                "providerInfo"
+            ],
+            "properties": {
+                "isDefault": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "description": "JSON encoded map of options",
+                    "type": "string"
+                },
+                "providerInfo": {
+                    "$ref": "#/definitions/provider.ProviderInfo"
+                }
+            }
+        },
+        "ProviderTargetManifest": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/provider.ProviderTargetProperty"
+            }
+        },
+        "PrunedBuild": {
+            "type": "object",
+            "required": [
+                "id",
+                "prebuildId",
+                "projectConfigName",
+                "size"
+            ],
+            "properties": {
+                "id": {
                     "type": "string"
                 },
-                "isRunning": {
-                    "type": "boolean"
-                },
-                "name": {
+                "image": {
                     "type": "string"
                 },
-                "providerMetadata": {
+                "prebuildId": {
                     "type": "string"
                 },
-                "workspaceId": {
+                "projectConfigName": {
                     "type": "string"
+                },
+                "size": {
+                    "description": "Compressed size in bytes of the image or 0 if the size was not recorded",
+                    "type": "integer",
+                    "format": "int64"
                 }
             }
         },
-        "ProjectState": {
+        "Quota": {
             "type": "object",
-            "required": [
-                "updatedAt",
-                "uptime"
-            ],
             "properties": {
-                "gitStatus": {
-                    "$ref": "#/definitions/GitStatus"
-                },
-                "updatedAt": {
+                "apiKeyName": {
+                    "description": "Name of the client API key or \"*\" for the default quota. Empty for the quota of a user",
                     "type": "string"
                 },
-                "uptime": {
+                "maxCpus": {
+                    "description": "Maximum sum of CPU cores requested by running projects",
+                    "type": "number"
+                },
+                "maxDisk": {
+                    "description": "Maximum sum of disk in GB requested by all projects",
+                    "type": "integer"
+                },
+                "maxMemory": {
+                    "description": "Maximum sum of memory in MB requested by running projects",
+                    "type": "integer"
+                },
+                "maxRunningProjects": {
+                    "description": "Maximum number of running projects",
+                    "type": "integer"
+                },
+                "maxWorkspaces": {
+                    "description": "Maximum number of workspaces",
                     "type": "integer"
+                },
+                "userId": {
+                    "description": "ID of the user whose workspaces are limited across all of their API keys",
+                    "type": "string"
                 }
             }
         },
-        "Provider": {
+        "QuotaDTO": {
             "type": "object",
-            "required": [
-                "name",
-                "version"
-            ],
             "properties": {
-                "label": {
+                "apiKeyName": {
+                    "description": "Name of the client API key or \"*\" for the default quota. Empty for the quota of a user",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "maxCpus": {
+                    "description": "Maximum sum of CPU cores requested by running projects",
+                    "type": "number"
                 },
-                "version": {
+                "maxDisk": {
+                    "description": "Maximum sum of disk in GB requested by all projects",
+                    "type": "integer"
+                },
+                "maxMemory": {
+                    "description": "Maximum sum of memory in MB requested by running projects",
+                    "type": "integer"
+                },
+                "maxRunningProjects": {
+                    "description": "Maximum number of running projects",
+                    "type": "integer"
+                },
+                "maxWorkspaces": {
+                    "description": "Maximum number of workspaces",
+                    "type": "integer"
+                },
+                "usage": {
+                    "$ref": "#/definitions/QuotaUsage"
+                },
+                "userId": {
+                    "description": "ID of the user whose workspaces are limited across all of their API keys",
                     "type": "string"
                 }
             }
         },
-        "ProviderTarget": {
+        "QuotaUsage": {
             "type": "object",
             "required": [
-                "isDefault",
-                "name",
-                "options",
-                "providerInfo"
+                "cpus",
+                "disk",
+                "memory",
+                "runningProjects",
+                "workspaces"
             ],
             "properties": {
-                "isDefault": {
-                    "type": "boolean"
+                "cpus": {
+                    "type": "number"
                 },
-                "name": {
-                    "type": "string"
+                "disk": {
+                    "type": "integer"
                 },
-                "options": {
-                    "description": "JSON encoded map of options",
-                    "type": "string"
+                "memory": {
+                    "type": "integer"
                 },
-                "providerInfo": {
-                    "$ref": "#/definitions/provider.ProviderInfo"
+                "runningProjects": {
+                    "type": "integer"
+                },
+                "workspaces": {
+                    "type": "integer"
                 }
             }
         },
-        "ProviderTargetManifest": {
+        "RefreshLoginDTO": {
             "type": "object",
-            "additionalProperties": {
-                "$ref": "#/definitions/provider.ProviderTargetProperty"
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
             }
         },
         "ReplaceRequest": {
@@ -4676,6 +6174,29 @@ const docTemplate = `{
                 }
             }
         },
+        "Secret": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "name",
+                "updatedAt"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "description": "Secrets without an owner are shared by all users",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
         "ServerConfig": {
             "type": "object",
             "required": [
@@ -4704,18 +6225,27 @@ const docTemplate = `{
                 "buildImageNamespace": {
                     "type": "string"
                 },
+                "buildTimeout": {
+                    "type": "integer"
+                },
                 "builderImage": {
                     "type": "string"
                 },
                 "builderRegistryServer": {
                     "type": "string"
                 },
+                "database": {
+                    "$ref": "#/definitions/DatabaseConfig"
+                },
                 "defaultProjectImage": {
                     "type": "string"
                 },
                 "defaultProjectUser": {
                     "type": "string"
                 },
+                "encryption": {
+                    "$ref": "#/definitions/EncryptionConfig"
+                },
                 "frps": {
                     "$ref": "#/definitions/FRPSConfig"
                 },
@@ -4725,6 +6255,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "localBuilderRegistryImage": {
                     "type": "string"
                 },
@@ -4734,6 +6267,19 @@ const docTemplate = `{
                 "logFile": {
                     "$ref": "#/definitions/LogFileConfig"
                 },
+                "maxConcurrentBuilds": {
+                    "type": "integer"
+                },
+                "maxProjectConfigBuilds": {
+                    "type": "integer"
+                },
+                "oidc": {
+                    "$ref": "#/definitions/OidcConfig"
+                },
+                "prebuildWebhookSecret": {
+                    "description": "Secret that git providers sign prebuild webhook events with. Generated on first start",
+                    "type": "string"
+                },
                 "providersDir": {
                     "type": "string"
                 },
@@ -4787,6 +6333,9 @@ const docTemplate = `{
                 "uptime"
             ],
             "properties": {
+                "activeSessions": {
+                    "type": "integer"
+                },
                 "gitStatus": {
                     "$ref": "#/definitions/GitStatus"
                 },
@@ -4795,6 +6344,17 @@ const docTemplate = `{
                 }
             }
         },
+        "SetSecretDTO": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "SigningMethod": {
             "type": "string",
             "enum": [
@@ -4806,6 +6366,29 @@ const docTemplate = `{
                 "SigningMethodGPG"
             ]
         },
+        "Snapshot": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "name",
+                "projectName",
+                "workspaceId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
         "Status": {
             "type": "string",
             "enum": [
@@ -4829,6 +6412,107 @@ const docTemplate = `{
                 "UpdatedButUnmerged"
             ]
         },
+        "User": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "description": "Issuer and subject of the OIDC identity of users that log in with OIDC",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "Webhook": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "eventTypes",
+                "id",
+                "url"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "eventTypes": {
+                    "description": "Empty list subscribes to all event types",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/events.EventType"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookDelivery": {
+            "type": "object",
+            "required": [
+                "attempt",
+                "createdAt",
+                "duration",
+                "eventId",
+                "eventType",
+                "id",
+                "statusCode",
+                "success",
+                "webhookId"
+            ],
+            "properties": {
+                "attempt": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Request duration in milliseconds",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "$ref": "#/definitions/events.EventType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "description": "Status code of the response, 0 if no response was received",
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
         "Workspace": {
             "type": "object",
             "required": [
@@ -4838,12 +6522,23 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "createdBy": {
+                    "description": "Name of the client API key that created the workspace",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
+                "ownerId": {
+                    "description": "ID of the user that owns the workspace",
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
@@ -4864,15 +6559,26 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "createdBy": {
+                    "description": "Name of the client API key that created the workspace",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "idleTimeout": {
+                    "type": "integer"
+                },
                 "info": {
                     "$ref": "#/definitions/WorkspaceInfo"
                 },
                 "name": {
                     "type": "string"
                 },
+                "ownerId": {
+                    "description": "ID of the user that owns the workspace",
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
@@ -4905,6 +6611,21 @@ const docTemplate = `{
                 }
             }
         },
+        "apikey.ApiKeyRole": {
+            "type": "string",
+            "enum": [
+                "admin",
+                "developer",
+                "read-only",
+                "ci"
+            ],
+            "x-enum-varnames": [
+                "ApiKeyRoleAdmin",
+                "ApiKeyRoleDeveloper",
+                "ApiKeyRoleReadOnly",
+                "ApiKeyRoleCI"
+            ]
+        },
         "apikey.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -4918,6 +6639,28 @@ const docTemplate = `{
                 "ApiKeyTypeWorkspace"
             ]
         },
+        "audit.Outcome": {
+            "type": "string",
+            "enum": [
+                "success",
+                "failure"
+            ],
+            "x-enum-varnames": [
+                "OutcomeSuccess",
+                "OutcomeFailure"
+            ]
+        },
+        "build.BuildPriority": {
+            "type": "string",
+            "enum": [
+                "normal",
+                "high"
+            ],
+            "x-enum-varnames": [
+                "BuildPriorityNormal",
+                "BuildPriorityHigh"
+            ]
+        },
         "build.BuildState": {
             "type": "string",
             "enum": [
@@ -4928,7 +6671,10 @@ const docTemplate = `{
                 "published",
                 "pending-delete",
                 "pending-forced-delete",
-                "deleting"
+                "deleting",
+                "pending-cancel",
+                "cancelled",
+                "timed-out"
             ],
             "x-enum-varnames": [
                 "BuildStatePendingRun",
@@ -4938,7 +6684,41 @@ const docTemplate = `{
                 "BuildStatePublished",
                 "BuildStatePendingDelete",
                 "BuildStatePendingForcedDelete",
-                "BuildStateDeleting"
+                "BuildStateDeleting",
+                "BuildStatePendingCancel",
+                "BuildStateCancelled",
+                "BuildStateTimedOut"
+            ]
+        },
+        "events.EventType": {
+            "type": "string",
+            "enum": [
+                "workspace.created",
+                "workspace.started",
+                "workspace.stopped",
+                "workspace.removed",
+                "workspace.error",
+                "project.started",
+                "project.stopped",
+                "project.error",
+                "project.state.updated",
+                "build.state.changed",
+                "build.success",
+                "build.error"
+            ],
+            "x-enum-varnames": [
+                "EventTypeWorkspaceCreated",
+                "EventTypeWorkspaceStarted",
+                "EventTypeWorkspaceStopped",
+                "EventTypeWorkspaceRemoved",
+                "EventTypeWorkspaceError",
+                "EventTypeProjectStarted",
+                "EventTypeProjectStopped",
+                "EventTypeProjectError",
+                "EventTypeProjectStateUpdated",
+                "EventTypeBuildStateChanged",
+                "EventTypeBuildSuccess",
+                "EventTypeBuildError"
             ]
         },
         "provider.ProviderInfo": {
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var auditedMethods = map[string]bool{
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+// Project state is reported by the agents every few seconds and is not a user operation.
+// Device logins are polled until the user has logged in at the OIDC provider.
+var auditExcludedRoutes = map[string]bool{
+	"/workspace/:workspaceId/:projectId/state": true,
+	"/auth/oidc/token":                         true,
+}
+
+func AuditMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !auditedMethods[ctx.Request.Method] || auditExcludedRoutes[ctx.FullPath()] {
+			ctx.Next()
+			return
+		}
+
+		startTime := time.Now()
+		ctx.Next()
+
+		apiKeyType, _ := ctx.Value("apiKeyType").(apikey.ApiKeyType)
+
+		auditLog := &audit.AuditLog{
+			ApiKeyName: ctx.GetString("apiKeyName"),
+			ApiKeyType: apiKeyType,
+			Method:     ctx.Request.Method,
+			Route:      ctx.FullPath(),
+			Path:       ctx.Request.URL.Path,
+			ResourceId: getResourceId(ctx),
+			StatusCode: ctx.Writer.Status(),
+			Outcome:    audit.OutcomeSuccess,
+			Duration:   time.Since(startTime).Milliseconds(),
+			CreatedAt:  startTime,
+		}
+
+		if auditLog.StatusCode >= 400 {
+			auditLog.Outcome = audit.OutcomeFailure
+		}
+
+		if len(ctx.Errors) > 0 {
+			auditLog.Error = ctx.Errors.Last().Error()
+		}
+
+		err := server.GetInstance(nil).AuditService.Record(auditLog)
+		if err != nil {
+			log.Errorf("failed to record audit log: %s", err)
+		}
+	}
+}
+
+// getResourceId joins the route path parameters, e.g. "<workspaceId>/<projectId>"
+func getResourceId(ctx *gin.Context) string {
+	values := []string{}
+	for _, param := range ctx.Params {
+		values = append(values, param.Value)
+	}
+
+	return strings.Join(values, "/")
+}
@@ -4,12 +4,17 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 func AuthMiddleware() gin.HandlerFunc {
@@ -33,15 +38,48 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		apiKeyType := apikey.ApiKeyTypeClient
+		err := server.ApiKeyService.UpdateLastUsed(token)
+		if err != nil {
+			log.Errorf("failed to update API key last use: %s", err)
+		}
+
+		apiKey, err := server.ApiKeyService.GetApiKey(token)
+		if err != nil {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		ctx.Set("apiKeyName", apiKey.Name)
+		ctx.Set("apiKeyType", apiKey.Type)
+
+		if apiKey.Type == apikey.ApiKeyTypeClient {
+			if !hasPermission(apiKey.Role, ctx.Request.Method, ctx.FullPath()) {
+				ctx.AbortWithError(403, fmt.Errorf("API key role '%s' is not allowed to %s %s", apiKey.Role, ctx.Request.Method, ctx.FullPath()))
+				return
+			}
+
+			if !isInScope(ctx, apiKey.Scope) {
+				ctx.AbortWithError(403, fmt.Errorf("API key '%s' is not allowed to access resources outside of its scope", apiKey.Name))
+				return
+			}
+
+			ctx.Set("apiKeyRole", apiKey.Role)
+			ctx.Set("apiKeyUserId", apiKey.UserId)
+			if apiKey.Scope != nil {
+				ctx.Set("apiKeyScope", apiKey.Scope)
+			}
+
+			if apiKey.Role != apikey.ApiKeyRoleAdmin && !canAccessOwnedResources(ctx) {
+				ctx.AbortWithError(403, fmt.Errorf("API key '%s' is not allowed to access resources of other users", apiKey.Name))
+				return
+			}
 
-		if server.ApiKeyService.IsWorkspaceApiKey(token) {
-			apiKeyType = apikey.ApiKeyTypeWorkspace
-		} else if server.ApiKeyService.IsProjectApiKey(token) {
-			apiKeyType = apikey.ApiKeyTypeProject
+			// Services read the client API key name and its user from the request context to apply quotas and ownership
+			requestCtx := context.WithValue(ctx.Request.Context(), apikey.CLIENT_API_KEY_NAME_CONTEXT_KEY, apiKey.Name)
+			requestCtx = context.WithValue(requestCtx, user.USER_ID_CONTEXT_KEY, apiKey.UserId)
+			ctx.Request = ctx.Request.WithContext(requestCtx)
 		}
 
-		ctx.Set("apiKeyType", apiKeyType)
 		ctx.Next()
 	}
 }
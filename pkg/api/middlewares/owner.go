@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/gin-gonic/gin"
+)
+
+// Checks that the workspace, project config, Git provider, secret and build referenced by the route are accessible to the user of the API key.
+// Resources that do not exist are left to the controllers to report.
+func canAccessOwnedResources(ctx *gin.Context) bool {
+	s := server.GetInstance(nil)
+
+	if workspaceId := ctx.Param("workspaceId"); workspaceId != "" {
+		w, err := s.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+		if err == nil && !controllers.CanAccess(ctx, w.OwnerId) {
+			return false
+		}
+	}
+
+	if configName := ctx.Param("configName"); configName != "" {
+		pc, err := s.ProjectConfigService.Find(&config.ProjectConfigFilter{Name: &configName})
+		if err == nil && !controllers.CanAccess(ctx, pc.OwnerId) {
+			return false
+		}
+	}
+
+	if gitProviderId := ctx.Param("gitProviderId"); gitProviderId != "" {
+		gc, err := s.GitProviderService.GetConfig(gitProviderId)
+		if err == nil && !controllers.CanAccess(ctx, gc.OwnerId) {
+			return false
+		}
+	}
+
+	if secretName := ctx.Param("secretName"); secretName != "" {
+		sec, err := s.SecretService.Find(secretName)
+		if err == nil && !controllers.CanAccess(ctx, sec.OwnerId) {
+			return false
+		}
+	}
+
+	if buildId := ctx.Param("buildId"); buildId != "" {
+		b, err := s.BuildService.Find(&build.Filter{Id: &buildId})
+		if err == nil && !controllers.CanAccessBuild(ctx, b, controllers.NewBuildOwnerResolver()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
+
+type access string
+
+const (
+	accessRead   access = "read"
+	accessWrite  access = "write"
+	accessDelete access = "delete"
+)
+
+// Resources are identified by the first segment of the route path, e.g. "workspace" for "/workspace/:workspaceId/start"
+var rolePermissions = map[apikey.ApiKeyRole]map[string][]access{
+	apikey.ApiKeyRoleDeveloper: {
+		"apikey":             {accessRead},
+		"binary":             {accessRead},
+		"build":              {accessRead, accessWrite, accessDelete},
+		"container-registry": {accessRead, accessWrite, accessDelete},
+		"events":             {accessRead},
+		"gitprovider":        {accessRead, accessWrite, accessDelete},
+		"log":                {accessRead},
+		"profile":            {accessRead, accessWrite, accessDelete},
+		"project-config":     {accessRead, accessWrite, accessDelete},
+		"provider":           {accessRead},
+		"sample":             {accessRead},
+		"secret":             {accessRead, accessWrite, accessDelete},
+		"server":             {accessRead},
+		"target":             {accessRead, accessWrite, accessDelete},
+		"workspace":          {accessRead, accessWrite, accessDelete},
+	},
+	apikey.ApiKeyRoleCI: {
+		"binary":         {accessRead},
+		"build":          {accessRead, accessWrite},
+		"events":         {accessRead},
+		"gitprovider":    {accessRead},
+		"log":            {accessRead},
+		"profile":        {accessRead},
+		"project-config": {accessRead},
+		"provider":       {accessRead},
+		"sample":         {accessRead},
+		"server":         {accessRead},
+		"target":         {accessRead},
+		"workspace":      {accessRead, accessWrite, accessDelete},
+	},
+	apikey.ApiKeyRoleReadOnly: {
+		"apikey":             {accessRead},
+		"binary":             {accessRead},
+		"build":              {accessRead},
+		"container-registry": {accessRead},
+		"events":             {accessRead},
+		"gitprovider":        {accessRead},
+		"log":                {accessRead},
+		"profile":            {accessRead},
+		"project-config":     {accessRead},
+		"provider":           {accessRead},
+		"sample":             {accessRead},
+		"secret":             {accessRead},
+		"server":             {accessRead},
+		"target":             {accessRead},
+		"workspace":          {accessRead},
+	},
+}
+
+// Routes that use POST to send a request body but do not modify any state
+var readOnlyPostRoutes = map[string]bool{
+	"/gitprovider/context":     true,
+	"/gitprovider/context/url": true,
+}
+
+func hasPermission(role apikey.ApiKeyRole, method, route string) bool {
+	if role == apikey.ApiKeyRoleAdmin {
+		return true
+	}
+
+	permissions, ok := rolePermissions[role]
+	if !ok {
+		return false
+	}
+
+	resource := getResource(route)
+
+	required := getRequiredAccess(method, route)
+
+	for _, a := range permissions[resource] {
+		if a == required {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getResource(route string) string {
+	return strings.Split(strings.TrimPrefix(route, "/"), "/")[0]
+}
+
+func getRequiredAccess(method, route string) access {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return accessRead
+	case http.MethodDelete:
+		return accessDelete
+	}
+
+	if method == http.MethodPost && readOnlyPostRoutes[route] {
+		return accessRead
+	}
+
+	return accessWrite
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasPermission(t *testing.T) {
+	tests := []struct {
+		role     apikey.ApiKeyRole
+		method   string
+		route    string
+		expected bool
+	}{
+		{apikey.ApiKeyRoleAdmin, http.MethodPost, "/server/config", true},
+		{apikey.ApiKeyRoleAdmin, http.MethodDelete, "/gitprovider/:gitProviderId", true},
+
+		{apikey.ApiKeyRoleDeveloper, http.MethodPost, "/workspace/", true},
+		{apikey.ApiKeyRoleDeveloper, http.MethodDelete, "/workspace/:workspaceId", true},
+		{apikey.ApiKeyRoleDeveloper, http.MethodGet, "/server/config", true},
+		{apikey.ApiKeyRoleDeveloper, http.MethodPost, "/server/config", false},
+		{apikey.ApiKeyRoleDeveloper, http.MethodPost, "/apikey/:apiKeyName", false},
+		{apikey.ApiKeyRoleDeveloper, http.MethodPost, "/provider/install", false},
+		{apikey.ApiKeyRoleDeveloper, http.MethodGet, "/secret/", true},
+		{apikey.ApiKeyRoleDeveloper, http.MethodPut, "/secret/:secretName", true},
+		{apikey.ApiKeyRoleDeveloper, http.MethodDelete, "/secret/:secretName", true},
+
+		{apikey.ApiKeyRoleCI, http.MethodPost, "/workspace/", true},
+		{apikey.ApiKeyRoleCI, http.MethodPost, "/workspace/:workspaceId/:projectId/toolbox/process/execute", true},
+		{apikey.ApiKeyRoleCI, http.MethodPost, "/build/", true},
+		{apikey.ApiKeyRoleCI, http.MethodPost, "/gitprovider/context", true},
+		{apikey.ApiKeyRoleCI, http.MethodDelete, "/gitprovider/:gitProviderId", false},
+		{apikey.ApiKeyRoleCI, http.MethodPut, "/project-config/", false},
+		{apikey.ApiKeyRoleCI, http.MethodPost, "/server/config", false},
+		{apikey.ApiKeyRoleCI, http.MethodGet, "/apikey/", false},
+		{apikey.ApiKeyRoleCI, http.MethodGet, "/secret/", false},
+
+		{apikey.ApiKeyRoleReadOnly, http.MethodGet, "/workspace/", true},
+		{apikey.ApiKeyRoleReadOnly, http.MethodGet, "/log/build/:buildId", true},
+		{apikey.ApiKeyRoleReadOnly, http.MethodGet, "/events", true},
+		{apikey.ApiKeyRoleReadOnly, http.MethodPost, "/gitprovider/context/url", true},
+		{apikey.ApiKeyRoleReadOnly, http.MethodPost, "/workspace/", false},
+		{apikey.ApiKeyRoleReadOnly, http.MethodPatch, "/target/:target/set-default", false},
+		{apikey.ApiKeyRoleReadOnly, http.MethodDelete, "/build/", false},
+
+		{apikey.ApiKeyRole(""), http.MethodGet, "/workspace/", false},
+		{apikey.ApiKeyRole("owner"), http.MethodGet, "/workspace/", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+" "+tt.method+" "+tt.route, func(t *testing.T) {
+			require.Equal(t, tt.expected, hasPermission(tt.role, tt.method, tt.route))
+		})
+	}
+}
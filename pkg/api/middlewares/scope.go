@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// isInScope checks that the resources referenced by the request path are within the API key scope.
+// Scoped keys cannot create new resources of the scoped type because those would fall outside of the scope.
+func isInScope(ctx *gin.Context, scope *apikey.ApiKeyScope) bool {
+	if scope == nil {
+		return true
+	}
+
+	resource := getResource(ctx.FullPath())
+
+	if len(scope.WorkspaceIds) > 0 {
+		workspaceId := ctx.Param("workspaceId")
+		if workspaceId != "" {
+			// The workspace can be referenced by either its ID or name
+			w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+			if err != nil || !scope.AllowsWorkspace(w.Id) {
+				return false
+			}
+		} else if resource == "workspace" && ctx.Request.Method != http.MethodGet {
+			return false
+		}
+	}
+
+	if len(scope.ProjectConfigNames) > 0 {
+		configName := ctx.Param("configName")
+		if configName != "" {
+			if !scope.AllowsProjectConfig(configName) {
+				return false
+			}
+		} else if resource == "project-config" && ctx.Request.Method != http.MethodGet {
+			return false
+		}
+	}
+
+	return true
+}
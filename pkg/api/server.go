@@ -33,9 +33,12 @@ import (
 	"github.com/gin-contrib/cors"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
+	"github.com/daytonaio/daytona/pkg/api/controllers/audit"
+	"github.com/daytonaio/daytona/pkg/api/controllers/auth"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	"github.com/daytonaio/daytona/pkg/api/controllers/events"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/health"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
@@ -44,8 +47,11 @@ import (
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig/prebuild"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/sample"
+	"github.com/daytonaio/daytona/pkg/api/controllers/secret"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
+	"github.com/daytonaio/daytona/pkg/api/controllers/users"
+	"github.com/daytonaio/daytona/pkg/api/controllers/webhook"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/toolbox"
 
@@ -114,6 +120,7 @@ func (a *ApiServer) Start() error {
 	a.router.Use(middlewares.TelemetryMiddleware(a.telemetryService))
 	a.router.Use(middlewares.LoggingMiddleware())
 	a.router.Use(middlewares.SetVersionMiddleware(a.version))
+	a.router.Use(middlewares.AuditMiddleware())
 
 	public := a.router.Group("/")
 	public.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
@@ -123,6 +130,13 @@ func (a *ApiServer) Start() error {
 		healthController.GET("/", health.HealthCheck)
 	}
 
+	oidcController := public.Group("/auth/oidc")
+	{
+		oidcController.POST("/device", auth.StartOidcDeviceLogin)
+		oidcController.POST("/token", auth.CompleteOidcDeviceLogin)
+		oidcController.POST("/refresh", auth.RefreshOidcLogin)
+	}
+
 	protected := a.router.Group("/")
 	protected.Use(middlewares.AuthMiddleware())
 
@@ -132,6 +146,10 @@ func (a *ApiServer) Start() error {
 		serverController.POST("/config", server.SetConfig)
 		serverController.POST("/network-key", server.GenerateNetworkKey)
 		serverController.GET("/logs", server.GetServerLogFiles)
+		serverController.GET("/quota", server.ListQuotas)
+		serverController.PUT("/quota", server.SetQuota)
+		serverController.DELETE("/quota/:apiKeyName", server.DeleteQuota)
+		serverController.DELETE("/quota/user/:userId", server.DeleteUserQuota)
 	}
 
 	binaryController := protected.Group("/binary")
@@ -147,9 +165,13 @@ func (a *ApiServer) Start() error {
 		workspaceController.POST("/", workspace.CreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/clone", workspace.CloneWorkspace)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.GET("/:workspaceId/:projectId/snapshots", workspace.ListSnapshots)
+		workspaceController.POST("/:workspaceId/:projectId/snapshots", workspace.CreateSnapshot)
+		workspaceController.POST("/:workspaceId/:projectId/snapshots/:snapshotName/restore", workspace.RestoreSnapshot)
 
 		toolboxController := workspaceController.Group("/:workspaceId/:projectId/toolbox")
 		{
@@ -250,9 +272,12 @@ func (a *ApiServer) Start() error {
 	{
 		buildController.POST("/", build.CreateBuild)
 		buildController.GET("/:buildId", build.GetBuild)
+		buildController.GET("/:buildId/sbom", build.GetBuildSbom)
 		buildController.GET("/", build.ListBuilds)
+		buildController.POST("/prune", build.PruneBuilds)
 		buildController.DELETE("/", build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
+		buildController.POST("/:buildId/cancel", build.CancelBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
 	}
 
@@ -296,6 +321,20 @@ func (a *ApiServer) Start() error {
 		apiKeyController.DELETE("/:apiKeyName", apikey.RevokeApiKey)
 	}
 
+	userController := protected.Group("/user")
+	{
+		userController.GET("/", users.ListUsers)
+		userController.POST("/", users.CreateUser)
+		userController.DELETE("/:userId", users.DeleteUser)
+	}
+
+	secretController := protected.Group("/secret")
+	{
+		secretController.GET("/", secret.ListSecrets)
+		secretController.PUT("/:secretName", secret.SetSecret)
+		secretController.DELETE("/:secretName", secret.DeleteSecret)
+	}
+
 	profileDataController := protected.Group("/profile")
 	{
 		profileDataController.GET("/", profiledata.GetProfileData)
@@ -303,6 +342,24 @@ func (a *ApiServer) Start() error {
 		profileDataController.DELETE("/", profiledata.DeleteProfileData)
 	}
 
+	eventsController := protected.Group("/events")
+	{
+		eventsController.GET("", events.StreamEvents)
+	}
+
+	auditController := protected.Group("/audit")
+	{
+		auditController.GET("/", audit.ListAuditLogs)
+	}
+
+	webhookController := protected.Group("/webhook")
+	{
+		webhookController.GET("/", webhook.ListWebhooks)
+		webhookController.POST("/", webhook.CreateWebhook)
+		webhookController.DELETE("/:webhookId", webhook.DeleteWebhook)
+		webhookController.GET("/:webhookId/deliveries", webhook.ListWebhookDeliveries)
+	}
+
 	samplesController := protected.Group("/sample")
 	{
 		samplesController.GET("/", sample.ListSamples)
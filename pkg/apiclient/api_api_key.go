@@ -26,6 +26,13 @@ type ApiGenerateApiKeyRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
 	apiKeyName string
+	apiKey     *GenerateApiKeyDTO
+}
+
+// API key options
+func (r ApiGenerateApiKeyRequest) ApiKey(apiKey GenerateApiKeyDTO) ApiGenerateApiKeyRequest {
+	r.apiKey = &apiKey
+	return r
 }
 
 func (r ApiGenerateApiKeyRequest) Execute() (string, *http.Response, error) {
@@ -73,7 +80,7 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 	localVarFormParams := url.Values{}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -89,6 +96,8 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.apiKey
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -50,6 +50,10 @@ type APIClient struct {
 
 	ApiKeyAPI *ApiKeyAPIService
 
+	AuditAPI *AuditAPIService
+
+	AuthAPI *AuthAPIService
+
 	BuildAPI *BuildAPIService
 
 	ContainerRegistryAPI *ContainerRegistryAPIService
@@ -68,10 +72,16 @@ type APIClient struct {
 
 	SampleAPI *SampleAPIService
 
+	SecretAPI *SecretAPIService
+
 	ServerAPI *ServerAPIService
 
 	TargetAPI *TargetAPIService
 
+	UserAPI *UserAPIService
+
+	WebhookAPI *WebhookAPIService
+
 	WorkspaceAPI *WorkspaceAPIService
 
 	WorkspaceToolboxAPI *WorkspaceToolboxAPIService
@@ -94,6 +104,8 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 
 	// API Services
 	c.ApiKeyAPI = (*ApiKeyAPIService)(&c.common)
+	c.AuditAPI = (*AuditAPIService)(&c.common)
+	c.AuthAPI = (*AuthAPIService)(&c.common)
 	c.BuildAPI = (*BuildAPIService)(&c.common)
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
 	c.DefaultAPI = (*DefaultAPIService)(&c.common)
@@ -103,8 +115,11 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.ProjectConfigAPI = (*ProjectConfigAPIService)(&c.common)
 	c.ProviderAPI = (*ProviderAPIService)(&c.common)
 	c.SampleAPI = (*SampleAPIService)(&c.common)
+	c.SecretAPI = (*SecretAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
+	c.UserAPI = (*UserAPIService)(&c.common)
+	c.WebhookAPI = (*WebhookAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
 	c.WorkspaceToolboxAPI = (*WorkspaceToolboxAPIService)(&c.common)
 
@@ -21,10 +21,16 @@ var _ MappedNullable = &ApiKey{}
 
 // ApiKey struct for ApiKey
 type ApiKey struct {
-	KeyHash string `json:"keyHash"`
+	ExpiresAt  *string `json:"expiresAt,omitempty"`
+	KeyHash    string  `json:"keyHash"`
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
 	// Project or client name
-	Name string           `json:"name"`
-	Type ApikeyApiKeyType `json:"type"`
+	Name  string            `json:"name"`
+	Role  *ApikeyApiKeyRole `json:"role,omitempty"`
+	Scope *ApiKeyScope      `json:"scope,omitempty"`
+	Type  ApikeyApiKeyType  `json:"type"`
+	// ID of the user that owns the client API key
+	UserId *string `json:"userId,omitempty"`
 }
 
 type _ApiKey ApiKey
@@ -49,6 +55,38 @@ func NewApiKeyWithDefaults() *ApiKey {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *ApiKey) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *ApiKey) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *ApiKey) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetKeyHash returns the KeyHash field value
 func (o *ApiKey) GetKeyHash() string {
 	if o == nil {
@@ -73,6 +111,38 @@ func (o *ApiKey) SetKeyHash(v string) {
 	o.KeyHash = v
 }
 
+// GetLastUsedAt returns the LastUsedAt field value if set, zero value otherwise.
+func (o *ApiKey) GetLastUsedAt() string {
+	if o == nil || IsNil(o.LastUsedAt) {
+		var ret string
+		return ret
+	}
+	return *o.LastUsedAt
+}
+
+// GetLastUsedAtOk returns a tuple with the LastUsedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetLastUsedAtOk() (*string, bool) {
+	if o == nil || IsNil(o.LastUsedAt) {
+		return nil, false
+	}
+	return o.LastUsedAt, true
+}
+
+// HasLastUsedAt returns a boolean if a field has been set.
+func (o *ApiKey) HasLastUsedAt() bool {
+	if o != nil && !IsNil(o.LastUsedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastUsedAt gets a reference to the given string and assigns it to the LastUsedAt field.
+func (o *ApiKey) SetLastUsedAt(v string) {
+	o.LastUsedAt = &v
+}
+
 // GetName returns the Name field value
 func (o *ApiKey) GetName() string {
 	if o == nil {
@@ -97,6 +167,70 @@ func (o *ApiKey) SetName(v string) {
 	o.Name = v
 }
 
+// GetRole returns the Role field value if set, zero value otherwise.
+func (o *ApiKey) GetRole() ApikeyApiKeyRole {
+	if o == nil || IsNil(o.Role) {
+		var ret ApikeyApiKeyRole
+		return ret
+	}
+	return *o.Role
+}
+
+// GetRoleOk returns a tuple with the Role field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetRoleOk() (*ApikeyApiKeyRole, bool) {
+	if o == nil || IsNil(o.Role) {
+		return nil, false
+	}
+	return o.Role, true
+}
+
+// HasRole returns a boolean if a field has been set.
+func (o *ApiKey) HasRole() bool {
+	if o != nil && !IsNil(o.Role) {
+		return true
+	}
+
+	return false
+}
+
+// SetRole gets a reference to the given ApikeyApiKeyRole and assigns it to the Role field.
+func (o *ApiKey) SetRole(v ApikeyApiKeyRole) {
+	o.Role = &v
+}
+
+// GetScope returns the Scope field value if set, zero value otherwise.
+func (o *ApiKey) GetScope() ApiKeyScope {
+	if o == nil || IsNil(o.Scope) {
+		var ret ApiKeyScope
+		return ret
+	}
+	return *o.Scope
+}
+
+// GetScopeOk returns a tuple with the Scope field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetScopeOk() (*ApiKeyScope, bool) {
+	if o == nil || IsNil(o.Scope) {
+		return nil, false
+	}
+	return o.Scope, true
+}
+
+// HasScope returns a boolean if a field has been set.
+func (o *ApiKey) HasScope() bool {
+	if o != nil && !IsNil(o.Scope) {
+		return true
+	}
+
+	return false
+}
+
+// SetScope gets a reference to the given ApiKeyScope and assigns it to the Scope field.
+func (o *ApiKey) SetScope(v ApiKeyScope) {
+	o.Scope = &v
+}
+
 // GetType returns the Type field value
 func (o *ApiKey) GetType() ApikeyApiKeyType {
 	if o == nil {
@@ -121,6 +255,38 @@ func (o *ApiKey) SetType(v ApikeyApiKeyType) {
 	o.Type = v
 }
 
+// GetUserId returns the UserId field value if set, zero value otherwise.
+func (o *ApiKey) GetUserId() string {
+	if o == nil || IsNil(o.UserId) {
+		var ret string
+		return ret
+	}
+	return *o.UserId
+}
+
+// GetUserIdOk returns a tuple with the UserId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetUserIdOk() (*string, bool) {
+	if o == nil || IsNil(o.UserId) {
+		return nil, false
+	}
+	return o.UserId, true
+}
+
+// HasUserId returns a boolean if a field has been set.
+func (o *ApiKey) HasUserId() bool {
+	if o != nil && !IsNil(o.UserId) {
+		return true
+	}
+
+	return false
+}
+
+// SetUserId gets a reference to the given string and assigns it to the UserId field.
+func (o *ApiKey) SetUserId(v string) {
+	o.UserId = &v
+}
+
 func (o ApiKey) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -131,9 +297,24 @@ func (o ApiKey) MarshalJSON() ([]byte, error) {
 
 func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["keyHash"] = o.KeyHash
+	if !IsNil(o.LastUsedAt) {
+		toSerialize["lastUsedAt"] = o.LastUsedAt
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Role) {
+		toSerialize["role"] = o.Role
+	}
+	if !IsNil(o.Scope) {
+		toSerialize["scope"] = o.Scope
+	}
 	toSerialize["type"] = o.Type
+	if !IsNil(o.UserId) {
+		toSerialize["userId"] = o.UserId
+	}
 	return toSerialize, nil
 }
 
@@ -21,17 +21,23 @@ var _ MappedNullable = &Build{}
 
 // Build struct for Build
 type Build struct {
-	BuildConfig     *BuildConfig      `json:"buildConfig,omitempty"`
-	ContainerConfig ContainerConfig   `json:"containerConfig"`
-	CreatedAt       string            `json:"createdAt"`
-	EnvVars         map[string]string `json:"envVars"`
-	Id              string            `json:"id"`
-	Image           *string           `json:"image,omitempty"`
-	PrebuildId      string            `json:"prebuildId"`
-	Repository      GitRepository     `json:"repository"`
-	State           BuildBuildState   `json:"state"`
-	UpdatedAt       string            `json:"updatedAt"`
-	User            *string           `json:"user,omitempty"`
+	Artifact          *BuildArtifact      `json:"artifact,omitempty"`
+	BuildConfig       *BuildConfig        `json:"buildConfig,omitempty"`
+	ContainerConfig   ContainerConfig     `json:"containerConfig"`
+	CreatedAt         string              `json:"createdAt"`
+	EnvVars           map[string]string   `json:"envVars"`
+	Id                string              `json:"id"`
+	Image             *string             `json:"image,omitempty"`
+	LayerCache        *LayerCacheStats    `json:"layerCache,omitempty"`
+	PrebuildId        string              `json:"prebuildId"`
+	Priority          *BuildBuildPriority `json:"priority,omitempty"`
+	ProjectConfigName *string             `json:"projectConfigName,omitempty"`
+	QueuePosition     *int32              `json:"queuePosition,omitempty"`
+	Repository        GitRepository       `json:"repository"`
+	State             BuildBuildState     `json:"state"`
+	Timeout           *int32              `json:"timeout,omitempty"`
+	UpdatedAt         string              `json:"updatedAt"`
+	User              *string             `json:"user,omitempty"`
 }
 
 type _Build Build
@@ -61,6 +67,38 @@ func NewBuildWithDefaults() *Build {
 	return &this
 }
 
+// GetArtifact returns the Artifact field value if set, zero value otherwise.
+func (o *Build) GetArtifact() BuildArtifact {
+	if o == nil || IsNil(o.Artifact) {
+		var ret BuildArtifact
+		return ret
+	}
+	return *o.Artifact
+}
+
+// GetArtifactOk returns a tuple with the Artifact field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetArtifactOk() (*BuildArtifact, bool) {
+	if o == nil || IsNil(o.Artifact) {
+		return nil, false
+	}
+	return o.Artifact, true
+}
+
+// HasArtifact returns a boolean if a field has been set.
+func (o *Build) HasArtifact() bool {
+	if o != nil && !IsNil(o.Artifact) {
+		return true
+	}
+
+	return false
+}
+
+// SetArtifact gets a reference to the given BuildArtifact and assigns it to the Artifact field.
+func (o *Build) SetArtifact(v BuildArtifact) {
+	o.Artifact = &v
+}
+
 // GetBuildConfig returns the BuildConfig field value if set, zero value otherwise.
 func (o *Build) GetBuildConfig() BuildConfig {
 	if o == nil || IsNil(o.BuildConfig) {
@@ -221,6 +259,38 @@ func (o *Build) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetLayerCache returns the LayerCache field value if set, zero value otherwise.
+func (o *Build) GetLayerCache() LayerCacheStats {
+	if o == nil || IsNil(o.LayerCache) {
+		var ret LayerCacheStats
+		return ret
+	}
+	return *o.LayerCache
+}
+
+// GetLayerCacheOk returns a tuple with the LayerCache field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetLayerCacheOk() (*LayerCacheStats, bool) {
+	if o == nil || IsNil(o.LayerCache) {
+		return nil, false
+	}
+	return o.LayerCache, true
+}
+
+// HasLayerCache returns a boolean if a field has been set.
+func (o *Build) HasLayerCache() bool {
+	if o != nil && !IsNil(o.LayerCache) {
+		return true
+	}
+
+	return false
+}
+
+// SetLayerCache gets a reference to the given LayerCacheStats and assigns it to the LayerCache field.
+func (o *Build) SetLayerCache(v LayerCacheStats) {
+	o.LayerCache = &v
+}
+
 // GetPrebuildId returns the PrebuildId field value
 func (o *Build) GetPrebuildId() string {
 	if o == nil {
@@ -245,6 +315,102 @@ func (o *Build) SetPrebuildId(v string) {
 	o.PrebuildId = v
 }
 
+// GetPriority returns the Priority field value if set, zero value otherwise.
+func (o *Build) GetPriority() BuildBuildPriority {
+	if o == nil || IsNil(o.Priority) {
+		var ret BuildBuildPriority
+		return ret
+	}
+	return *o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetPriorityOk() (*BuildBuildPriority, bool) {
+	if o == nil || IsNil(o.Priority) {
+		return nil, false
+	}
+	return o.Priority, true
+}
+
+// HasPriority returns a boolean if a field has been set.
+func (o *Build) HasPriority() bool {
+	if o != nil && !IsNil(o.Priority) {
+		return true
+	}
+
+	return false
+}
+
+// SetPriority gets a reference to the given BuildBuildPriority and assigns it to the Priority field.
+func (o *Build) SetPriority(v BuildBuildPriority) {
+	o.Priority = &v
+}
+
+// GetProjectConfigName returns the ProjectConfigName field value if set, zero value otherwise.
+func (o *Build) GetProjectConfigName() string {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		var ret string
+		return ret
+	}
+	return *o.ProjectConfigName
+}
+
+// GetProjectConfigNameOk returns a tuple with the ProjectConfigName field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetProjectConfigNameOk() (*string, bool) {
+	if o == nil || IsNil(o.ProjectConfigName) {
+		return nil, false
+	}
+	return o.ProjectConfigName, true
+}
+
+// HasProjectConfigName returns a boolean if a field has been set.
+func (o *Build) HasProjectConfigName() bool {
+	if o != nil && !IsNil(o.ProjectConfigName) {
+		return true
+	}
+
+	return false
+}
+
+// SetProjectConfigName gets a reference to the given string and assigns it to the ProjectConfigName field.
+func (o *Build) SetProjectConfigName(v string) {
+	o.ProjectConfigName = &v
+}
+
+// GetQueuePosition returns the QueuePosition field value if set, zero value otherwise.
+func (o *Build) GetQueuePosition() int32 {
+	if o == nil || IsNil(o.QueuePosition) {
+		var ret int32
+		return ret
+	}
+	return *o.QueuePosition
+}
+
+// GetQueuePositionOk returns a tuple with the QueuePosition field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetQueuePositionOk() (*int32, bool) {
+	if o == nil || IsNil(o.QueuePosition) {
+		return nil, false
+	}
+	return o.QueuePosition, true
+}
+
+// HasQueuePosition returns a boolean if a field has been set.
+func (o *Build) HasQueuePosition() bool {
+	if o != nil && !IsNil(o.QueuePosition) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuePosition gets a reference to the given int32 and assigns it to the QueuePosition field.
+func (o *Build) SetQueuePosition(v int32) {
+	o.QueuePosition = &v
+}
+
 // GetRepository returns the Repository field value
 func (o *Build) GetRepository() GitRepository {
 	if o == nil {
@@ -293,6 +459,38 @@ func (o *Build) SetState(v BuildBuildState) {
 	o.State = v
 }
 
+// GetTimeout returns the Timeout field value if set, zero value otherwise.
+func (o *Build) GetTimeout() int32 {
+	if o == nil || IsNil(o.Timeout) {
+		var ret int32
+		return ret
+	}
+	return *o.Timeout
+}
+
+// GetTimeoutOk returns a tuple with the Timeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.Timeout) {
+		return nil, false
+	}
+	return o.Timeout, true
+}
+
+// HasTimeout returns a boolean if a field has been set.
+func (o *Build) HasTimeout() bool {
+	if o != nil && !IsNil(o.Timeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetTimeout gets a reference to the given int32 and assigns it to the Timeout field.
+func (o *Build) SetTimeout(v int32) {
+	o.Timeout = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *Build) GetUpdatedAt() string {
 	if o == nil {
@@ -359,6 +557,9 @@ func (o Build) MarshalJSON() ([]byte, error) {
 
 func (o Build) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Artifact) {
+		toSerialize["artifact"] = o.Artifact
+	}
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
@@ -369,9 +570,24 @@ func (o Build) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.LayerCache) {
+		toSerialize["layerCache"] = o.LayerCache
+	}
 	toSerialize["prebuildId"] = o.PrebuildId
+	if !IsNil(o.Priority) {
+		toSerialize["priority"] = o.Priority
+	}
+	if !IsNil(o.ProjectConfigName) {
+		toSerialize["projectConfigName"] = o.ProjectConfigName
+	}
+	if !IsNil(o.QueuePosition) {
+		toSerialize["queuePosition"] = o.QueuePosition
+	}
 	toSerialize["repository"] = o.Repository
 	toSerialize["state"] = o.State
+	if !IsNil(o.Timeout) {
+		toSerialize["timeout"] = o.Timeout
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
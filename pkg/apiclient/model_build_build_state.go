@@ -28,6 +28,9 @@ const (
 	BuildStatePendingDelete       BuildBuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildBuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildBuildState = "deleting"
+	BuildStatePendingCancel       BuildBuildState = "pending-cancel"
+	BuildStateCancelled           BuildBuildState = "cancelled"
+	BuildStateTimedOut            BuildBuildState = "timed-out"
 )
 
 // All allowed values of BuildBuildState enum
@@ -40,6 +43,9 @@ var AllowedBuildBuildStateEnumValues = []BuildBuildState{
 	"pending-delete",
 	"pending-forced-delete",
 	"deleting",
+	"pending-cancel",
+	"cancelled",
+	"timed-out",
 }
 
 func (v *BuildBuildState) UnmarshalJSON(src []byte) error {
@@ -21,11 +21,15 @@ var _ MappedNullable = &CreatePrebuildDTO{}
 
 // CreatePrebuildDTO struct for CreatePrebuildDTO
 type CreatePrebuildDTO struct {
-	Branch         *string  `json:"branch,omitempty"`
-	CommitInterval *int32   `json:"commitInterval,omitempty"`
-	Id             *string  `json:"id,omitempty"`
-	Retention      int32    `json:"retention"`
-	TriggerFiles   []string `json:"triggerFiles,omitempty"`
+	Branch           *string  `json:"branch,omitempty"`
+	BuildTimeout     *int32   `json:"buildTimeout,omitempty"`
+	CommitInterval   *int32   `json:"commitInterval,omitempty"`
+	ForkPullRequests *bool    `json:"forkPullRequests,omitempty"`
+	Id               *string  `json:"id,omitempty"`
+	PullRequests     *bool    `json:"pullRequests,omitempty"`
+	Retention        int32    `json:"retention"`
+	ReviewWorkspace  *bool    `json:"reviewWorkspace,omitempty"`
+	TriggerFiles     []string `json:"triggerFiles,omitempty"`
 }
 
 type _CreatePrebuildDTO CreatePrebuildDTO
@@ -80,6 +84,38 @@ func (o *CreatePrebuildDTO) SetBranch(v string) {
 	o.Branch = &v
 }
 
+// GetBuildTimeout returns the BuildTimeout field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetBuildTimeout() int32 {
+	if o == nil || IsNil(o.BuildTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.BuildTimeout
+}
+
+// GetBuildTimeoutOk returns a tuple with the BuildTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetBuildTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.BuildTimeout) {
+		return nil, false
+	}
+	return o.BuildTimeout, true
+}
+
+// HasBuildTimeout returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasBuildTimeout() bool {
+	if o != nil && !IsNil(o.BuildTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildTimeout gets a reference to the given int32 and assigns it to the BuildTimeout field.
+func (o *CreatePrebuildDTO) SetBuildTimeout(v int32) {
+	o.BuildTimeout = &v
+}
+
 // GetCommitInterval returns the CommitInterval field value if set, zero value otherwise.
 func (o *CreatePrebuildDTO) GetCommitInterval() int32 {
 	if o == nil || IsNil(o.CommitInterval) {
@@ -112,6 +148,38 @@ func (o *CreatePrebuildDTO) SetCommitInterval(v int32) {
 	o.CommitInterval = &v
 }
 
+// GetForkPullRequests returns the ForkPullRequests field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetForkPullRequests() bool {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.ForkPullRequests
+}
+
+// GetForkPullRequestsOk returns a tuple with the ForkPullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetForkPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		return nil, false
+	}
+	return o.ForkPullRequests, true
+}
+
+// HasForkPullRequests returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasForkPullRequests() bool {
+	if o != nil && !IsNil(o.ForkPullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetForkPullRequests gets a reference to the given bool and assigns it to the ForkPullRequests field.
+func (o *CreatePrebuildDTO) SetForkPullRequests(v bool) {
+	o.ForkPullRequests = &v
+}
+
 // GetId returns the Id field value if set, zero value otherwise.
 func (o *CreatePrebuildDTO) GetId() string {
 	if o == nil || IsNil(o.Id) {
@@ -144,6 +212,38 @@ func (o *CreatePrebuildDTO) SetId(v string) {
 	o.Id = &v
 }
 
+// GetPullRequests returns the PullRequests field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetPullRequests() bool {
+	if o == nil || IsNil(o.PullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.PullRequests
+}
+
+// GetPullRequestsOk returns a tuple with the PullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.PullRequests) {
+		return nil, false
+	}
+	return o.PullRequests, true
+}
+
+// HasPullRequests returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasPullRequests() bool {
+	if o != nil && !IsNil(o.PullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequests gets a reference to the given bool and assigns it to the PullRequests field.
+func (o *CreatePrebuildDTO) SetPullRequests(v bool) {
+	o.PullRequests = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *CreatePrebuildDTO) GetRetention() int32 {
 	if o == nil {
@@ -168,6 +268,38 @@ func (o *CreatePrebuildDTO) SetRetention(v int32) {
 	o.Retention = v
 }
 
+// GetReviewWorkspace returns the ReviewWorkspace field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetReviewWorkspace() bool {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		var ret bool
+		return ret
+	}
+	return *o.ReviewWorkspace
+}
+
+// GetReviewWorkspaceOk returns a tuple with the ReviewWorkspace field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetReviewWorkspaceOk() (*bool, bool) {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		return nil, false
+	}
+	return o.ReviewWorkspace, true
+}
+
+// HasReviewWorkspace returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasReviewWorkspace() bool {
+	if o != nil && !IsNil(o.ReviewWorkspace) {
+		return true
+	}
+
+	return false
+}
+
+// SetReviewWorkspace gets a reference to the given bool and assigns it to the ReviewWorkspace field.
+func (o *CreatePrebuildDTO) SetReviewWorkspace(v bool) {
+	o.ReviewWorkspace = &v
+}
+
 // GetTriggerFiles returns the TriggerFiles field value if set, zero value otherwise.
 func (o *CreatePrebuildDTO) GetTriggerFiles() []string {
 	if o == nil || IsNil(o.TriggerFiles) {
@@ -213,13 +345,25 @@ func (o CreatePrebuildDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Branch) {
 		toSerialize["branch"] = o.Branch
 	}
+	if !IsNil(o.BuildTimeout) {
+		toSerialize["buildTimeout"] = o.BuildTimeout
+	}
 	if !IsNil(o.CommitInterval) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
+	if !IsNil(o.ForkPullRequests) {
+		toSerialize["forkPullRequests"] = o.ForkPullRequests
+	}
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.PullRequests) {
+		toSerialize["pullRequests"] = o.PullRequests
+	}
 	toSerialize["retention"] = o.Retention
+	if !IsNil(o.ReviewWorkspace) {
+		toSerialize["reviewWorkspace"] = o.ReviewWorkspace
+	}
 	if !IsNil(o.TriggerFiles) {
 		toSerialize["triggerFiles"] = o.TriggerFiles
 	}
@@ -27,6 +27,7 @@ type CreateProjectConfigDTO struct {
 	Image               *string           `json:"image,omitempty"`
 	Name                string            `json:"name"`
 	RepositoryUrl       string            `json:"repositoryUrl"`
+	Resources           *ProjectResources `json:"resources,omitempty"`
 	User                *string           `json:"user,omitempty"`
 }
 
@@ -220,6 +221,38 @@ func (o *CreateProjectConfigDTO) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *CreateProjectConfigDTO) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetUser returns the User field value if set, zero value otherwise.
 func (o *CreateProjectConfigDTO) GetUser() string {
 	if o == nil || IsNil(o.User) {
@@ -274,6 +307,9 @@ func (o CreateProjectConfigDTO) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["name"] = o.Name
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
 	}
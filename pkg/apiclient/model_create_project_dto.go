@@ -26,6 +26,7 @@ type CreateProjectDTO struct {
 	GitProviderConfigId *string                `json:"gitProviderConfigId,omitempty"`
 	Image               *string                `json:"image,omitempty"`
 	Name                string                 `json:"name"`
+	Resources           *ProjectResources      `json:"resources,omitempty"`
 	Source              CreateProjectSourceDTO `json:"source"`
 	User                *string                `json:"user,omitempty"`
 }
@@ -196,6 +197,38 @@ func (o *CreateProjectDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *CreateProjectDTO) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetSource returns the Source field value
 func (o *CreateProjectDTO) GetSource() CreateProjectSourceDTO {
 	if o == nil {
@@ -273,6 +306,9 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 		toSerialize["image"] = o.Image
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	toSerialize["source"] = o.Source
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
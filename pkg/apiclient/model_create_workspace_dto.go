@@ -21,10 +21,11 @@ var _ MappedNullable = &CreateWorkspaceDTO{}
 
 // CreateWorkspaceDTO struct for CreateWorkspaceDTO
 type CreateWorkspaceDTO struct {
-	Id       string             `json:"id"`
-	Name     string             `json:"name"`
-	Projects []CreateProjectDTO `json:"projects"`
-	Target   string             `json:"target"`
+	Id          string             `json:"id"`
+	IdleTimeout *int32             `json:"idleTimeout,omitempty"`
+	Name        string             `json:"name"`
+	Projects    []CreateProjectDTO `json:"projects"`
+	Target      string             `json:"target"`
 }
 
 type _CreateWorkspaceDTO CreateWorkspaceDTO
@@ -74,6 +75,38 @@ func (o *CreateWorkspaceDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *CreateWorkspaceDTO) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetName returns the Name field value
 func (o *CreateWorkspaceDTO) GetName() string {
 	if o == nil {
@@ -157,6 +190,9 @@ func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 func (o CreateWorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
@@ -21,9 +21,11 @@ var _ MappedNullable = &GitProvider{}
 
 // GitProvider struct for GitProvider
 type GitProvider struct {
-	Alias         string         `json:"alias"`
-	BaseApiUrl    *string        `json:"baseApiUrl,omitempty"`
-	Id            string         `json:"id"`
+	Alias      string  `json:"alias"`
+	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id         string  `json:"id"`
+	// ID of the user that owns the Git provider config
+	OwnerId       *string        `json:"ownerId,omitempty"`
 	ProviderId    string         `json:"providerId"`
 	SigningKey    *string        `json:"signingKey,omitempty"`
 	SigningMethod *SigningMethod `json:"signingMethod,omitempty"`
@@ -135,6 +137,38 @@ func (o *GitProvider) SetId(v string) {
 	o.Id = v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *GitProvider) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *GitProvider) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *GitProvider) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetProviderId returns the ProviderId field value
 func (o *GitProvider) GetProviderId() string {
 	if o == nil {
@@ -286,6 +320,9 @@ func (o GitProvider) ToMap() (map[string]interface{}, error) {
 		toSerialize["baseApiUrl"] = o.BaseApiUrl
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	toSerialize["providerId"] = o.ProviderId
 	if !IsNil(o.SigningKey) {
 		toSerialize["signingKey"] = o.SigningKey
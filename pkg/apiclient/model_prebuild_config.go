@@ -21,11 +21,19 @@ var _ MappedNullable = &PrebuildConfig{}
 
 // PrebuildConfig struct for PrebuildConfig
 type PrebuildConfig struct {
-	Branch         string   `json:"branch"`
-	CommitInterval int32    `json:"commitInterval"`
-	Id             string   `json:"id"`
-	Retention      int32    `json:"retention"`
-	TriggerFiles   []string `json:"triggerFiles"`
+	Branch string `json:"branch"`
+	// Minutes after which builds of the prebuild are stopped - overrides the server build timeout
+	BuildTimeout   *int32 `json:"buildTimeout,omitempty"`
+	CommitInterval int32  `json:"commitInterval"`
+	// Also targets pull requests from forks. Their code is not trusted and is built and run with the configuration of the project config
+	ForkPullRequests *bool  `json:"forkPullRequests,omitempty"`
+	Id               string `json:"id"`
+	// Targets pull requests instead of pushes - Branch then holds the base branch and an empty Branch matches all pull requests
+	PullRequests *bool `json:"pullRequests,omitempty"`
+	Retention    int32 `json:"retention"`
+	// Creates a workspace for each matching pull request and removes it once the pull request is closed
+	ReviewWorkspace *bool    `json:"reviewWorkspace,omitempty"`
+	TriggerFiles    []string `json:"triggerFiles"`
 }
 
 type _PrebuildConfig PrebuildConfig
@@ -76,6 +84,38 @@ func (o *PrebuildConfig) SetBranch(v string) {
 	o.Branch = v
 }
 
+// GetBuildTimeout returns the BuildTimeout field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetBuildTimeout() int32 {
+	if o == nil || IsNil(o.BuildTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.BuildTimeout
+}
+
+// GetBuildTimeoutOk returns a tuple with the BuildTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetBuildTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.BuildTimeout) {
+		return nil, false
+	}
+	return o.BuildTimeout, true
+}
+
+// HasBuildTimeout returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasBuildTimeout() bool {
+	if o != nil && !IsNil(o.BuildTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildTimeout gets a reference to the given int32 and assigns it to the BuildTimeout field.
+func (o *PrebuildConfig) SetBuildTimeout(v int32) {
+	o.BuildTimeout = &v
+}
+
 // GetCommitInterval returns the CommitInterval field value
 func (o *PrebuildConfig) GetCommitInterval() int32 {
 	if o == nil {
@@ -100,6 +140,38 @@ func (o *PrebuildConfig) SetCommitInterval(v int32) {
 	o.CommitInterval = v
 }
 
+// GetForkPullRequests returns the ForkPullRequests field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetForkPullRequests() bool {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.ForkPullRequests
+}
+
+// GetForkPullRequestsOk returns a tuple with the ForkPullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetForkPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		return nil, false
+	}
+	return o.ForkPullRequests, true
+}
+
+// HasForkPullRequests returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasForkPullRequests() bool {
+	if o != nil && !IsNil(o.ForkPullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetForkPullRequests gets a reference to the given bool and assigns it to the ForkPullRequests field.
+func (o *PrebuildConfig) SetForkPullRequests(v bool) {
+	o.ForkPullRequests = &v
+}
+
 // GetId returns the Id field value
 func (o *PrebuildConfig) GetId() string {
 	if o == nil {
@@ -124,6 +196,38 @@ func (o *PrebuildConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetPullRequests returns the PullRequests field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetPullRequests() bool {
+	if o == nil || IsNil(o.PullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.PullRequests
+}
+
+// GetPullRequestsOk returns a tuple with the PullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.PullRequests) {
+		return nil, false
+	}
+	return o.PullRequests, true
+}
+
+// HasPullRequests returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasPullRequests() bool {
+	if o != nil && !IsNil(o.PullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequests gets a reference to the given bool and assigns it to the PullRequests field.
+func (o *PrebuildConfig) SetPullRequests(v bool) {
+	o.PullRequests = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildConfig) GetRetention() int32 {
 	if o == nil {
@@ -148,6 +252,38 @@ func (o *PrebuildConfig) SetRetention(v int32) {
 	o.Retention = v
 }
 
+// GetReviewWorkspace returns the ReviewWorkspace field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetReviewWorkspace() bool {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		var ret bool
+		return ret
+	}
+	return *o.ReviewWorkspace
+}
+
+// GetReviewWorkspaceOk returns a tuple with the ReviewWorkspace field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetReviewWorkspaceOk() (*bool, bool) {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		return nil, false
+	}
+	return o.ReviewWorkspace, true
+}
+
+// HasReviewWorkspace returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasReviewWorkspace() bool {
+	if o != nil && !IsNil(o.ReviewWorkspace) {
+		return true
+	}
+
+	return false
+}
+
+// SetReviewWorkspace gets a reference to the given bool and assigns it to the ReviewWorkspace field.
+func (o *PrebuildConfig) SetReviewWorkspace(v bool) {
+	o.ReviewWorkspace = &v
+}
+
 // GetTriggerFiles returns the TriggerFiles field value
 func (o *PrebuildConfig) GetTriggerFiles() []string {
 	if o == nil {
@@ -183,9 +319,21 @@ func (o PrebuildConfig) MarshalJSON() ([]byte, error) {
 func (o PrebuildConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["branch"] = o.Branch
+	if !IsNil(o.BuildTimeout) {
+		toSerialize["buildTimeout"] = o.BuildTimeout
+	}
 	toSerialize["commitInterval"] = o.CommitInterval
+	if !IsNil(o.ForkPullRequests) {
+		toSerialize["forkPullRequests"] = o.ForkPullRequests
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.PullRequests) {
+		toSerialize["pullRequests"] = o.PullRequests
+	}
 	toSerialize["retention"] = o.Retention
+	if !IsNil(o.ReviewWorkspace) {
+		toSerialize["reviewWorkspace"] = o.ReviewWorkspace
+	}
 	toSerialize["triggerFiles"] = o.TriggerFiles
 	return toSerialize, nil
 }
@@ -22,10 +22,14 @@ var _ MappedNullable = &PrebuildDTO{}
 // PrebuildDTO struct for PrebuildDTO
 type PrebuildDTO struct {
 	Branch            string   `json:"branch"`
+	BuildTimeout      *int32   `json:"buildTimeout,omitempty"`
 	CommitInterval    *int32   `json:"commitInterval,omitempty"`
+	ForkPullRequests  *bool    `json:"forkPullRequests,omitempty"`
 	Id                string   `json:"id"`
 	ProjectConfigName string   `json:"projectConfigName"`
+	PullRequests      *bool    `json:"pullRequests,omitempty"`
 	Retention         int32    `json:"retention"`
+	ReviewWorkspace   *bool    `json:"reviewWorkspace,omitempty"`
 	TriggerFiles      []string `json:"triggerFiles,omitempty"`
 }
 
@@ -76,6 +80,38 @@ func (o *PrebuildDTO) SetBranch(v string) {
 	o.Branch = v
 }
 
+// GetBuildTimeout returns the BuildTimeout field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetBuildTimeout() int32 {
+	if o == nil || IsNil(o.BuildTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.BuildTimeout
+}
+
+// GetBuildTimeoutOk returns a tuple with the BuildTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetBuildTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.BuildTimeout) {
+		return nil, false
+	}
+	return o.BuildTimeout, true
+}
+
+// HasBuildTimeout returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasBuildTimeout() bool {
+	if o != nil && !IsNil(o.BuildTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildTimeout gets a reference to the given int32 and assigns it to the BuildTimeout field.
+func (o *PrebuildDTO) SetBuildTimeout(v int32) {
+	o.BuildTimeout = &v
+}
+
 // GetCommitInterval returns the CommitInterval field value if set, zero value otherwise.
 func (o *PrebuildDTO) GetCommitInterval() int32 {
 	if o == nil || IsNil(o.CommitInterval) {
@@ -108,6 +144,38 @@ func (o *PrebuildDTO) SetCommitInterval(v int32) {
 	o.CommitInterval = &v
 }
 
+// GetForkPullRequests returns the ForkPullRequests field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetForkPullRequests() bool {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.ForkPullRequests
+}
+
+// GetForkPullRequestsOk returns a tuple with the ForkPullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetForkPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.ForkPullRequests) {
+		return nil, false
+	}
+	return o.ForkPullRequests, true
+}
+
+// HasForkPullRequests returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasForkPullRequests() bool {
+	if o != nil && !IsNil(o.ForkPullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetForkPullRequests gets a reference to the given bool and assigns it to the ForkPullRequests field.
+func (o *PrebuildDTO) SetForkPullRequests(v bool) {
+	o.ForkPullRequests = &v
+}
+
 // GetId returns the Id field value
 func (o *PrebuildDTO) GetId() string {
 	if o == nil {
@@ -156,6 +224,38 @@ func (o *PrebuildDTO) SetProjectConfigName(v string) {
 	o.ProjectConfigName = v
 }
 
+// GetPullRequests returns the PullRequests field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetPullRequests() bool {
+	if o == nil || IsNil(o.PullRequests) {
+		var ret bool
+		return ret
+	}
+	return *o.PullRequests
+}
+
+// GetPullRequestsOk returns a tuple with the PullRequests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetPullRequestsOk() (*bool, bool) {
+	if o == nil || IsNil(o.PullRequests) {
+		return nil, false
+	}
+	return o.PullRequests, true
+}
+
+// HasPullRequests returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasPullRequests() bool {
+	if o != nil && !IsNil(o.PullRequests) {
+		return true
+	}
+
+	return false
+}
+
+// SetPullRequests gets a reference to the given bool and assigns it to the PullRequests field.
+func (o *PrebuildDTO) SetPullRequests(v bool) {
+	o.PullRequests = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildDTO) GetRetention() int32 {
 	if o == nil {
@@ -180,6 +280,38 @@ func (o *PrebuildDTO) SetRetention(v int32) {
 	o.Retention = v
 }
 
+// GetReviewWorkspace returns the ReviewWorkspace field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetReviewWorkspace() bool {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		var ret bool
+		return ret
+	}
+	return *o.ReviewWorkspace
+}
+
+// GetReviewWorkspaceOk returns a tuple with the ReviewWorkspace field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetReviewWorkspaceOk() (*bool, bool) {
+	if o == nil || IsNil(o.ReviewWorkspace) {
+		return nil, false
+	}
+	return o.ReviewWorkspace, true
+}
+
+// HasReviewWorkspace returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasReviewWorkspace() bool {
+	if o != nil && !IsNil(o.ReviewWorkspace) {
+		return true
+	}
+
+	return false
+}
+
+// SetReviewWorkspace gets a reference to the given bool and assigns it to the ReviewWorkspace field.
+func (o *PrebuildDTO) SetReviewWorkspace(v bool) {
+	o.ReviewWorkspace = &v
+}
+
 // GetTriggerFiles returns the TriggerFiles field value if set, zero value otherwise.
 func (o *PrebuildDTO) GetTriggerFiles() []string {
 	if o == nil || IsNil(o.TriggerFiles) {
@@ -223,12 +355,24 @@ func (o PrebuildDTO) MarshalJSON() ([]byte, error) {
 func (o PrebuildDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["branch"] = o.Branch
+	if !IsNil(o.BuildTimeout) {
+		toSerialize["buildTimeout"] = o.BuildTimeout
+	}
 	if !IsNil(o.CommitInterval) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
+	if !IsNil(o.ForkPullRequests) {
+		toSerialize["forkPullRequests"] = o.ForkPullRequests
+	}
 	toSerialize["id"] = o.Id
 	toSerialize["projectConfigName"] = o.ProjectConfigName
+	if !IsNil(o.PullRequests) {
+		toSerialize["pullRequests"] = o.PullRequests
+	}
 	toSerialize["retention"] = o.Retention
+	if !IsNil(o.ReviewWorkspace) {
+		toSerialize["reviewWorkspace"] = o.ReviewWorkspace
+	}
 	if !IsNil(o.TriggerFiles) {
 		toSerialize["triggerFiles"] = o.TriggerFiles
 	}
@@ -27,6 +27,7 @@ type Project struct {
 	Image               string            `json:"image"`
 	Name                string            `json:"name"`
 	Repository          GitRepository     `json:"repository"`
+	Resources           *ProjectResources `json:"resources,omitempty"`
 	State               *ProjectState     `json:"state,omitempty"`
 	Target              string            `json:"target"`
 	User                string            `json:"user"`
@@ -219,6 +220,38 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *Project) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *Project) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *Project) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -343,6 +376,9 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	toSerialize["image"] = o.Image
 	toSerialize["name"] = o.Name
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
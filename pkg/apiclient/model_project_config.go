@@ -27,9 +27,12 @@ type ProjectConfig struct {
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               string            `json:"image"`
 	Name                string            `json:"name"`
-	Prebuilds           []PrebuildConfig  `json:"prebuilds,omitempty"`
-	RepositoryUrl       string            `json:"repositoryUrl"`
-	User                string            `json:"user"`
+	// ID of the user that owns the project config
+	OwnerId       *string           `json:"ownerId,omitempty"`
+	Prebuilds     []PrebuildConfig  `json:"prebuilds,omitempty"`
+	RepositoryUrl string            `json:"repositoryUrl"`
+	Resources     *ProjectResources `json:"resources,omitempty"`
+	User          string            `json:"user"`
 }
 
 type _ProjectConfig ProjectConfig
@@ -217,6 +220,38 @@ func (o *ProjectConfig) SetName(v string) {
 	o.Name = v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *ProjectConfig) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *ProjectConfig) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *ProjectConfig) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetPrebuilds returns the Prebuilds field value if set, zero value otherwise.
 func (o *ProjectConfig) GetPrebuilds() []PrebuildConfig {
 	if o == nil || IsNil(o.Prebuilds) {
@@ -273,6 +308,38 @@ func (o *ProjectConfig) SetRepositoryUrl(v string) {
 	o.RepositoryUrl = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *ProjectConfig) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *ProjectConfig) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *ProjectConfig) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetUser returns the User field value
 func (o *ProjectConfig) GetUser() string {
 	if o == nil {
@@ -317,10 +384,16 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 	}
 	toSerialize["image"] = o.Image
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	if !IsNil(o.Prebuilds) {
 		toSerialize["prebuilds"] = o.Prebuilds
 	}
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	toSerialize["user"] = o.User
 	return toSerialize, nil
 }
@@ -21,9 +21,11 @@ var _ MappedNullable = &ProjectState{}
 
 // ProjectState struct for ProjectState
 type ProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt string     `json:"updatedAt"`
-	Uptime    int32      `json:"uptime"`
+	ActiveSessions *int32     `json:"activeSessions,omitempty"`
+	GitStatus      *GitStatus `json:"gitStatus,omitempty"`
+	LastActivityAt *string    `json:"lastActivityAt,omitempty"`
+	UpdatedAt      string     `json:"updatedAt"`
+	Uptime         int32      `json:"uptime"`
 }
 
 type _ProjectState ProjectState
@@ -47,6 +49,38 @@ func NewProjectStateWithDefaults() *ProjectState {
 	return &this
 }
 
+// GetActiveSessions returns the ActiveSessions field value if set, zero value otherwise.
+func (o *ProjectState) GetActiveSessions() int32 {
+	if o == nil || IsNil(o.ActiveSessions) {
+		var ret int32
+		return ret
+	}
+	return *o.ActiveSessions
+}
+
+// GetActiveSessionsOk returns a tuple with the ActiveSessions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetActiveSessionsOk() (*int32, bool) {
+	if o == nil || IsNil(o.ActiveSessions) {
+		return nil, false
+	}
+	return o.ActiveSessions, true
+}
+
+// HasActiveSessions returns a boolean if a field has been set.
+func (o *ProjectState) HasActiveSessions() bool {
+	if o != nil && !IsNil(o.ActiveSessions) {
+		return true
+	}
+
+	return false
+}
+
+// SetActiveSessions gets a reference to the given int32 and assigns it to the ActiveSessions field.
+func (o *ProjectState) SetActiveSessions(v int32) {
+	o.ActiveSessions = &v
+}
+
 // GetGitStatus returns the GitStatus field value if set, zero value otherwise.
 func (o *ProjectState) GetGitStatus() GitStatus {
 	if o == nil || IsNil(o.GitStatus) {
@@ -79,6 +113,38 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLastActivityAt returns the LastActivityAt field value if set, zero value otherwise.
+func (o *ProjectState) GetLastActivityAt() string {
+	if o == nil || IsNil(o.LastActivityAt) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivityAt
+}
+
+// GetLastActivityAtOk returns a tuple with the LastActivityAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLastActivityAtOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivityAt) {
+		return nil, false
+	}
+	return o.LastActivityAt, true
+}
+
+// HasLastActivityAt returns a boolean if a field has been set.
+func (o *ProjectState) HasLastActivityAt() bool {
+	if o != nil && !IsNil(o.LastActivityAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivityAt gets a reference to the given string and assigns it to the LastActivityAt field.
+func (o *ProjectState) SetLastActivityAt(v string) {
+	o.LastActivityAt = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil {
@@ -137,9 +203,15 @@ func (o ProjectState) MarshalJSON() ([]byte, error) {
 
 func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ActiveSessions) {
+		toSerialize["activeSessions"] = o.ActiveSessions
+	}
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.LastActivityAt) {
+		toSerialize["lastActivityAt"] = o.LastActivityAt
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
 	return toSerialize, nil
@@ -21,23 +21,32 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                   int32         `json:"apiPort"`
-	BinariesPath              string        `json:"binariesPath"`
-	BuildImageNamespace       *string       `json:"buildImageNamespace,omitempty"`
-	BuilderImage              string        `json:"builderImage"`
-	BuilderRegistryServer     string        `json:"builderRegistryServer"`
-	DefaultProjectImage       string        `json:"defaultProjectImage"`
-	DefaultProjectUser        string        `json:"defaultProjectUser"`
-	Frps                      *FRPSConfig   `json:"frps,omitempty"`
-	HeadscalePort             int32         `json:"headscalePort"`
-	Id                        string        `json:"id"`
-	LocalBuilderRegistryImage string        `json:"localBuilderRegistryImage"`
-	LocalBuilderRegistryPort  int32         `json:"localBuilderRegistryPort"`
-	LogFile                   LogFileConfig `json:"logFile"`
-	ProvidersDir              string        `json:"providersDir"`
-	RegistryUrl               string        `json:"registryUrl"`
-	SamplesIndexUrl           *string       `json:"samplesIndexUrl,omitempty"`
-	ServerDownloadUrl         string        `json:"serverDownloadUrl"`
+	ApiPort                   int32             `json:"apiPort"`
+	BinariesPath              string            `json:"binariesPath"`
+	BuildImageNamespace       *string           `json:"buildImageNamespace,omitempty"`
+	BuildTimeout              *int32            `json:"buildTimeout,omitempty"`
+	BuilderImage              string            `json:"builderImage"`
+	BuilderRegistryServer     string            `json:"builderRegistryServer"`
+	Database                  *DatabaseConfig   `json:"database,omitempty"`
+	DefaultProjectImage       string            `json:"defaultProjectImage"`
+	DefaultProjectUser        string            `json:"defaultProjectUser"`
+	Encryption                *EncryptionConfig `json:"encryption,omitempty"`
+	Frps                      *FRPSConfig       `json:"frps,omitempty"`
+	HeadscalePort             int32             `json:"headscalePort"`
+	Id                        string            `json:"id"`
+	IdleTimeout               *int32            `json:"idleTimeout,omitempty"`
+	LocalBuilderRegistryImage string            `json:"localBuilderRegistryImage"`
+	LocalBuilderRegistryPort  int32             `json:"localBuilderRegistryPort"`
+	LogFile                   LogFileConfig     `json:"logFile"`
+	MaxConcurrentBuilds       *int32            `json:"maxConcurrentBuilds,omitempty"`
+	MaxProjectConfigBuilds    *int32            `json:"maxProjectConfigBuilds,omitempty"`
+	Oidc                      *OidcConfig       `json:"oidc,omitempty"`
+	// Secret that git providers sign prebuild webhook events with. Generated on first start
+	PrebuildWebhookSecret *string `json:"prebuildWebhookSecret,omitempty"`
+	ProvidersDir          string  `json:"providersDir"`
+	RegistryUrl           string  `json:"registryUrl"`
+	SamplesIndexUrl       *string `json:"samplesIndexUrl,omitempty"`
+	ServerDownloadUrl     string  `json:"serverDownloadUrl"`
 }
 
 type _ServerConfig ServerConfig
@@ -153,6 +162,38 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildTimeout returns the BuildTimeout field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildTimeout() int32 {
+	if o == nil || IsNil(o.BuildTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.BuildTimeout
+}
+
+// GetBuildTimeoutOk returns a tuple with the BuildTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.BuildTimeout) {
+		return nil, false
+	}
+	return o.BuildTimeout, true
+}
+
+// HasBuildTimeout returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildTimeout() bool {
+	if o != nil && !IsNil(o.BuildTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildTimeout gets a reference to the given int32 and assigns it to the BuildTimeout field.
+func (o *ServerConfig) SetBuildTimeout(v int32) {
+	o.BuildTimeout = &v
+}
+
 // GetBuilderImage returns the BuilderImage field value
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil {
@@ -201,6 +242,38 @@ func (o *ServerConfig) SetBuilderRegistryServer(v string) {
 	o.BuilderRegistryServer = v
 }
 
+// GetDatabase returns the Database field value if set, zero value otherwise.
+func (o *ServerConfig) GetDatabase() DatabaseConfig {
+	if o == nil || IsNil(o.Database) {
+		var ret DatabaseConfig
+		return ret
+	}
+	return *o.Database
+}
+
+// GetDatabaseOk returns a tuple with the Database field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetDatabaseOk() (*DatabaseConfig, bool) {
+	if o == nil || IsNil(o.Database) {
+		return nil, false
+	}
+	return o.Database, true
+}
+
+// HasDatabase returns a boolean if a field has been set.
+func (o *ServerConfig) HasDatabase() bool {
+	if o != nil && !IsNil(o.Database) {
+		return true
+	}
+
+	return false
+}
+
+// SetDatabase gets a reference to the given DatabaseConfig and assigns it to the Database field.
+func (o *ServerConfig) SetDatabase(v DatabaseConfig) {
+	o.Database = &v
+}
+
 // GetDefaultProjectImage returns the DefaultProjectImage field value
 func (o *ServerConfig) GetDefaultProjectImage() string {
 	if o == nil {
@@ -249,6 +322,38 @@ func (o *ServerConfig) SetDefaultProjectUser(v string) {
 	o.DefaultProjectUser = v
 }
 
+// GetEncryption returns the Encryption field value if set, zero value otherwise.
+func (o *ServerConfig) GetEncryption() EncryptionConfig {
+	if o == nil || IsNil(o.Encryption) {
+		var ret EncryptionConfig
+		return ret
+	}
+	return *o.Encryption
+}
+
+// GetEncryptionOk returns a tuple with the Encryption field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetEncryptionOk() (*EncryptionConfig, bool) {
+	if o == nil || IsNil(o.Encryption) {
+		return nil, false
+	}
+	return o.Encryption, true
+}
+
+// HasEncryption returns a boolean if a field has been set.
+func (o *ServerConfig) HasEncryption() bool {
+	if o != nil && !IsNil(o.Encryption) {
+		return true
+	}
+
+	return false
+}
+
+// SetEncryption gets a reference to the given EncryptionConfig and assigns it to the Encryption field.
+func (o *ServerConfig) SetEncryption(v EncryptionConfig) {
+	o.Encryption = &v
+}
+
 // GetFrps returns the Frps field value if set, zero value otherwise.
 func (o *ServerConfig) GetFrps() FRPSConfig {
 	if o == nil || IsNil(o.Frps) {
@@ -329,6 +434,38 @@ func (o *ServerConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *ServerConfig) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *ServerConfig) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *ServerConfig) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetLocalBuilderRegistryImage returns the LocalBuilderRegistryImage field value
 func (o *ServerConfig) GetLocalBuilderRegistryImage() string {
 	if o == nil {
@@ -401,6 +538,134 @@ func (o *ServerConfig) SetLogFile(v LogFileConfig) {
 	o.LogFile = v
 }
 
+// GetMaxConcurrentBuilds returns the MaxConcurrentBuilds field value if set, zero value otherwise.
+func (o *ServerConfig) GetMaxConcurrentBuilds() int32 {
+	if o == nil || IsNil(o.MaxConcurrentBuilds) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrentBuilds
+}
+
+// GetMaxConcurrentBuildsOk returns a tuple with the MaxConcurrentBuilds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMaxConcurrentBuildsOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxConcurrentBuilds) {
+		return nil, false
+	}
+	return o.MaxConcurrentBuilds, true
+}
+
+// HasMaxConcurrentBuilds returns a boolean if a field has been set.
+func (o *ServerConfig) HasMaxConcurrentBuilds() bool {
+	if o != nil && !IsNil(o.MaxConcurrentBuilds) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrentBuilds gets a reference to the given int32 and assigns it to the MaxConcurrentBuilds field.
+func (o *ServerConfig) SetMaxConcurrentBuilds(v int32) {
+	o.MaxConcurrentBuilds = &v
+}
+
+// GetMaxProjectConfigBuilds returns the MaxProjectConfigBuilds field value if set, zero value otherwise.
+func (o *ServerConfig) GetMaxProjectConfigBuilds() int32 {
+	if o == nil || IsNil(o.MaxProjectConfigBuilds) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxProjectConfigBuilds
+}
+
+// GetMaxProjectConfigBuildsOk returns a tuple with the MaxProjectConfigBuilds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMaxProjectConfigBuildsOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxProjectConfigBuilds) {
+		return nil, false
+	}
+	return o.MaxProjectConfigBuilds, true
+}
+
+// HasMaxProjectConfigBuilds returns a boolean if a field has been set.
+func (o *ServerConfig) HasMaxProjectConfigBuilds() bool {
+	if o != nil && !IsNil(o.MaxProjectConfigBuilds) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxProjectConfigBuilds gets a reference to the given int32 and assigns it to the MaxProjectConfigBuilds field.
+func (o *ServerConfig) SetMaxProjectConfigBuilds(v int32) {
+	o.MaxProjectConfigBuilds = &v
+}
+
+// GetOidc returns the Oidc field value if set, zero value otherwise.
+func (o *ServerConfig) GetOidc() OidcConfig {
+	if o == nil || IsNil(o.Oidc) {
+		var ret OidcConfig
+		return ret
+	}
+	return *o.Oidc
+}
+
+// GetOidcOk returns a tuple with the Oidc field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetOidcOk() (*OidcConfig, bool) {
+	if o == nil || IsNil(o.Oidc) {
+		return nil, false
+	}
+	return o.Oidc, true
+}
+
+// HasOidc returns a boolean if a field has been set.
+func (o *ServerConfig) HasOidc() bool {
+	if o != nil && !IsNil(o.Oidc) {
+		return true
+	}
+
+	return false
+}
+
+// SetOidc gets a reference to the given OidcConfig and assigns it to the Oidc field.
+func (o *ServerConfig) SetOidc(v OidcConfig) {
+	o.Oidc = &v
+}
+
+// GetPrebuildWebhookSecret returns the PrebuildWebhookSecret field value if set, zero value otherwise.
+func (o *ServerConfig) GetPrebuildWebhookSecret() string {
+	if o == nil || IsNil(o.PrebuildWebhookSecret) {
+		var ret string
+		return ret
+	}
+	return *o.PrebuildWebhookSecret
+}
+
+// GetPrebuildWebhookSecretOk returns a tuple with the PrebuildWebhookSecret field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPrebuildWebhookSecretOk() (*string, bool) {
+	if o == nil || IsNil(o.PrebuildWebhookSecret) {
+		return nil, false
+	}
+	return o.PrebuildWebhookSecret, true
+}
+
+// HasPrebuildWebhookSecret returns a boolean if a field has been set.
+func (o *ServerConfig) HasPrebuildWebhookSecret() bool {
+	if o != nil && !IsNil(o.PrebuildWebhookSecret) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrebuildWebhookSecret gets a reference to the given string and assigns it to the PrebuildWebhookSecret field.
+func (o *ServerConfig) SetPrebuildWebhookSecret(v string) {
+	o.PrebuildWebhookSecret = &v
+}
+
 // GetProvidersDir returns the ProvidersDir field value
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil {
@@ -520,18 +785,42 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	if !IsNil(o.BuildTimeout) {
+		toSerialize["buildTimeout"] = o.BuildTimeout
+	}
 	toSerialize["builderImage"] = o.BuilderImage
 	toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
+	if !IsNil(o.Database) {
+		toSerialize["database"] = o.Database
+	}
 	toSerialize["defaultProjectImage"] = o.DefaultProjectImage
 	toSerialize["defaultProjectUser"] = o.DefaultProjectUser
+	if !IsNil(o.Encryption) {
+		toSerialize["encryption"] = o.Encryption
+	}
 	if !IsNil(o.Frps) {
 		toSerialize["frps"] = o.Frps
 	}
 	toSerialize["headscalePort"] = o.HeadscalePort
 	toSerialize["id"] = o.Id
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["localBuilderRegistryImage"] = o.LocalBuilderRegistryImage
 	toSerialize["localBuilderRegistryPort"] = o.LocalBuilderRegistryPort
 	toSerialize["logFile"] = o.LogFile
+	if !IsNil(o.MaxConcurrentBuilds) {
+		toSerialize["maxConcurrentBuilds"] = o.MaxConcurrentBuilds
+	}
+	if !IsNil(o.MaxProjectConfigBuilds) {
+		toSerialize["maxProjectConfigBuilds"] = o.MaxProjectConfigBuilds
+	}
+	if !IsNil(o.Oidc) {
+		toSerialize["oidc"] = o.Oidc
+	}
+	if !IsNil(o.PrebuildWebhookSecret) {
+		toSerialize["prebuildWebhookSecret"] = o.PrebuildWebhookSecret
+	}
 	toSerialize["providersDir"] = o.ProvidersDir
 	toSerialize["registryUrl"] = o.RegistryUrl
 	if !IsNil(o.SamplesIndexUrl) {
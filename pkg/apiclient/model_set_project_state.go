@@ -21,8 +21,9 @@ var _ MappedNullable = &SetProjectState{}
 
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    int32      `json:"uptime"`
+	ActiveSessions *int32     `json:"activeSessions,omitempty"`
+	GitStatus      *GitStatus `json:"gitStatus,omitempty"`
+	Uptime         int32      `json:"uptime"`
 }
 
 type _SetProjectState SetProjectState
@@ -45,6 +46,38 @@ func NewSetProjectStateWithDefaults() *SetProjectState {
 	return &this
 }
 
+// GetActiveSessions returns the ActiveSessions field value if set, zero value otherwise.
+func (o *SetProjectState) GetActiveSessions() int32 {
+	if o == nil || IsNil(o.ActiveSessions) {
+		var ret int32
+		return ret
+	}
+	return *o.ActiveSessions
+}
+
+// GetActiveSessionsOk returns a tuple with the ActiveSessions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetActiveSessionsOk() (*int32, bool) {
+	if o == nil || IsNil(o.ActiveSessions) {
+		return nil, false
+	}
+	return o.ActiveSessions, true
+}
+
+// HasActiveSessions returns a boolean if a field has been set.
+func (o *SetProjectState) HasActiveSessions() bool {
+	if o != nil && !IsNil(o.ActiveSessions) {
+		return true
+	}
+
+	return false
+}
+
+// SetActiveSessions gets a reference to the given int32 and assigns it to the ActiveSessions field.
+func (o *SetProjectState) SetActiveSessions(v int32) {
+	o.ActiveSessions = &v
+}
+
 // GetGitStatus returns the GitStatus field value if set, zero value otherwise.
 func (o *SetProjectState) GetGitStatus() GitStatus {
 	if o == nil || IsNil(o.GitStatus) {
@@ -111,6 +144,9 @@ func (o SetProjectState) MarshalJSON() ([]byte, error) {
 
 func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ActiveSessions) {
+		toSerialize["activeSessions"] = o.ActiveSessions
+	}
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
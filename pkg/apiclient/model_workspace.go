@@ -21,8 +21,13 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
+	// Name of the client API key that created the workspace
+	CreatedBy   *string `json:"createdBy,omitempty"`
+	Id          string  `json:"id"`
+	IdleTimeout *int32  `json:"idleTimeout,omitempty"`
+	Name        string  `json:"name"`
+	// ID of the user that owns the workspace
+	OwnerId  *string   `json:"ownerId,omitempty"`
 	Projects []Project `json:"projects"`
 	Target   string    `json:"target"`
 }
@@ -50,6 +55,38 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetCreatedBy returns the CreatedBy field value if set, zero value otherwise.
+func (o *Workspace) GetCreatedBy() string {
+	if o == nil || IsNil(o.CreatedBy) {
+		var ret string
+		return ret
+	}
+	return *o.CreatedBy
+}
+
+// GetCreatedByOk returns a tuple with the CreatedBy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetCreatedByOk() (*string, bool) {
+	if o == nil || IsNil(o.CreatedBy) {
+		return nil, false
+	}
+	return o.CreatedBy, true
+}
+
+// HasCreatedBy returns a boolean if a field has been set.
+func (o *Workspace) HasCreatedBy() bool {
+	if o != nil && !IsNil(o.CreatedBy) {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedBy gets a reference to the given string and assigns it to the CreatedBy field.
+func (o *Workspace) SetCreatedBy(v string) {
+	o.CreatedBy = &v
+}
+
 // GetId returns the Id field value
 func (o *Workspace) GetId() string {
 	if o == nil {
@@ -74,6 +111,38 @@ func (o *Workspace) SetId(v string) {
 	o.Id = v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *Workspace) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *Workspace) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *Workspace) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetName returns the Name field value
 func (o *Workspace) GetName() string {
 	if o == nil {
@@ -98,6 +167,38 @@ func (o *Workspace) SetName(v string) {
 	o.Name = v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *Workspace) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *Workspace) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *Workspace) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetProjects returns the Projects field value
 func (o *Workspace) GetProjects() []Project {
 	if o == nil {
@@ -156,8 +257,17 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.CreatedBy) {
+		toSerialize["createdBy"] = o.CreatedBy
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
@@ -21,11 +21,16 @@ var _ MappedNullable = &WorkspaceDTO{}
 
 // WorkspaceDTO struct for WorkspaceDTO
 type WorkspaceDTO struct {
-	Id       string         `json:"id"`
-	Info     *WorkspaceInfo `json:"info,omitempty"`
-	Name     string         `json:"name"`
-	Projects []Project      `json:"projects"`
-	Target   string         `json:"target"`
+	// Name of the client API key that created the workspace
+	CreatedBy   *string        `json:"createdBy,omitempty"`
+	Id          string         `json:"id"`
+	IdleTimeout *int32         `json:"idleTimeout,omitempty"`
+	Info        *WorkspaceInfo `json:"info,omitempty"`
+	Name        string         `json:"name"`
+	// ID of the user that owns the workspace
+	OwnerId  *string   `json:"ownerId,omitempty"`
+	Projects []Project `json:"projects"`
+	Target   string    `json:"target"`
 }
 
 type _WorkspaceDTO WorkspaceDTO
@@ -51,6 +56,38 @@ func NewWorkspaceDTOWithDefaults() *WorkspaceDTO {
 	return &this
 }
 
+// GetCreatedBy returns the CreatedBy field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetCreatedBy() string {
+	if o == nil || IsNil(o.CreatedBy) {
+		var ret string
+		return ret
+	}
+	return *o.CreatedBy
+}
+
+// GetCreatedByOk returns a tuple with the CreatedBy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetCreatedByOk() (*string, bool) {
+	if o == nil || IsNil(o.CreatedBy) {
+		return nil, false
+	}
+	return o.CreatedBy, true
+}
+
+// HasCreatedBy returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasCreatedBy() bool {
+	if o != nil && !IsNil(o.CreatedBy) {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedBy gets a reference to the given string and assigns it to the CreatedBy field.
+func (o *WorkspaceDTO) SetCreatedBy(v string) {
+	o.CreatedBy = &v
+}
+
 // GetId returns the Id field value
 func (o *WorkspaceDTO) GetId() string {
 	if o == nil {
@@ -75,6 +112,38 @@ func (o *WorkspaceDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetIdleTimeout returns the IdleTimeout field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetIdleTimeout() int32 {
+	if o == nil || IsNil(o.IdleTimeout) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeout
+}
+
+// GetIdleTimeoutOk returns a tuple with the IdleTimeout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetIdleTimeoutOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeout) {
+		return nil, false
+	}
+	return o.IdleTimeout, true
+}
+
+// HasIdleTimeout returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasIdleTimeout() bool {
+	if o != nil && !IsNil(o.IdleTimeout) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeout gets a reference to the given int32 and assigns it to the IdleTimeout field.
+func (o *WorkspaceDTO) SetIdleTimeout(v int32) {
+	o.IdleTimeout = &v
+}
+
 // GetInfo returns the Info field value if set, zero value otherwise.
 func (o *WorkspaceDTO) GetInfo() WorkspaceInfo {
 	if o == nil || IsNil(o.Info) {
@@ -131,6 +200,38 @@ func (o *WorkspaceDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *WorkspaceDTO) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *WorkspaceDTO) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *WorkspaceDTO) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *WorkspaceDTO) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetProjects returns the Projects field value
 func (o *WorkspaceDTO) GetProjects() []Project {
 	if o == nil {
@@ -189,11 +290,20 @@ func (o WorkspaceDTO) MarshalJSON() ([]byte, error) {
 
 func (o WorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.CreatedBy) {
+		toSerialize["createdBy"] = o.CreatedBy
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.IdleTimeout) {
+		toSerialize["idleTimeout"] = o.IdleTimeout
+	}
 	if !IsNil(o.Info) {
 		toSerialize["info"] = o.Info
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	toSerialize["projects"] = o.Projects
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
@@ -3,6 +3,11 @@
 
 package apikey
 
+import (
+	"slices"
+	"time"
+)
+
 type ApiKeyType string
 
 const (
@@ -11,9 +16,53 @@ const (
 	ApiKeyTypeWorkspace ApiKeyType = "workspace"
 )
 
+type ApiKeyRole string
+
+const (
+	ApiKeyRoleAdmin     ApiKeyRole = "admin"
+	ApiKeyRoleDeveloper ApiKeyRole = "developer"
+	ApiKeyRoleReadOnly  ApiKeyRole = "read-only"
+	ApiKeyRoleCI        ApiKeyRole = "ci"
+)
+
+var ApiKeyRoles = []ApiKeyRole{
+	ApiKeyRoleAdmin,
+	ApiKeyRoleDeveloper,
+	ApiKeyRoleReadOnly,
+	ApiKeyRoleCI,
+}
+
 type ApiKey struct {
 	KeyHash string     `json:"keyHash" validate:"required"`
 	Type    ApiKeyType `json:"type" validate:"required"`
 	// Project or client name
-	Name string `json:"name" validate:"required"`
+	Name       string       `json:"name" validate:"required"`
+	Role       ApiKeyRole   `json:"role" validate:"optional"`
+	Scope      *ApiKeyScope `json:"scope" validate:"optional"`
+	ExpiresAt  *time.Time   `json:"expiresAt" validate:"optional"`
+	LastUsedAt *time.Time   `json:"lastUsedAt" validate:"optional"`
+	// ID of the user that owns the client API key
+	UserId string `json:"userId,omitempty" validate:"optional"`
 } // @name ApiKey
+
+func (k *ApiKey) IsExpired() bool {
+	return k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now())
+}
+
+// Limits the resources a client API key can access. Empty lists do not restrict access.
+type ApiKeyScope struct {
+	WorkspaceIds       []string `json:"workspaceIds" validate:"optional"`
+	ProjectConfigNames []string `json:"projectConfigNames" validate:"optional"`
+} // @name ApiKeyScope
+
+func (s *ApiKeyScope) AllowsWorkspace(workspaceId string) bool {
+	return s == nil || len(s.WorkspaceIds) == 0 || slices.Contains(s.WorkspaceIds, workspaceId)
+}
+
+func (s *ApiKeyScope) AllowsProjectConfig(name string) bool {
+	return s == nil || len(s.ProjectConfigNames) == 0 || slices.Contains(s.ProjectConfigNames, name)
+}
+
+func IsValidApiKeyRole(role ApiKeyRole) bool {
+	return slices.Contains(ApiKeyRoles, role)
+}
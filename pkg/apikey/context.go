@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikey
+
+import "context"
+
+type ApiKeyContextKey string
+
+var CLIENT_API_KEY_NAME_CONTEXT_KEY ApiKeyContextKey = "client-api-key-name"
+
+// Returns the name of the client API key that authenticated the request or an empty string
+// if the request was not made with a client API key, e.g. by the server itself
+func ClientApiKeyName(ctx context.Context) string {
+	name, ok := ctx.Value(CLIENT_API_KEY_NAME_CONTEXT_KEY).(string)
+	if !ok {
+		return ""
+	}
+
+	return name
+}
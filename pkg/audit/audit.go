@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
+
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// AuditLog records a single mutating request handled by the server
+type AuditLog struct {
+	Id         string            `json:"id" validate:"required"`
+	ApiKeyName string            `json:"apiKeyName" validate:"required"`
+	ApiKeyType apikey.ApiKeyType `json:"apiKeyType" validate:"required"`
+	Method     string            `json:"method" validate:"required"`
+	Route      string            `json:"route" validate:"required"`
+	Path       string            `json:"path" validate:"required"`
+	ResourceId string            `json:"resourceId" validate:"required"`
+	StatusCode int               `json:"statusCode" validate:"required"`
+	Outcome    Outcome           `json:"outcome" validate:"required"`
+	Error      string            `json:"error" validate:"optional"`
+	// Request duration in milliseconds
+	Duration  int64     `json:"duration" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name AuditLog
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import "time"
+
+// Store is append-only, audit logs are never updated or deleted
+type Store interface {
+	List(filter *Filter) ([]*AuditLog, error)
+	Save(auditLog *AuditLog) error
+}
+
+type Filter struct {
+	ApiKeyName *string
+	Method     *string
+	ResourceId *string
+	Outcome    *Outcome
+	Since      *time.Time
+	Until      *time.Time
+	Limit      *int
+}
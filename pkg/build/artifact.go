@@ -0,0 +1,263 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
+)
+
+const sbomGeneratorImage = "anchore/syft:v1.14.0"
+
+const SbomFormatSpdxJson = "spdx-json"
+
+var jsonTrailingCommaRegex = regexp.MustCompile(`,(\s*[}\]])`)
+
+// Collects the metadata of the published build image. SBOM generation errors are
+// logged and the artifact is returned without the SBOM.
+func (b *Builder) GetArtifact(build Build) (*BuildArtifact, error) {
+	if build.Image == nil {
+		return nil, errors.New("build image is nil")
+	}
+
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	manifest, err := containerregistry.GetImageManifest(*build.Image, b.buildImageContainerRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact := &BuildArtifact{
+		ImageDigest:    manifest.Digest,
+		CompressedSize: manifest.CompressedSize,
+		CommitSha:      build.Repository.Sha,
+	}
+
+	imageInfo, _, err := cli.ImageInspectWithRaw(context.Background(), *build.Image)
+	if err == nil && imageInfo.Config != nil {
+		artifact.BaseImage = imageInfo.Config.Labels[docker.BaseImageLabel]
+	}
+
+	if artifact.BaseImage == "" {
+		artifact.BaseImage = b.getBaseImage(build)
+	}
+
+	buildLogger.Write([]byte("Generating SBOM...\n"))
+
+	document, err := dockerClient.GenerateSbom(docker.GenerateSbomOptions{
+		ImageName:         *build.Image,
+		Format:            SbomFormatSpdxJson,
+		GeneratorImage:    sbomGeneratorImage,
+		ContainerRegistry: b.buildImageContainerRegistry,
+		Labels: map[string]string{
+			"daytona.builder.build.id": build.Id,
+		},
+		LogWriter: buildLogger,
+	})
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error generating SBOM: %v\n", err)))
+		return artifact, nil
+	}
+
+	var spdxDocument struct {
+		Packages []json.RawMessage `json:"packages"`
+	}
+	err = json.Unmarshal([]byte(document), &spdxDocument)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error parsing SBOM: %v\n", err)))
+		return artifact, nil
+	}
+
+	artifact.Sbom = &BuildSbom{
+		Format:   SbomFormatSpdxJson,
+		Packages: len(spdxDocument.Packages),
+		Document: document,
+	}
+
+	return artifact, nil
+}
+
+// Base images of Dockerfile and devcontainer builds are read from the build files
+// since images only carry the base image label if the image author set it
+func (b *Builder) getBaseImage(build Build) string {
+	if build.BuildConfig == nil {
+		return ""
+	}
+
+	if build.BuildConfig.Dockerfile != nil {
+		dockerfile, err := os.ReadFile(filepath.Join(b.projectDir, build.BuildConfig.Dockerfile.Path))
+		if err != nil {
+			return ""
+		}
+
+		return getDockerfileBaseImage(string(dockerfile), build.BuildConfig.Dockerfile.Target)
+	}
+
+	if build.BuildConfig.Devcontainer != nil {
+		devcontainerPath := filepath.Join(b.projectDir, build.BuildConfig.Devcontainer.FilePath)
+		config, err := os.ReadFile(devcontainerPath)
+		if err != nil {
+			return ""
+		}
+
+		return getDevcontainerBaseImage(config, filepath.Dir(devcontainerPath))
+	}
+
+	return ""
+}
+
+// Returns the image of the target stage or of the last stage if the target is not set.
+// Stages based on previous stages resolve to the image of that stage.
+func getDockerfileBaseImage(dockerfile, target string) string {
+	stages := map[string]string{}
+	baseImage := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(dockerfile))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "FROM") {
+			continue
+		}
+
+		args := []string{}
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "--") {
+				args = append(args, field)
+			}
+		}
+
+		if len(args) == 0 {
+			continue
+		}
+
+		image := args[0]
+		if stageImage, ok := stages[strings.ToLower(image)]; ok {
+			image = stageImage
+		}
+
+		if len(args) >= 3 && strings.EqualFold(args[1], "AS") {
+			stage := strings.ToLower(args[2])
+			stages[stage] = image
+
+			if target != "" && stage == strings.ToLower(target) {
+				return image
+			}
+		}
+
+		baseImage = image
+	}
+
+	return baseImage
+}
+
+func getDevcontainerBaseImage(config []byte, configDir string) string {
+	var devcontainerConfig struct {
+		Image      string `json:"image"`
+		DockerFile string `json:"dockerFile"`
+		Build      *struct {
+			Dockerfile string `json:"dockerfile"`
+			Target     string `json:"target"`
+		} `json:"build"`
+	}
+
+	config = jsonTrailingCommaRegex.ReplaceAll(stripJsonComments(config), []byte("$1"))
+
+	err := json.Unmarshal(config, &devcontainerConfig)
+	if err != nil {
+		return ""
+	}
+
+	if devcontainerConfig.Image != "" {
+		return devcontainerConfig.Image
+	}
+
+	dockerfilePath := devcontainerConfig.DockerFile
+	target := ""
+	if devcontainerConfig.Build != nil {
+		if devcontainerConfig.Build.Dockerfile != "" {
+			dockerfilePath = devcontainerConfig.Build.Dockerfile
+		}
+		target = devcontainerConfig.Build.Target
+	}
+
+	if dockerfilePath == "" {
+		return ""
+	}
+
+	dockerfile, err := os.ReadFile(filepath.Join(configDir, dockerfilePath))
+	if err != nil {
+		return ""
+	}
+
+	return getDockerfileBaseImage(string(dockerfile), target)
+}
+
+// Devcontainer configs are JSON with comments
+func stripJsonComments(data []byte) []byte {
+	var result bytes.Buffer
+	inString := false
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+
+		if inString {
+			result.WriteByte(c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				result.WriteByte(data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		if c == '/' && i+1 < len(data) && data[i+1] == '/' {
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			result.WriteByte('\n')
+			continue
+		}
+
+		if c == '/' && i+1 < len(data) && data[i+1] == '*' {
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end == -1 {
+				break
+			}
+			i += end + 3
+			continue
+		}
+
+		if c == '"' {
+			inString = true
+		}
+
+		result.WriteByte(c)
+	}
+
+	return result.Bytes()
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var multiStageDockerfile = `FROM golang:1.23 AS builder
+RUN go build ./...
+
+FROM --platform=linux/amd64 ubuntu:22.04 AS runtime
+COPY --from=builder /app /app
+
+FROM runtime AS dev
+RUN apt-get install -y git
+`
+
+func TestGetDockerfileBaseImage(t *testing.T) {
+	require.Equal(t, "ubuntu:22.04", getDockerfileBaseImage(multiStageDockerfile, ""))
+	require.Equal(t, "golang:1.23", getDockerfileBaseImage(multiStageDockerfile, "builder"))
+	require.Equal(t, "ubuntu:22.04", getDockerfileBaseImage(multiStageDockerfile, "runtime"))
+	require.Equal(t, "", getDockerfileBaseImage("RUN echo", ""))
+}
+
+func TestGetDevcontainerBaseImage(t *testing.T) {
+	t.Run("Image config", func(t *testing.T) {
+		config := `{
+			// The image is pulled from Docker Hub
+			"name": "Go // dev",
+			"image": "mcr.microsoft.com/devcontainers/go:1.23", /* pinned */
+			"features": {},
+		}`
+
+		require.Equal(t, "mcr.microsoft.com/devcontainers/go:1.23", getDevcontainerBaseImage([]byte(config), ""))
+	})
+
+	t.Run("Dockerfile config", func(t *testing.T) {
+		configDir := t.TempDir()
+		err := os.WriteFile(filepath.Join(configDir, "Dockerfile"), []byte(multiStageDockerfile), 0644)
+		require.Nil(t, err)
+
+		config := `{
+			"build": {
+				"dockerfile": "Dockerfile",
+				"target": "builder"
+			}
+		}`
+
+		require.Equal(t, "golang:1.23", getDevcontainerBaseImage([]byte(config), configDir))
+	})
+}
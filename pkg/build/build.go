@@ -24,22 +24,73 @@ const (
 	BuildStatePendingDelete       BuildState = "pending-delete"
 	BuildStatePendingForcedDelete BuildState = "pending-forced-delete"
 	BuildStateDeleting            BuildState = "deleting"
+	BuildStatePendingCancel       BuildState = "pending-cancel"
+	BuildStateCancelled           BuildState = "cancelled"
+	BuildStateTimedOut            BuildState = "timed-out"
+)
+
+// Returns true if the build runner no longer writes to the logs of a build in this state
+func (s BuildState) IsFinished() bool {
+	switch s {
+	case BuildStatePendingRun, BuildStateRunning, BuildStateSuccess, BuildStatePendingCancel:
+		return false
+	}
+
+	return true
+}
+
+type BuildPriority string
+
+const (
+	BuildPriorityNormal BuildPriority = "normal"
+	BuildPriorityHigh   BuildPriority = "high"
 )
 
 type Build struct {
-	Id              string                          `json:"id" validate:"required"`
-	State           BuildState                      `json:"state" validate:"required"`
-	Image           *string                         `json:"image" validate:"optional"`
-	User            *string                         `json:"user" validate:"optional"`
-	ContainerConfig containerconfig.ContainerConfig `json:"containerConfig" validate:"required"`
-	BuildConfig     *buildconfig.BuildConfig        `json:"buildConfig" validate:"optional"`
-	Repository      *gitprovider.GitRepository      `json:"repository" validate:"required"`
-	EnvVars         map[string]string               `json:"envVars" validate:"required"`
-	PrebuildId      string                          `json:"prebuildId" validate:"required"`
-	CreatedAt       time.Time                       `json:"createdAt" validate:"required"`
-	UpdatedAt       time.Time                       `json:"updatedAt" validate:"required"`
+	Id                string                          `json:"id" validate:"required"`
+	State             BuildState                      `json:"state" validate:"required"`
+	Image             *string                         `json:"image" validate:"optional"`
+	User              *string                         `json:"user" validate:"optional"`
+	ContainerConfig   containerconfig.ContainerConfig `json:"containerConfig" validate:"required"`
+	BuildConfig       *buildconfig.BuildConfig        `json:"buildConfig" validate:"optional"`
+	Repository        *gitprovider.GitRepository      `json:"repository" validate:"required"`
+	EnvVars           map[string]string               `json:"envVars" validate:"required"`
+	PrebuildId        string                          `json:"prebuildId" validate:"required"`
+	ProjectConfigName string                          `json:"projectConfigName" validate:"optional"`
+	Priority          BuildPriority                   `json:"priority" validate:"optional"`
+	QueuePosition     *int                            `json:"queuePosition,omitempty" validate:"optional"`
+	Timeout           *int                            `json:"timeout,omitempty" validate:"optional"`
+	LayerCache        *LayerCacheStats                `json:"layerCache,omitempty" validate:"optional"`
+	Artifact          *BuildArtifact                  `json:"artifact,omitempty" validate:"optional"`
+	CreatedAt         time.Time                       `json:"createdAt" validate:"required"`
+	UpdatedAt         time.Time                       `json:"updatedAt" validate:"required"`
 } // @name Build
 
+// Statistics of the image layers reused from the layer cache of the previous build of the project config
+type LayerCacheStats struct {
+	// Image in the builder registry that the layer cache was imported from
+	CacheImage  string `json:"cacheImage" validate:"required"`
+	CachedSteps int    `json:"cachedSteps" validate:"required"`
+	TotalSteps  int    `json:"totalSteps" validate:"required"`
+} // @name LayerCacheStats
+
+// Metadata of the published build image used to audit what went into the image
+type BuildArtifact struct {
+	ImageDigest string `json:"imageDigest" validate:"required"`
+	// Sum of the compressed layer sizes and the image config size in bytes
+	CompressedSize int64      `json:"compressedSize" validate:"required" format:"int64"`
+	BaseImage      string     `json:"baseImage,omitempty" validate:"optional"`
+	CommitSha      string     `json:"commitSha" validate:"required"`
+	Sbom           *BuildSbom `json:"sbom,omitempty" validate:"optional"`
+} // @name BuildArtifact
+
+type BuildSbom struct {
+	Format   string `json:"format" validate:"required"`
+	Packages int    `json:"packages" validate:"required"`
+	// Stored separately from the build and only returned by the build SBOM endpoint
+	Document string `json:"document,omitempty" validate:"optional"`
+} // @name BuildSbom
+
 func (b *Build) Compare(other *Build) (bool, error) {
 	if b.BuildConfig != nil && *b.BuildConfig == (buildconfig.BuildConfig{}) {
 		buildHash, err := b.getBuildHashWithoutBuildConfig()
@@ -75,6 +126,16 @@ func (b *Build) GetBuildHash() (string, error) {
 		if err != nil {
 			return "", err
 		}
+	} else if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		buildJson, err = json.Marshal(b.BuildConfig.Dockerfile)
+		if err != nil {
+			return "", err
+		}
+	} else if b.BuildConfig != nil && b.BuildConfig.Nix != nil {
+		buildJson, err = json.Marshal(b.BuildConfig.Nix)
+		if err != nil {
+			return "", err
+		}
 	}
 	envVarsJson, err := json.Marshal(b.EnvVars)
 	if err != nil {
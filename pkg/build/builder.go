@@ -6,10 +6,14 @@ package build
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/docker/docker/client"
 )
 
 type IBuilder interface {
@@ -17,6 +21,12 @@ type IBuilder interface {
 	CleanUp() error
 	Publish(build Build) error
 	GetImageName(build Build) (string, error)
+	// Cancel stops the containers of a running build so that Build returns early
+	Cancel(build Build) error
+	// GetLayerCacheStats returns the layer cache statistics of the last build or nil if the layer cache was not used
+	GetLayerCacheStats() *LayerCacheStats
+	// GetArtifact returns the metadata of the published build image
+	GetArtifact(build Build) (*BuildArtifact, error)
 }
 
 type Builder struct {
@@ -30,6 +40,7 @@ type Builder struct {
 	loggerFactory               logs.LoggerFactory
 	defaultProjectImage         string
 	defaultProjectUser          string
+	layerCacheStats             *LayerCacheStats
 }
 
 func (b *Builder) GetImageName(build Build) (string, error) {
@@ -48,3 +59,112 @@ func (b *Builder) GetImageName(build Build) (string, error) {
 
 	return imageName, nil
 }
+
+// buildImage builds the project image with the given Docker client build function,
+// e.g. BuildFromDockerfile or BuildFromNix, and records the layer cache stats of the build
+func (b *Builder) buildImage(build Build, buildFn func(docker.IDockerClient, docker.BuildDockerfileOptions) (docker.RemoteUser, error)) (string, string, error) {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	err = dockerClient.PullImage(b.image, b.containerRegistry, buildLogger)
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	imageName, err := b.GetImageName(build)
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	layerCacheImage := b.GetLayerCacheImageName(build)
+	statsWriter := newLayerCacheStatsWriter(buildLogger)
+
+	remoteUser, err := buildFn(dockerClient, docker.BuildDockerfileOptions{
+		ProjectDir:               b.projectDir,
+		ImageName:                imageName,
+		BuildConfig:              build.BuildConfig,
+		LogWriter:                statsWriter,
+		ContainerRegistry:        b.buildImageContainerRegistry,
+		BuilderImage:             b.image,
+		BuilderContainerRegistry: b.containerRegistry,
+		BuilderLabels: map[string]string{
+			"daytona.builder.build.id": build.Id,
+		},
+		LayerCacheImage: layerCacheImage,
+	})
+	if err != nil {
+		return b.defaultProjectImage, b.defaultProjectUser, err
+	}
+
+	b.layerCacheStats = statsWriter.Stats(layerCacheImage)
+
+	return imageName, string(remoteUser), nil
+}
+
+func (b *Builder) CleanUp() error {
+	return os.RemoveAll(b.projectDir)
+}
+
+// Removing the builder containers makes the build command exit and the build fail.
+// The devcontainer of the build is removed as well in case the build already started it.
+func (b *Builder) Cancel(build Build) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	err = dockerClient.RemoveContainersByLabel(fmt.Sprintf("daytona.builder.build.id=%s", build.Id))
+	if err != nil {
+		return err
+	}
+
+	return dockerClient.RemoveContainersByLabel(fmt.Sprintf("daytona.build.id=%s", build.Id))
+}
+
+func (b *Builder) Publish(build Build) error {
+	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	if build.Image == nil {
+		return errors.New("build image is nil")
+	}
+
+	err = dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
+	if err != nil {
+		return err
+	}
+
+	if build.LayerCache == nil {
+		return nil
+	}
+
+	// The build is usable without the layer cache so push errors are not fatal
+	err = dockerClient.PushImage(build.LayerCache.CacheImage, b.buildImageContainerRegistry, buildLogger)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error pushing layer cache image: %v\n", err)))
+	}
+
+	return nil
+}
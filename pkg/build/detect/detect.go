@@ -16,6 +16,8 @@ type BuilderType string
 
 var (
 	BuilderTypeDevcontainer BuilderType = "devcontainer"
+	BuilderTypeDockerfile   BuilderType = "dockerfile"
+	BuilderTypeNix          BuilderType = "nix"
 	BuilderTypeImage        BuilderType = "image"
 )
 
@@ -28,6 +30,16 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 		return BuilderTypeDevcontainer, nil
 	}
 
+	// Dockerfiles are not detected automatically since repositories often ship Dockerfiles
+	// that are meant for deployment rather than for development
+	if buildConfig.Dockerfile != nil {
+		return BuilderTypeDockerfile, nil
+	}
+
+	if buildConfig.Nix != nil {
+		return BuilderTypeNix, nil
+	}
+
 	if sshClient != nil {
 		if _, err := sshClient.ReadFile(path.Join(projectDir, ".devcontainer/devcontainer.json")); err == nil {
 			buildConfig.Devcontainer = &buildconfig.DevcontainerConfig{
@@ -51,6 +63,23 @@ func DetectProjectBuilderType(buildConfig *buildconfig.BuildConfig, projectDir s
 		}
 	}
 
+	// Devcontainer configs take precedence over Nix files since they describe the whole container
+	for _, nixFilePath := range []string{"flake.nix", "shell.nix"} {
+		var err error
+		if sshClient != nil {
+			_, err = sshClient.ReadFile(path.Join(projectDir, nixFilePath))
+		} else {
+			_, err = os.Stat(filepath.Join(projectDir, nixFilePath))
+		}
+
+		if err == nil {
+			buildConfig.Nix = &buildconfig.NixConfig{
+				FilePath: nixFilePath,
+			}
+			return BuilderTypeNix, nil
+		}
+	}
+
 	return BuilderTypeImage, nil
 }
 
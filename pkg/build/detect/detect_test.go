@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package detect_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProjectFiles(t *testing.T, files ...string) string {
+	projectDir := t.TempDir()
+
+	for _, file := range files {
+		filePath := filepath.Join(projectDir, file)
+		require.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.Nil(t, os.WriteFile(filePath, []byte("{}"), 0644))
+	}
+
+	return projectDir
+}
+
+func TestDetectProjectBuilderType(t *testing.T) {
+	t.Run("No build config", func(t *testing.T) {
+		builderType, err := detect.DetectProjectBuilderType(nil, writeProjectFiles(t, "flake.nix"), nil)
+		require.Nil(t, err)
+		require.Equal(t, detect.BuilderTypeImage, builderType)
+	})
+
+	t.Run("Flake", func(t *testing.T) {
+		buildConfig := &buildconfig.BuildConfig{}
+
+		builderType, err := detect.DetectProjectBuilderType(buildConfig, writeProjectFiles(t, "flake.nix", "shell.nix"), nil)
+		require.Nil(t, err)
+		require.Equal(t, detect.BuilderTypeNix, builderType)
+		require.Equal(t, &buildconfig.NixConfig{FilePath: "flake.nix"}, buildConfig.Nix)
+	})
+
+	t.Run("Shell", func(t *testing.T) {
+		buildConfig := &buildconfig.BuildConfig{}
+
+		builderType, err := detect.DetectProjectBuilderType(buildConfig, writeProjectFiles(t, "shell.nix"), nil)
+		require.Nil(t, err)
+		require.Equal(t, detect.BuilderTypeNix, builderType)
+		require.Equal(t, &buildconfig.NixConfig{FilePath: "shell.nix"}, buildConfig.Nix)
+	})
+
+	t.Run("Devcontainer takes precedence over Nix", func(t *testing.T) {
+		buildConfig := &buildconfig.BuildConfig{}
+
+		builderType, err := detect.DetectProjectBuilderType(buildConfig, writeProjectFiles(t, ".devcontainer/devcontainer.json", "flake.nix"), nil)
+		require.Nil(t, err)
+		require.Equal(t, detect.BuilderTypeDevcontainer, builderType)
+		require.Nil(t, buildConfig.Nix)
+	})
+
+	t.Run("Dockerfiles are not detected", func(t *testing.T) {
+		builderType, err := detect.DetectProjectBuilderType(&buildconfig.BuildConfig{}, writeProjectFiles(t, "Dockerfile"), nil)
+		require.Nil(t, err)
+		require.Equal(t, detect.BuilderTypeImage, builderType)
+	})
+}
@@ -6,7 +6,7 @@ package build
 import (
 	"context"
 	"errors"
-	"os"
+	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/docker"
@@ -33,35 +33,16 @@ func (b *DevcontainerBuilder) Build(build Build) (string, string, error) {
 		return "", "", err
 	}
 
-	if builderType != detect.BuilderTypeDevcontainer {
+	switch builderType {
+	case detect.BuilderTypeDevcontainer:
+		return b.buildDevcontainer(build)
+	case detect.BuilderTypeNix:
+		// Nix files of automatic build configs are only detected once the project is cloned
+		nixBuilder := &NixBuilder{Builder: b.Builder}
+		return nixBuilder.buildNix(build)
+	default:
 		return "", "", errors.New("failed to detect devcontainer config")
 	}
-
-	return b.buildDevcontainer(build)
-}
-
-func (b *DevcontainerBuilder) CleanUp() error {
-	return os.RemoveAll(b.projectDir)
-}
-
-func (b *DevcontainerBuilder) Publish(build Build) error {
-	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
-	defer buildLogger.Close()
-
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-	if err != nil {
-		return err
-	}
-
-	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
-		ApiClient: cli,
-	})
-
-	if build.Image == nil {
-		return errors.New("build image is nil")
-	}
-
-	return dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
 }
 
 func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, error) {
@@ -82,6 +63,9 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
+	layerCacheImage := b.GetLayerCacheImageName(build)
+	statsWriter := newLayerCacheStatsWriter(buildLogger)
+
 	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(docker.CreateDevcontainerOptions{
 		BuildConfig:              build.BuildConfig,
 		ProjectName:              build.Id,
@@ -92,9 +76,13 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		IdLabels: map[string]string{
 			"daytona.build.id": build.Id,
 		},
-		ProjectDir: b.projectDir,
-		LogWriter:  buildLogger,
-		EnvVars:    build.EnvVars,
+		BuilderLabels: map[string]string{
+			"daytona.builder.build.id": build.Id,
+		},
+		ProjectDir:      b.projectDir,
+		LogWriter:       statsWriter,
+		EnvVars:         build.EnvVars,
+		LayerCacheImage: layerCacheImage,
 	})
 	if err != nil {
 		return b.defaultProjectImage, b.defaultProjectUser, err
@@ -114,5 +102,23 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
-	return imageName, string(remoteUser), err
+	// The devcontainer image is built with inline cache metadata so it is
+	// tagged as the layer cache image instead of the committed container
+	err = b.tagLayerCacheImage(cli, containerId, layerCacheImage)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Error tagging layer cache image: %v\n", err)))
+	} else {
+		b.layerCacheStats = statsWriter.Stats(layerCacheImage)
+	}
+
+	return imageName, string(remoteUser), nil
+}
+
+func (b *DevcontainerBuilder) tagLayerCacheImage(cli *client.Client, containerId, layerCacheImage string) error {
+	c, err := cli.ContainerInspect(context.Background(), containerId)
+	if err != nil {
+		return err
+	}
+
+	return cli.ImageTag(context.Background(), c.Image, layerCacheImage)
 }
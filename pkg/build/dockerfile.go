@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+)
+
+type DockerfileBuilder struct {
+	*Builder
+}
+
+func (b *DockerfileBuilder) Build(build Build) (string, string, error) {
+	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeDockerfile {
+		return "", "", errors.New("failed to detect dockerfile config")
+	}
+
+	return b.buildDockerfile(build)
+}
+
+func (b *DockerfileBuilder) buildDockerfile(build Build) (string, string, error) {
+	return b.buildImage(build, docker.IDockerClient.BuildFromDockerfile)
+}
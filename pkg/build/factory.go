@@ -53,8 +53,17 @@ func NewBuilderFactory(config BuilderFactoryConfig) IBuilderFactory {
 	}
 }
 
+// The project is not cloned yet when the builder is created so only explicitly configured builders
+// can be chosen from the build config. Automatic build configs are detected by the devcontainer builder.
 func (f *BuilderFactory) Create(build Build, projectDir string) (IBuilder, error) {
-	// TODO: Implement factory logic after adding prebuilds and other builder types
+	if build.BuildConfig != nil && build.BuildConfig.Dockerfile != nil {
+		return f.newDockerfileBuilder(projectDir)
+	}
+
+	if build.BuildConfig != nil && build.BuildConfig.Nix != nil {
+		return f.newNixBuilder(projectDir)
+	}
+
 	return f.newDevcontainerBuilder(projectDir)
 }
 
@@ -102,3 +111,45 @@ func (f *BuilderFactory) newDevcontainerBuilder(projectDir string) (*Devcontaine
 		builderDockerPort: builderDockerPort,
 	}, nil
 }
+
+func (f *BuilderFactory) newDockerfileBuilder(projectDir string) (*DockerfileBuilder, error) {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+	id = fmt.Sprintf("%s-%s", "dockerfile-builder", id)
+
+	return &DockerfileBuilder{
+		Builder: &Builder{
+			id:                          id,
+			projectDir:                  projectDir,
+			image:                       f.image,
+			containerRegistry:           f.containerRegistry,
+			buildImageContainerRegistry: f.buildImageContainerRegistry,
+			buildImageNamespace:         f.buildImageNamespace,
+			buildStore:                  f.buildStore,
+			loggerFactory:               f.loggerFactory,
+			defaultProjectImage:         f.defaultProjectImage,
+			defaultProjectUser:          f.defaultProjectUser,
+		},
+	}, nil
+}
+
+func (f *BuilderFactory) newNixBuilder(projectDir string) (*NixBuilder, error) {
+	id := stringid.GenerateRandomID()
+	id = stringid.TruncateID(id)
+	id = fmt.Sprintf("%s-%s", "nix-builder", id)
+
+	return &NixBuilder{
+		Builder: &Builder{
+			id:                          id,
+			projectDir:                  projectDir,
+			image:                       f.image,
+			containerRegistry:           f.containerRegistry,
+			buildImageContainerRegistry: f.buildImageContainerRegistry,
+			buildImageNamespace:         f.buildImageNamespace,
+			buildStore:                  f.buildStore,
+			loggerFactory:               f.loggerFactory,
+			defaultProjectImage:         f.defaultProjectImage,
+			defaultProjectUser:          f.defaultProjectUser,
+		},
+	}, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"testing"
+
+	t_build "github.com/daytonaio/daytona/internal/testing/build"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderFactoryCreate(t *testing.T) {
+	factory := build.NewBuilderFactory(build.BuilderFactoryConfig{
+		BuildStore: t_build.NewInMemoryBuildStore(),
+	})
+
+	t.Run("Dockerfile config", func(t *testing.T) {
+		builder, err := factory.Create(build.Build{
+			BuildConfig: &buildconfig.BuildConfig{
+				Dockerfile: &buildconfig.DockerfileConfig{
+					Path: "Dockerfile",
+				},
+			},
+		}, "")
+		require.Nil(t, err)
+		require.IsType(t, &build.DockerfileBuilder{}, builder)
+	})
+
+	t.Run("Nix config", func(t *testing.T) {
+		builder, err := factory.Create(build.Build{
+			BuildConfig: &buildconfig.BuildConfig{
+				Nix: &buildconfig.NixConfig{
+					FilePath: "flake.nix",
+				},
+			},
+		}, "")
+		require.Nil(t, err)
+		require.IsType(t, &build.NixBuilder{}, builder)
+	})
+
+	t.Run("Automatic config", func(t *testing.T) {
+		builder, err := factory.Create(build.Build{
+			BuildConfig: &buildconfig.BuildConfig{},
+		}, "")
+		require.Nil(t, err)
+		require.IsType(t, &build.DevcontainerBuilder{}, builder)
+	})
+}
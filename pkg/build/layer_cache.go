@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+)
+
+// Build steps of the BuildKit plain progress output, e.g. "#5 [2/4] RUN apt-get update"
+var layerCacheStepRegex = regexp.MustCompile(`#(\d+) \[[^\]]*\d+/\d+\] `)
+var layerCacheCachedRegex = regexp.MustCompile(`#(\d+) CACHED`)
+
+// Cache imports of the BuildKit plain progress output, e.g. "#3 importing cache manifest from registry/image:cache"
+var layerCacheImportRegex = regexp.MustCompile(`#(\d+) importing cache manifest from (\S+)`)
+var layerCacheDoneRegex = regexp.MustCompile(`#(\d+) DONE`)
+
+// The layer cache image is shared by all builds of a project config so that
+// a changed build config still reuses the unchanged layers of the previous build
+func (b *Builder) GetLayerCacheImageName(build Build) string {
+	cacheKey := build.ProjectConfigName
+	if cacheKey == "" {
+		cacheKey = build.Repository.Branch
+	}
+
+	tagBytes := sha256.Sum256([]byte(cacheKey))
+	nameBytes := sha256.Sum256([]byte(build.Repository.Url))
+
+	tag := hex.EncodeToString(tagBytes[:])[:16]
+	name := hex.EncodeToString(nameBytes[:])[:16]
+
+	return fmt.Sprintf("%s%s/p-%s:cache-%s", b.buildImageContainerRegistry.Server, b.buildImageNamespace, name, tag)
+}
+
+func (b *Builder) GetLayerCacheStats() *LayerCacheStats {
+	return b.layerCacheStats
+}
+
+// Forwards the build output to the build logger and counts
+// the build steps that BuildKit resolved from the layer cache
+type layerCacheStatsWriter struct {
+	writer      io.Writer
+	mutex       sync.Mutex
+	line        []byte
+	steps       map[string]bool
+	cachedSteps int
+	totalSteps  int
+	// Cache refs by the step number of their import and the cache refs that were imported
+	importSteps   map[string]string
+	importedCache map[string]bool
+}
+
+func newLayerCacheStatsWriter(writer io.Writer) *layerCacheStatsWriter {
+	return &layerCacheStatsWriter{
+		writer:        writer,
+		steps:         map[string]bool{},
+		importSteps:   map[string]string{},
+		importedCache: map[string]bool{},
+	}
+}
+
+func (w *layerCacheStatsWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	w.line = append(w.line, p...)
+	for {
+		index := bytes.IndexAny(w.line, "\r\n")
+		if index == -1 {
+			break
+		}
+
+		w.parseLine(string(w.line[:index]))
+		w.line = w.line[index+1:]
+	}
+	w.mutex.Unlock()
+
+	return w.writer.Write(p)
+}
+
+func (w *layerCacheStatsWriter) parseLine(line string) {
+	if match := layerCacheImportRegex.FindStringSubmatch(line); match != nil {
+		w.importSteps[match[1]] = match[2]
+		return
+	}
+
+	if match := layerCacheDoneRegex.FindStringSubmatch(line); match != nil {
+		if ref, ok := w.importSteps[match[1]]; ok {
+			w.importedCache[ref] = true
+			delete(w.importSteps, match[1])
+		}
+		return
+	}
+
+	if match := layerCacheStepRegex.FindStringSubmatch(line); match != nil {
+		// Step numbers start over when a single build runs multiple image builds
+		if _, ok := w.steps[match[1]]; ok {
+			w.steps = map[string]bool{}
+		}
+		w.steps[match[1]] = false
+		w.totalSteps++
+		return
+	}
+
+	if match := layerCacheCachedRegex.FindStringSubmatch(line); match != nil {
+		cached, ok := w.steps[match[1]]
+		if ok && !cached {
+			w.steps[match[1]] = true
+			w.cachedSteps++
+		}
+	}
+}
+
+// Cached steps are only attributed to the cache image if BuildKit imported it. Steps that were
+// resolved from the local build cache of the builder while the import failed are not counted.
+func (w *layerCacheStatsWriter) Stats(cacheImage string) *LayerCacheStats {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	cachedSteps := 0
+	if w.importedCache[cacheImage] {
+		cachedSteps = w.cachedSteps
+	}
+
+	return &LayerCacheStats{
+		CacheImage:  cacheImage,
+		CachedSteps: cachedSteps,
+		TotalSteps:  w.totalSteps,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var dockerBuildOutput = `#0 building with "default" instance using docker driver
+
+#1 [internal] load build definition from Dockerfile
+#1 transferring dockerfile: 142B done
+#1 DONE 0.0s
+
+#2 [internal] load metadata for docker.io/library/ubuntu:22.04
+#2 DONE 0.5s
+
+#3 importing cache manifest from cache-image
+#3 DONE 0.2s
+
+#4 [1/3] FROM docker.io/library/ubuntu:22.04@sha256:0e5e4a57c2499249aafc3b40fcd541e9a456aab7296681a3994d631587203f97
+#4 CACHED
+
+#5 [internal] load build context
+#5 transferring context: 35B done
+#5 DONE 0.0s
+
+#6 [2/3] RUN apt-get update && apt-get install -y curl
+#6 CACHED
+
+#7 [3/3] RUN echo "$GREETING" > /greeting
+#7 0.231 done
+#7 DONE 0.3s
+`
+
+func TestLayerCacheStatsWriter(t *testing.T) {
+	t.Run("Counts cached build steps", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		writer := newLayerCacheStatsWriter(output)
+
+		// Write the output in chunks that split lines
+		for i := 0; i < len(dockerBuildOutput); i += 7 {
+			end := min(i+7, len(dockerBuildOutput))
+			_, err := writer.Write([]byte(dockerBuildOutput[i:end]))
+			require.Nil(t, err)
+		}
+
+		require.Equal(t, dockerBuildOutput, output.String())
+		require.Equal(t, &LayerCacheStats{
+			CacheImage:  "cache-image",
+			CachedSteps: 2,
+			TotalSteps:  3,
+		}, writer.Stats("cache-image"))
+	})
+
+	t.Run("Counts steps of consecutive image builds", func(t *testing.T) {
+		writer := newLayerCacheStatsWriter(&bytes.Buffer{})
+
+		_, err := writer.Write([]byte(dockerBuildOutput + dockerBuildOutput))
+		require.Nil(t, err)
+
+		stats := writer.Stats("cache-image")
+		require.Equal(t, 4, stats.CachedSteps)
+		require.Equal(t, 6, stats.TotalSteps)
+	})
+
+	t.Run("Does not count cached steps without a cache import", func(t *testing.T) {
+		writer := newLayerCacheStatsWriter(&bytes.Buffer{})
+
+		output := strings.Replace(dockerBuildOutput, "#3 DONE 0.2s", "#3 ERROR: cache-image: not found", 1)
+		_, err := writer.Write([]byte(output))
+		require.Nil(t, err)
+
+		stats := writer.Stats("cache-image")
+		require.Equal(t, 0, stats.CachedSteps)
+		require.Equal(t, 3, stats.TotalSteps)
+
+		require.Equal(t, 0, writer.Stats("other-cache-image").CachedSteps)
+	})
+}
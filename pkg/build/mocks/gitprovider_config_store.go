@@ -16,3 +16,8 @@ func (s *MockGitProviderConfigStore) ListConfigsForUrl(url string) ([]*gitprovid
 	args := s.Called(url)
 	return args.Get(0).([]*gitprovider.GitProviderConfig), args.Error(1)
 }
+
+func (s *MockGitProviderConfigStore) GetGitProviderForUrl(url string) (gitprovider.GitProvider, string, error) {
+	args := s.Called(url)
+	return args.Get(0).(gitprovider.GitProvider), args.String(1), args.Error(2)
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/docker"
+)
+
+type NixBuilder struct {
+	*Builder
+}
+
+func (b *NixBuilder) Build(build Build) (string, string, error) {
+	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if builderType != detect.BuilderTypeNix {
+		return "", "", errors.New("failed to detect nix config")
+	}
+
+	return b.buildNix(build)
+}
+
+func (b *NixBuilder) buildNix(build Build) (string, string, error) {
+	return b.buildImage(build, docker.IDockerClient.BuildFromNix)
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"sort"
+	"sync"
+)
+
+// BuildQueue decides which pending builds can be started without exceeding the concurrency limits.
+// A limit of 0 means that the number of concurrent builds is not limited.
+type BuildQueue struct {
+	maxConcurrentBuilds    int
+	maxProjectConfigBuilds int
+	// Maps the ids of running builds to their project config names
+	running map[string]string
+	mutex   sync.Mutex
+}
+
+func NewBuildQueue(maxConcurrentBuilds, maxProjectConfigBuilds int) *BuildQueue {
+	return &BuildQueue{
+		maxConcurrentBuilds:    maxConcurrentBuilds,
+		maxProjectConfigBuilds: maxProjectConfigBuilds,
+		running:                make(map[string]string),
+	}
+}
+
+// Next returns the pending builds that should be started now and marks them as running.
+// Builds are picked in queue order. A build whose project config already reached its limit
+// is skipped so that builds of other project configs are not blocked behind it.
+func (q *BuildQueue) Next(pendingBuilds []*Build) []*Build {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	queue := make([]*Build, len(pendingBuilds))
+	copy(queue, pendingBuilds)
+	SortBuildQueue(queue)
+
+	runningPerProjectConfig := map[string]int{}
+	for _, projectConfigName := range q.running {
+		runningPerProjectConfig[projectConfigName]++
+	}
+
+	var next []*Build
+	for _, b := range queue {
+		if q.maxConcurrentBuilds > 0 && len(q.running) >= q.maxConcurrentBuilds {
+			break
+		}
+
+		if _, ok := q.running[b.Id]; ok {
+			continue
+		}
+
+		if b.ProjectConfigName != "" && q.maxProjectConfigBuilds > 0 && runningPerProjectConfig[b.ProjectConfigName] >= q.maxProjectConfigBuilds {
+			continue
+		}
+
+		q.running[b.Id] = b.ProjectConfigName
+		runningPerProjectConfig[b.ProjectConfigName]++
+		next = append(next, b)
+	}
+
+	return next
+}
+
+// Done releases the slot held by the build
+func (q *BuildQueue) Done(buildId string) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	delete(q.running, buildId)
+}
+
+// SortBuildQueue orders builds by priority and, within the same priority, by creation time
+func SortBuildQueue(builds []*Build) {
+	sort.SliceStable(builds, func(i, j int) bool {
+		if builds[i].Priority.rank() != builds[j].Priority.rank() {
+			return builds[i].Priority.rank() > builds[j].Priority.rank()
+		}
+		return builds[i].CreatedAt.Before(builds[j].CreatedAt)
+	})
+}
+
+// SetQueuePositions sets the 1-based queue position of each pending build
+func SetQueuePositions(pendingBuilds []*Build) {
+	queue := make([]*Build, 0, len(pendingBuilds))
+	for _, b := range pendingBuilds {
+		if b.State == BuildStatePendingRun {
+			queue = append(queue, b)
+		}
+	}
+
+	SortBuildQueue(queue)
+
+	for i, b := range queue {
+		position := i + 1
+		b.QueuePosition = &position
+	}
+}
+
+// Builds created before priorities were introduced are treated as normal priority builds
+func (p BuildPriority) rank() int {
+	if p == BuildPriorityHigh {
+		return 1
+	}
+	return 0
+}
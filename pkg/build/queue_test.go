@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func newQueuedBuild(id, projectConfigName string, priority build.BuildPriority, createdAt time.Time) *build.Build {
+	return &build.Build{
+		Id:                id,
+		State:             build.BuildStatePendingRun,
+		ProjectConfigName: projectConfigName,
+		Priority:          priority,
+		CreatedAt:         createdAt,
+	}
+}
+
+func getBuildIds(builds []*build.Build) []string {
+	ids := []string{}
+	for _, b := range builds {
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+func TestSortBuildQueue(t *testing.T) {
+	now := time.Now()
+
+	builds := []*build.Build{
+		newQueuedBuild("prebuild-new", "pc1", build.BuildPriorityNormal, now),
+		newQueuedBuild("prebuild-old", "pc1", build.BuildPriorityNormal, now.Add(-time.Minute)),
+		newQueuedBuild("manual", "pc1", build.BuildPriorityHigh, now.Add(time.Minute)),
+		newQueuedBuild("legacy", "pc1", "", now.Add(-2*time.Minute)),
+	}
+
+	build.SortBuildQueue(builds)
+
+	require.Equal(t, []string{"manual", "legacy", "prebuild-old", "prebuild-new"}, getBuildIds(builds))
+}
+
+func TestSetQueuePositions(t *testing.T) {
+	now := time.Now()
+
+	published := newQueuedBuild("published", "pc1", build.BuildPriorityNormal, now)
+	published.State = build.BuildStatePublished
+	prebuild := newQueuedBuild("prebuild", "pc1", build.BuildPriorityNormal, now.Add(-time.Minute))
+	manual := newQueuedBuild("manual", "pc1", build.BuildPriorityHigh, now)
+
+	build.SetQueuePositions([]*build.Build{published, prebuild, manual})
+
+	require.Nil(t, published.QueuePosition)
+	require.Equal(t, 1, *manual.QueuePosition)
+	require.Equal(t, 2, *prebuild.QueuePosition)
+}
+
+func TestBuildQueueNext(t *testing.T) {
+	now := time.Now()
+
+	pendingBuilds := []*build.Build{
+		newQueuedBuild("pc1-1", "pc1", build.BuildPriorityNormal, now),
+		newQueuedBuild("pc1-2", "pc1", build.BuildPriorityNormal, now.Add(time.Second)),
+		newQueuedBuild("pc2-1", "pc2", build.BuildPriorityNormal, now.Add(2*time.Second)),
+		newQueuedBuild("pc3-1", "pc3", build.BuildPriorityNormal, now.Add(3*time.Second)),
+		newQueuedBuild("manual", "pc2", build.BuildPriorityHigh, now.Add(4*time.Second)),
+	}
+
+	t.Run("Respects limits and priorities", func(t *testing.T) {
+		queue := build.NewBuildQueue(3, 1)
+
+		next := queue.Next(pendingBuilds)
+		require.Equal(t, []string{"manual", "pc1-1", "pc3-1"}, getBuildIds(next))
+
+		next = queue.Next(pendingBuilds)
+		require.Empty(t, next)
+
+		// Finished builds are no longer pending
+		queue.Done("pc1-1")
+
+		next = queue.Next(pendingBuilds[1:])
+		require.Equal(t, []string{"pc1-2"}, getBuildIds(next))
+	})
+
+	t.Run("No limits", func(t *testing.T) {
+		queue := build.NewBuildQueue(0, 0)
+
+		next := queue.Next(pendingBuilds)
+		require.Len(t, next, len(pendingBuilds))
+	})
+
+	t.Run("Builds without a project config", func(t *testing.T) {
+		queue := build.NewBuildQueue(0, 1)
+
+		next := queue.Next([]*build.Build{
+			newQueuedBuild("build-1", "", build.BuildPriorityNormal, now),
+			newQueuedBuild("build-2", "", build.BuildPriorityNormal, now),
+		})
+		require.Len(t, next, 2)
+	})
+}
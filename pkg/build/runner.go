@@ -5,13 +5,17 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
@@ -34,6 +38,13 @@ type BuildRunnerInstanceConfig struct {
 	BasePath          string
 	TelemetryEnabled  bool
 	TelemetryService  telemetry.TelemetryService
+	EventBus          events.IEventBus
+	// Limits the number of builds running at the same time. 0 means no limit
+	MaxConcurrentBuilds int
+	// Limits the number of builds of a single project config running at the same time. 0 means no limit
+	MaxProjectConfigBuilds int
+	// Running builds are stopped after the timeout unless the build sets its own. 0 means no timeout
+	BuildTimeout time.Duration
 }
 
 type BuildRunner struct {
@@ -48,6 +59,12 @@ type BuildRunner struct {
 	basePath          string
 	telemetryEnabled  bool
 	telemetryService  telemetry.TelemetryService
+	eventBus          events.IEventBus
+	queue             *BuildQueue
+	buildTimeout      time.Duration
+	// Cancels the context of the builds started by this runner
+	activeBuilds      map[string]context.CancelCauseFunc
+	activeBuildsMutex sync.Mutex
 }
 
 type BuildProcessConfig struct {
@@ -61,6 +78,7 @@ type BuildProcessConfig struct {
 
 type GitProviderStore interface {
 	ListConfigsForUrl(url string) ([]*gitprovider.GitProviderConfig, error)
+	GetGitProviderForUrl(url string) (gitprovider.GitProvider, string, error)
 }
 
 func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
@@ -76,6 +94,10 @@ func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 		basePath:          config.BasePath,
 		telemetryEnabled:  config.TelemetryEnabled,
 		telemetryService:  config.TelemetryService,
+		eventBus:          config.EventBus,
+		queue:             NewBuildQueue(config.MaxConcurrentBuilds, config.MaxProjectConfigBuilds),
+		buildTimeout:      config.BuildTimeout,
+		activeBuilds:      make(map[string]context.CancelCauseFunc),
 	}
 
 	return runner
@@ -90,6 +112,10 @@ func (r *BuildRunner) Start() error {
 	if err != nil {
 		return err
 	}
+	err = r.scheduler.AddFunc(r.runInterval, func() { r.CancelBuilds() })
+	if err != nil {
+		return err
+	}
 
 	r.scheduler.Start()
 	return nil
@@ -108,66 +134,73 @@ func (r *BuildRunner) RunBuilds() {
 		return
 	}
 
-	var wg sync.WaitGroup
+	var pendingBuilds []*Build
 	for _, b := range builds {
-		if b.State == BuildStatePendingRun {
-			wg.Add(1)
+		if b.State == BuildStatePendingRun && b.BuildConfig != nil {
+			pendingBuilds = append(pendingBuilds, b)
+		}
+	}
 
-			if b.BuildConfig == nil {
-				return
-			}
+	for _, b := range r.queue.Next(pendingBuilds) {
+		go func(b *Build) {
+			defer r.queue.Done(b.Id)
+			r.runBuild(b, builds)
+		}(b)
+	}
+}
 
-			buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
-			defer buildLogger.Close()
+func (r *BuildRunner) runBuild(b *Build, builds []*Build) {
+	// The build might have been cancelled since it was listed
+	current, err := r.buildStore.Find(&Filter{Id: &b.Id})
+	if err != nil || current.State != BuildStatePendingRun {
+		return
+	}
 
-			projectDir := filepath.Join(r.basePath, b.Id, "project")
+	buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
+	defer buildLogger.Close()
 
-			builder, err := r.builderFactory.Create(*b, projectDir)
-			if err != nil {
-				r.handleBuildError(*b, builder, err, buildLogger)
-				return
-			}
+	projectDir := filepath.Join(r.basePath, b.Id, "project")
 
-			cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-			if err != nil {
-				log.Error(err)
-				return
-			}
+	builder, err := r.builderFactory.Create(*b, projectDir)
+	if err != nil {
+		r.handleBuildError(*b, builder, err, buildLogger)
+		return
+	}
 
-			imageName, err := builder.GetImageName(*b)
-			if err != nil {
-				r.handleBuildError(*b, builder, err, buildLogger)
-				return
-			}
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		log.Error(err)
+		return
+	}
 
-			_, _, err = cli.ImageInspectWithRaw(context.Background(), imageName)
-			if err == nil {
-				b.State = BuildStatePublished
-				err = r.buildStore.Save(b)
-				if err != nil {
-					r.handleBuildError(*b, builder, err, buildLogger)
-					return
-				}
-				return
-			}
+	imageName, err := builder.GetImageName(*b)
+	if err != nil {
+		r.handleBuildError(*b, builder, err, buildLogger)
+		return
+	}
 
-			b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
-
-			go r.RunBuildProcess(BuildProcessConfig{
-				Builder:     builder,
-				BuildLogger: buildLogger,
-				Build:       b,
-				ProjectDir:  projectDir,
-				GitService: &git.Service{
-					ProjectDir: projectDir,
-					LogWriter:  buildLogger,
-				},
-				Wg: &wg,
-			})
+	_, _, err = cli.ImageInspectWithRaw(context.Background(), imageName)
+	if err == nil {
+		b.State = BuildStatePublished
+		err = r.saveBuildState(b, BuildStatePendingRun)
+		if err != nil && !errors.Is(err, ErrBuildStateChanged) {
+			r.handleBuildError(*b, builder, err, buildLogger)
 		}
+		return
 	}
 
-	wg.Wait()
+	b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
+
+	r.RunBuildProcess(BuildProcessConfig{
+		Builder:     builder,
+		BuildLogger: buildLogger,
+		Build:       b,
+		ProjectDir:  projectDir,
+		GitService: &git.Service{
+			ProjectDir: projectDir,
+			LogWriter:  buildLogger,
+		},
+	})
 }
 
 func (r *BuildRunner) DeleteBuilds() {
@@ -189,18 +222,27 @@ func (r *BuildRunner) DeleteBuilds() {
 		ApiClient: cli,
 	})
 
+	// Builds with the same build config and commit share the image in the registry
+	sharedImages, err := r.getImagesOfRemainingBuilds()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
 	var wg sync.WaitGroup
 	for _, b := range markedForDeletionBuilds {
 		wg.Add(1)
 
 		go func(b *Build) {
+			defer wg.Done()
+
 			buildLogger := r.loggerFactory.CreateBuildLogger(b.Id, logs.LogSourceBuilder)
 			defer buildLogger.Close()
 
 			force := b.State == BuildStatePendingForcedDelete
 
 			b.State = BuildStateDeleting
-			err = r.buildStore.Save(b)
+			err := r.saveBuild(b)
 			if err != nil {
 				r.handleBuildError(*b, nil, err, buildLogger)
 				return
@@ -215,6 +257,16 @@ func (r *BuildRunner) DeleteBuilds() {
 						return
 					}
 				}
+
+				if !sharedImages[*b.Image] {
+					err = containerregistry.DeleteImage(*b.Image, r.containerRegistry)
+					if err != nil {
+						r.handleBuildError(*b, nil, err, buildLogger)
+						if !force {
+							return
+						}
+					}
+				}
 			}
 
 			err = r.buildStore.Delete(b.Id)
@@ -228,21 +280,60 @@ func (r *BuildRunner) DeleteBuilds() {
 	wg.Wait()
 }
 
+func (r *BuildRunner) getImagesOfRemainingBuilds() (map[string]bool, error) {
+	builds, err := r.buildStore.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]bool{}
+	for _, b := range builds {
+		if b.Image == nil {
+			continue
+		}
+
+		switch b.State {
+		case BuildStatePendingDelete, BuildStatePendingForcedDelete, BuildStateDeleting:
+			continue
+		}
+
+		images[*b.Image] = true
+	}
+
+	return images, nil
+}
+
 func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	if config.Wg != nil {
 		defer config.Wg.Done()
 	}
 
+	ctx, cancel := r.startBuildContext(config.Build)
+	defer cancel()
+
+	// Stop the builder as soon as the build is cancelled or times out.
+	// The hook gets a copy of the build because the build process keeps updating it.
+	b := *config.Build
+	stopCancelHook := context.AfterFunc(ctx, func() {
+		err := config.Builder.Cancel(b)
+		if err != nil {
+			config.BuildLogger.Write([]byte(fmt.Sprintf("Error cancelling build: %s\n", err.Error())))
+		}
+	})
+	defer stopCancelHook()
+
 	config.Build.State = BuildStateRunning
-	err := r.buildStore.Save(config.Build)
+	err := r.saveBuildState(config.Build, BuildStatePendingRun)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
+	r.setCommitStatus(*config.Build, gitprovider.CommitStatusPending, "Prebuild is running", config.BuildLogger)
+
 	gitProviders, err := r.gitProviderStore.ListConfigsForUrl(config.Build.Repository.Url)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
@@ -254,39 +345,58 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	}
 
 	err = config.GitService.CloneRepository(config.Build.Repository, auth)
+	if err == nil {
+		err = ctx.Err()
+	}
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
 	image, user, err := config.Builder.Build(*config.Build)
+	if err == nil {
+		err = ctx.Err()
+	}
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
 	config.Build.Image = &image
 	config.Build.User = &user
+	config.Build.LayerCache = config.Builder.GetLayerCacheStats()
 	config.Build.State = BuildStateSuccess
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuildState(config.Build, BuildStateRunning)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
 	err = config.Builder.Publish(*config.Build)
+	if err == nil {
+		err = ctx.Err()
+	}
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
+	// Missing artifact metadata does not make the published image unusable
+	artifact, err := config.Builder.GetArtifact(*config.Build)
+	if err != nil {
+		config.BuildLogger.Write([]byte(fmt.Sprintf("Error collecting build artifact metadata: %s\n", err.Error())))
+	}
+	config.Build.Artifact = artifact
+
 	config.Build.State = BuildStatePublished
-	err = r.buildStore.Save(config.Build)
+	err = r.saveBuildState(config.Build, BuildStateSuccess)
 	if err != nil {
-		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		r.handleBuildProcessError(ctx, *config.Build, config.Builder, err, config.BuildLogger)
 		return
 	}
 
+	r.setCommitStatus(*config.Build, gitprovider.CommitStatusSuccess, "Prebuild is ready", config.BuildLogger)
+
 	err = config.Builder.CleanUp()
 	if err != nil {
 		errMsg := fmt.Sprintf("Error cleaning up build: %s\n", err.Error())
@@ -300,6 +410,125 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	}
 }
 
+// startBuildContext returns the context of a running build. The context is cancelled
+// with ErrBuildCancelled when the build is cancelled and with ErrBuildTimedOut once the timeout passes.
+func (r *BuildRunner) startBuildContext(b *Build) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	timeout := r.buildTimeout
+	if b.Timeout != nil {
+		timeout = time.Duration(*b.Timeout) * time.Minute
+	}
+
+	var timeoutTimer *time.Timer
+	if timeout > 0 {
+		timeoutTimer = time.AfterFunc(timeout, func() { cancel(ErrBuildTimedOut) })
+	}
+
+	r.activeBuildsMutex.Lock()
+	r.activeBuilds[b.Id] = cancel
+	r.activeBuildsMutex.Unlock()
+
+	return ctx, func() {
+		r.activeBuildsMutex.Lock()
+		delete(r.activeBuilds, b.Id)
+		r.activeBuildsMutex.Unlock()
+
+		if timeoutTimer != nil {
+			timeoutTimer.Stop()
+		}
+		cancel(nil)
+	}
+}
+
+// CancelBuilds stops the builds that were requested to be cancelled
+func (r *BuildRunner) CancelBuilds() {
+	builds, err := r.buildStore.List(&Filter{
+		States: &[]BuildState{BuildStatePendingCancel},
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, b := range builds {
+		r.activeBuildsMutex.Lock()
+		cancel, ok := r.activeBuilds[b.Id]
+		r.activeBuildsMutex.Unlock()
+
+		if ok {
+			cancel(ErrBuildCancelled)
+			continue
+		}
+
+		// The build is not running anymore, e.g. because the server was restarted while it was running
+		b.State = BuildStateCancelled
+		err = r.saveBuildState(b, BuildStatePendingCancel)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = r.removeBuildDir(b.Id)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+func (r *BuildRunner) removeBuildDir(buildId string) error {
+	if r.basePath == "" {
+		return nil
+	}
+
+	return os.RemoveAll(filepath.Join(r.basePath, buildId))
+}
+
+// handleBuildProcessError ends the build as cancelled or timed out if its context was cancelled and as failed otherwise
+func (r *BuildRunner) handleBuildProcessError(ctx context.Context, b Build, builder IBuilder, err error, buildLogger logs.Logger) {
+	cause := context.Cause(ctx)
+	if cause == nil || (!errors.Is(cause, ErrBuildCancelled) && !errors.Is(cause, ErrBuildTimedOut)) {
+		r.handleBuildError(b, builder, err, buildLogger)
+		return
+	}
+
+	b.State = BuildStateCancelled
+	commitStatusDescription := "Prebuild was cancelled"
+	if errors.Is(cause, ErrBuildTimedOut) {
+		b.State = BuildStateTimedOut
+		commitStatusDescription = "Prebuild timed out"
+	}
+
+	msg := "\n \n" + lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Build stopped: %s", cause.Error())) + "\n"
+
+	err = r.saveBuild(&b)
+	if err != nil {
+		msg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	} else if b.State == BuildStateTimedOut {
+		r.publishEvent(events.NewBuildResultEvent(b.Id, string(b.State), cause))
+	}
+
+	if builder != nil {
+		cleanupErr := builder.CleanUp()
+		if cleanupErr != nil {
+			msg += fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())
+		}
+	}
+
+	err = r.removeBuildDir(b.Id)
+	if err != nil {
+		msg += fmt.Sprintf("Error removing build directory: %s\n", err.Error())
+	}
+
+	buildLogger.Write([]byte(msg))
+
+	r.setCommitStatus(b, gitprovider.CommitStatusError, commitStatusDescription, buildLogger)
+
+	if r.telemetryEnabled {
+		r.logTelemetry(context.Background(), b, cause)
+	}
+}
+
 func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
 	var errMsg string
 	errMsg += "################################################\n"
@@ -307,9 +536,11 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	errMsg += "################################################\n"
 
 	b.State = BuildStateError
-	err = r.buildStore.Save(&b)
-	if err != nil {
-		errMsg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	saveErr := r.saveBuild(&b)
+	if saveErr != nil {
+		errMsg += fmt.Sprintf("Error saving build: %s\n", saveErr.Error())
+	} else {
+		r.publishEvent(events.NewBuildResultEvent(b.Id, string(b.State), err))
 	}
 
 	if builder != nil {
@@ -321,11 +552,84 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 
 	buildLogger.Write([]byte(errMsg + "\n"))
 
+	r.setCommitStatus(b, gitprovider.CommitStatusError, "Prebuild failed", buildLogger)
+
 	if r.telemetryEnabled {
 		r.logTelemetry(context.Background(), b, err)
 	}
 }
 
+// setCommitStatus reports the state of prebuild-triggered builds on the built commit.
+// Failing to report the status does not fail the build.
+func (r *BuildRunner) setCommitStatus(b Build, state gitprovider.CommitStatus, description string, buildLogger logs.Logger) {
+	if b.PrebuildId == "" || b.Repository == nil || b.Repository.Sha == "" {
+		return
+	}
+
+	gitProvider, _, err := r.gitProviderStore.GetGitProviderForUrl(b.Repository.Url)
+	if err == nil {
+		if !gitProvider.SupportsCommitStatus() {
+			return
+		}
+
+		// The server has no page that shows build logs in a browser so the status does not link anywhere
+		err = gitProvider.SetCommitStatus(b.Repository, b.Repository.Sha, state, description, "")
+	}
+
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Failed to set commit status: %s\n", err.Error())))
+	}
+}
+
+// saveBuild persists the build and notifies event stream subscribers of the build state
+func (r *BuildRunner) saveBuild(b *Build) error {
+	err := r.buildStore.Save(b)
+	if err != nil {
+		return err
+	}
+
+	r.publishBuildState(b)
+
+	return nil
+}
+
+// saveBuildState persists the new state of the build only if the stored state is still the expected one.
+// The state is changed concurrently when the build is cancelled, in which case the build context is cancelled
+// so that the build process stops instead of overwriting the cancellation.
+func (r *BuildRunner) saveBuildState(b *Build, expectedState BuildState) error {
+	err := r.buildStore.CompareAndSave(b, expectedState)
+	if err != nil {
+		if errors.Is(err, ErrBuildStateChanged) {
+			r.activeBuildsMutex.Lock()
+			cancel, ok := r.activeBuilds[b.Id]
+			r.activeBuildsMutex.Unlock()
+
+			if ok {
+				cancel(ErrBuildCancelled)
+			}
+		}
+		return err
+	}
+
+	r.publishBuildState(b)
+
+	return nil
+}
+
+func (r *BuildRunner) publishBuildState(b *Build) {
+	r.publishEvent(events.NewBuildEvent(b.Id, string(b.State)))
+
+	if b.State == BuildStatePublished {
+		r.publishEvent(events.NewBuildResultEvent(b.Id, string(b.State), nil))
+	}
+}
+
+func (r *BuildRunner) publishEvent(event events.Event) {
+	if r.eventBus != nil {
+		r.eventBus.Publish(event)
+	}
+}
+
 func (r *BuildRunner) logTelemetry(ctx context.Context, b Build, err error) {
 	telemetryProps := telemetry.NewBuildRunnerEventProps(ctx, b.Id, string(b.State))
 	event := telemetry.BuildRunnerEventRunBuild
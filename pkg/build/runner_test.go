@@ -4,15 +4,19 @@
 package build_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	t_build "github.com/daytonaio/daytona/internal/testing/build"
 	git_mocks "github.com/daytonaio/daytona/internal/testing/git/mocks"
+	gitprovider_mocks "github.com/daytonaio/daytona/internal/testing/gitprovider/mocks"
 	logger_mocks "github.com/daytonaio/daytona/internal/testing/logger/mocks"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	t_gitprovider "github.com/daytonaio/daytona/pkg/build/mocks"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -36,6 +40,7 @@ type BuildRunnerTestSuite struct {
 	loggerFactory              logs.LoggerFactory
 	mockBuildStore             build.Store
 	mockGitProviderConfigStore t_gitprovider.MockGitProviderConfigStore
+	eventBus                   events.IEventBus
 	Runner                     build.BuildRunner
 }
 
@@ -54,6 +59,7 @@ func TestBuildRunner(t *testing.T) {
 	s.mockBuildStore = t_build.NewInMemoryBuildStore()
 	logTempDir := t.TempDir()
 	s.loggerFactory = logs.NewLoggerFactory(nil, &logTempDir)
+	s.eventBus = events.NewEventBus()
 
 	s.Runner = *build.NewBuildRunner(build.BuildRunnerInstanceConfig{
 		Interval:         "0 */5 * * * *",
@@ -64,6 +70,7 @@ func TestBuildRunner(t *testing.T) {
 		BuilderFactory:   &s.mockBuilderFactory,
 		LoggerFactory:    s.loggerFactory,
 		TelemetryEnabled: false,
+		EventBus:         s.eventBus,
 	})
 
 	suite.Run(t, s)
@@ -117,12 +124,27 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	runningBuild.State = build.BuildStateRunning
 	s.mockBuilder.On("Build", runningBuild).Return("image", "user", nil)
 
+	layerCacheStats := &build.LayerCacheStats{
+		CacheImage:  "cache-image",
+		CachedSteps: 3,
+		TotalSteps:  4,
+	}
+	s.mockBuilder.On("GetLayerCacheStats").Return(layerCacheStats)
+
 	successBuild := *mocks.MockBuild
 	successBuild.State = build.BuildStateSuccess
 	successBuild.Image = util.Pointer("image")
 	successBuild.User = util.Pointer("user")
+	successBuild.LayerCache = layerCacheStats
 	s.mockBuilder.On("Publish", successBuild).Return(nil)
 
+	artifact := &build.BuildArtifact{
+		ImageDigest:    "sha256:digest",
+		CompressedSize: 1024,
+		BaseImage:      "ubuntu:22.04",
+	}
+	s.mockBuilder.On("GetArtifact", successBuild).Return(artifact, nil)
+
 	s.mockBuilder.On("CleanUp").Return(nil)
 
 	mockLogger := logger_mocks.NewMockLogger()
@@ -143,5 +165,216 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 
 	s.Require().Equal(mocks.MockBuild.Image, util.Pointer("image"))
 	s.Require().Equal(mocks.MockBuild.User, util.Pointer("user"))
+	s.Require().Equal(mocks.MockBuild.LayerCache, layerCacheStats)
+	s.Require().Equal(mocks.MockBuild.Artifact, artifact)
 	s.Require().Equal(mocks.MockBuild.State, build.BuildStatePublished)
+
+	var states []string
+	var eventTypes []events.EventType
+	for _, event := range s.eventBus.Recent() {
+		s.Require().Equal(mocks.MockBuild.Id, event.ResourceId)
+		eventTypes = append(eventTypes, event.Type)
+		if event.Type == events.EventTypeBuildStateChanged {
+			states = append(states, event.State)
+		}
+	}
+	s.Require().Equal([]string{string(build.BuildStateRunning), string(build.BuildStateSuccess), string(build.BuildStatePublished)}, states)
+	s.Require().Equal(events.EventTypeBuildSuccess, eventTypes[len(eventTypes)-1])
+}
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcessPrebuild() {
+	prebuild := *mocks.MockBuild
+	prebuild.Id = "prebuild-1"
+	prebuild.PrebuildId = "1"
+	prebuild.State = build.BuildStatePendingRun
+	prebuild.Repository = &gitprovider.GitRepository{
+		Url: mocks.MockBuild.Repository.Url,
+		Sha: "sha1",
+	}
+
+	err := s.mockBuildStore.Save(&prebuild)
+	s.Require().NoError(err)
+
+	mockGitProvider := &gitprovider_mocks.MockGitProvider{}
+	mockGitProvider.On("SupportsCommitStatus").Return(true)
+	mockGitProvider.On("SetCommitStatus", prebuild.Repository, "sha1", gitprovider.CommitStatusPending, mock.Anything, "").Return(nil)
+	mockGitProvider.On("SetCommitStatus", prebuild.Repository, "sha1", gitprovider.CommitStatusSuccess, mock.Anything, "").Return(nil)
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", prebuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+	s.mockGitProviderConfigStore.On("GetGitProviderForUrl", prebuild.Repository.Url).Return(mockGitProvider, gitProviderConfig.Id, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", prebuild.Repository, mock.Anything).Return(nil)
+
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything).Return("image", "user", nil)
+	mockBuilder.On("GetLayerCacheStats").Return(nil)
+	mockBuilder.On("Publish", mock.Anything).Return(nil)
+	mockBuilder.On("GetArtifact", mock.Anything).Return(nil, errors.New("registry unavailable"))
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     &mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &prebuild,
+		ProjectDir:  "",
+		GitService:  mockGitService,
+		Wg:          nil,
+	})
+
+	s.Require().Equal(build.BuildStatePublished, prebuild.State)
+	mockGitProvider.AssertExpectations(s.T())
+}
+
+// Starts the build process with a builder that blocks until the build is cancelled
+func (s *BuildRunnerTestSuite) startBlockingBuildProcess(runner *build.BuildRunner, b *build.Build) (chan struct{}, chan struct{}) {
+	b.State = build.BuildStatePendingRun
+	err := s.mockBuildStore.Save(b)
+	s.Require().NoError(err)
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", b.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", b.Repository, mock.Anything).Return(nil)
+
+	building := make(chan struct{})
+	cancelled := make(chan struct{})
+
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything).Run(func(mock.Arguments) {
+		close(building)
+		<-cancelled
+	}).Return("", "", errors.New("builder container removed"))
+	mockBuilder.On("Cancel", mock.Anything).Run(func(mock.Arguments) {
+		close(cancelled)
+	}).Return(nil)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runner.RunBuildProcess(build.BuildProcessConfig{
+			Builder:     &mockBuilder,
+			BuildLogger: mockLogger,
+			Build:       b,
+			GitService:  mockGitService,
+		})
+	}()
+
+	return building, done
+}
+
+// Returns a runner that does not publish events so that the events of other tests are not affected
+func (s *BuildRunnerTestSuite) newRunner(buildTimeout time.Duration) *build.BuildRunner {
+	return build.NewBuildRunner(build.BuildRunnerInstanceConfig{
+		Scheduler:        &s.mockScheduler,
+		BuildRunnerId:    "1",
+		BuildStore:       s.mockBuildStore,
+		GitProviderStore: &s.mockGitProviderConfigStore,
+		BuilderFactory:   &s.mockBuilderFactory,
+		LoggerFactory:    s.loggerFactory,
+		BuildTimeout:     buildTimeout,
+	})
+}
+
+func (s *BuildRunnerTestSuite) TestCancelBuilds() {
+	runner := s.newRunner(0)
+
+	b := *mocks.MockBuild
+	b.Id = "cancel-1"
+
+	building, done := s.startBlockingBuildProcess(runner, &b)
+	<-building
+
+	runningBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &b.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateRunning, runningBuild.State)
+
+	runningBuild.State = build.BuildStatePendingCancel
+	err = s.mockBuildStore.Save(runningBuild)
+	s.Require().NoError(err)
+
+	runner.CancelBuilds()
+	<-done
+
+	cancelledBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &b.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, cancelledBuild.State)
+}
+
+func (s *BuildRunnerTestSuite) TestCancelBuildsNotRunning() {
+	b := *mocks.MockBuild
+	b.Id = "cancel-2"
+	b.State = build.BuildStatePendingCancel
+	err := s.mockBuildStore.Save(&b)
+	s.Require().NoError(err)
+
+	s.newRunner(0).CancelBuilds()
+
+	cancelledBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &b.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, cancelledBuild.State)
+}
+
+func (s *BuildRunnerTestSuite) TestBuildTimeout() {
+	runner := s.newRunner(50 * time.Millisecond)
+
+	b := *mocks.MockBuild
+	b.Id = "timeout-1"
+
+	_, done := s.startBlockingBuildProcess(runner, &b)
+	<-done
+
+	timedOutBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &b.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateTimedOut, timedOutBuild.State)
+}
+
+func (s *BuildRunnerTestSuite) TestCancelBetweenTicks() {
+	runner := s.newRunner(0)
+
+	b := *mocks.MockBuild
+	b.Id = "cancel-3"
+	b.State = build.BuildStatePendingRun
+	err := s.mockBuildStore.Save(&b)
+	s.Require().NoError(err)
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", b.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", b.Repository, mock.Anything).Return(nil)
+
+	// The build is cancelled while it is being built, before the runner checks for cancelled builds
+	mockBuilder := mocks.MockBuilder{}
+	mockBuilder.On("Build", mock.Anything).Run(func(mock.Arguments) {
+		cancelledBuild := b
+		cancelledBuild.State = build.BuildStatePendingCancel
+		err := s.mockBuildStore.Save(&cancelledBuild)
+		s.Require().NoError(err)
+	}).Return("image", "user", nil)
+	mockBuilder.On("GetLayerCacheStats").Return(nil)
+	mockBuilder.On("Cancel", mock.Anything).Return(nil)
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     &mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &b,
+		GitService:  mockGitService,
+	})
+
+	mockBuilder.AssertNotCalled(s.T(), "Publish", mock.Anything)
+
+	cancelledBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &b.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateCancelled, cancelledBuild.State)
 }
@@ -13,17 +13,32 @@ type Store interface {
 	Find(filter *Filter) (*Build, error)
 	List(filter *Filter) ([]*Build, error)
 	Save(build *Build) error
+	// CompareAndSave saves the build only if its stored state is one of the expected states
+	// and returns ErrBuildStateChanged otherwise
+	CompareAndSave(build *Build, expectedStates ...BuildState) error
 	Delete(id string) error
+	// FindSbom returns the SBOM of the build artifact including the SBOM document.
+	// Builds returned by Find and List do not include the SBOM document.
+	FindSbom(buildId string) (*BuildSbom, error)
 }
 
 var (
-	ErrBuildNotFound = errors.New("build not found")
+	ErrBuildNotFound  = errors.New("build not found")
+	ErrBuildCancelled = errors.New("build cancelled")
+	ErrBuildTimedOut  = errors.New("build timed out")
+
+	ErrBuildStateChanged = errors.New("build state changed")
+	ErrSbomNotFound      = errors.New("sbom not found")
 )
 
 func IsBuildNotFound(err error) bool {
 	return err.Error() == ErrBuildNotFound.Error()
 }
 
+func IsSbomNotFound(err error) bool {
+	return err.Error() == ErrSbomNotFound.Error()
+}
+
 type Filter struct {
 	Id            *string
 	States        *[]BuildState
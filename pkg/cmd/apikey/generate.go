@@ -6,6 +6,7 @@ package apikey
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,12 @@ import (
 	view "github.com/daytonaio/daytona/pkg/views/apikey"
 )
 
+var roleFlag string
+var expiresInFlag time.Duration
+var workspaceScopeFlag []string
+var projectConfigScopeFlag []string
+var userFlag string
+
 var GenerateCmd = &cobra.Command{
 	Use:     "generate [NAME]",
 	Short:   "Generate a new API key",
@@ -47,9 +54,41 @@ var GenerateCmd = &cobra.Command{
 			}
 		}
 
-		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).Execute()
+		role := apiclient.ApikeyApiKeyRole(roleFlag)
+		req := apiclient.GenerateApiKeyDTO{
+			Role: &role,
+		}
+
+		if userFlag != "" {
+			req.UserId = &userFlag
+		}
+
+		if expiresInFlag < 0 {
+			return errors.New("expiry duration must be positive")
+		}
+
+		if expiresInFlag > 0 {
+			expiresAt := time.Now().Add(expiresInFlag).Format(time.RFC3339)
+			req.ExpiresAt = &expiresAt
+		}
+
+		if len(workspaceScopeFlag) > 0 || len(projectConfigScopeFlag) > 0 {
+			req.Scope = &apiclient.ApiKeyScope{
+				ProjectConfigNames: projectConfigScopeFlag,
+			}
+
+			for _, workspaceId := range workspaceScopeFlag {
+				workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+				req.Scope.WorkspaceIds = append(req.Scope.WorkspaceIds, workspace.Id)
+			}
+		}
+
+		key, res, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).ApiKey(req).Execute()
 		if err != nil {
-			return apiclient_util.HandleErrorResponse(nil, err)
+			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
 		serverConfig, _, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
@@ -67,3 +106,11 @@ var GenerateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	GenerateCmd.Flags().StringVarP(&roleFlag, "role", "r", string(apiclient.ApiKeyRoleAdmin), "API key role (admin, developer, read-only, ci)")
+	GenerateCmd.Flags().DurationVar(&expiresInFlag, "expires-in", 0, "Expire the API key after the given duration (e.g. 720h). The key does not expire by default")
+	GenerateCmd.Flags().StringArrayVar(&workspaceScopeFlag, "workspace", []string{}, "Limit the API key to the given workspace (can be used multiple times)")
+	GenerateCmd.Flags().StringArrayVar(&projectConfigScopeFlag, "project-config", []string{}, "Limit the API key to the given project config (can be used multiple times)")
+	GenerateCmd.Flags().StringVar(&userFlag, "user", "", "Give the API key to the user with the given ID or name. Non-admin keys of a user can only access resources owned by the user")
+}
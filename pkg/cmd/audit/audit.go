@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/audit"
+	"github.com/spf13/cobra"
+)
+
+var apiKeyFlag string
+var methodFlag string
+var resourceFlag string
+var outcomeFlag string
+var sinceFlag time.Duration
+var limitFlag int32
+
+var AuditCmd = &cobra.Command{
+	Use:     "audit",
+	Short:   "List the audit log of mutating API operations",
+	Args:    cobra.NoArgs,
+	GroupID: util.SERVER_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if limitFlag < 1 {
+			return errors.New("limit must be a positive number")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.AuditAPI.ListAuditLogs(ctx).Limit(limitFlag)
+
+		if apiKeyFlag != "" {
+			req = req.ApiKeyName(apiKeyFlag)
+		}
+
+		if methodFlag != "" {
+			req = req.Method(strings.ToUpper(methodFlag))
+		}
+
+		if resourceFlag != "" {
+			req = req.ResourceId(resourceFlag)
+		}
+
+		if outcomeFlag != "" {
+			req = req.Outcome(outcomeFlag)
+		}
+
+		if sinceFlag > 0 {
+			req = req.Since(time.Now().Add(-sinceFlag).Format(time.RFC3339))
+		}
+
+		auditLogs, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(auditLogs)
+			formattedData.Print()
+			return nil
+		}
+
+		audit.ListAuditLogs(auditLogs)
+		return nil
+	},
+}
+
+func init() {
+	AuditCmd.Flags().StringVar(&apiKeyFlag, "api-key", "", "Only show operations performed with the given API key name")
+	AuditCmd.Flags().StringVar(&methodFlag, "method", "", "Only show operations with the given HTTP method (POST, PUT, PATCH, DELETE)")
+	AuditCmd.Flags().StringVar(&resourceFlag, "resource", "", "Only show operations on the given resource ID")
+	AuditCmd.Flags().StringVar(&outcomeFlag, "outcome", "", "Only show operations with the given outcome (success, failure)")
+	AuditCmd.Flags().DurationVar(&sinceFlag, "since", 0, "Only show operations within the given duration (e.g. 24h)")
+	AuditCmd.Flags().Int32VarP(&limitFlag, "limit", "l", 50, "Maximum number of entries to show")
+	format.RegisterFormatFlag(AuditCmd)
+}
@@ -20,5 +20,7 @@ func init() {
 	BuildCmd.AddCommand(buildInfoCmd)
 	BuildCmd.AddCommand(buildRunCmd)
 	BuildCmd.AddCommand(buildDeleteCmd)
+	BuildCmd.AddCommand(buildCancelCmd)
 	BuildCmd.AddCommand(buildLogsCmd)
+	BuildCmd.AddCommand(buildPruneCmd)
 }
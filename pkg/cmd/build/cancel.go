@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var buildCancelCmd = &cobra.Command{
+	Use:   "cancel [BUILD]",
+	Short: "Cancel a queued or running build",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		var buildId string
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			buildList, res, err := apiClient.BuildAPI.ListBuilds(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(buildList) == 0 {
+				views_util.NotifyEmptyBuildList(false)
+				return nil
+			}
+
+			build := selection.GetBuildFromPrompt(buildList, "Cancel")
+			if build == nil {
+				return nil
+			}
+			buildId = build.Id
+		} else {
+			buildId = args[0]
+		}
+
+		res, err := apiClient.BuildAPI.CancelBuild(ctx, buildId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Build %s has been marked for cancellation", buildId))
+		return nil
+	},
+}
@@ -5,9 +5,11 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
@@ -30,11 +32,23 @@ var buildLogsCmd = &cobra.Command{
 			return err
 		}
 
-		query := ""
-		if followFlag {
-			query += "follow=true"
+		if sinceFlag != "" {
+			_, err := util.ParseLogSince(sinceFlag)
+			if err != nil {
+				return err
+			}
+		}
+
+		var tail *int
+		if cmd.Flags().Changed("tail") {
+			if tailFlag < 0 {
+				return errors.New("tail must be a non-negative number")
+			}
+			tail = &tailFlag
 		}
 
+		query := apiclient_util.GetLogQuery(followFlag, sinceFlag, tail)
+
 		ctx := context.Background()
 		var buildId string
 
@@ -78,7 +92,11 @@ var buildLogsCmd = &cobra.Command{
 }
 
 var followFlag bool
+var sinceFlag string
+var tailFlag int
 
 func init() {
-	buildLogsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
+	buildLogsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs until the build finishes")
+	buildLogsCmd.Flags().StringVar(&sinceFlag, "since", "", "Show logs since a timestamp (e.g. 2024-01-02T13:23:37Z) or relative duration (e.g. 42m)")
+	buildLogsCmd.Flags().IntVar(&tailFlag, "tail", 0, "Number of log entries to show from the end of the logs")
 }
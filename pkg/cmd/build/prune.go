@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/build/prune"
+	"github.com/spf13/cobra"
+)
+
+var buildPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete the builds that exceed the retention of their prebuild",
+	Long:  "Delete the published builds that exceed the retention of their prebuild. Builds used by workspaces are kept.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		result, res, err := apiClient.BuildAPI.PruneBuilds(ctx).DryRun(dryRunFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(result)
+			formattedData.Print()
+			return nil
+		}
+
+		view.Render(result)
+		return nil
+	},
+}
+
+var dryRunFlag bool
+
+func init() {
+	buildPruneCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "List the builds that would be pruned and the space that would be reclaimed")
+	format.RegisterFormatFlag(buildPruneCmd)
+}
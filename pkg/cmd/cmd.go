@@ -15,9 +15,11 @@ import (
 	"github.com/daytonaio/daytona/internal"
 	. "github.com/daytonaio/daytona/internal/util"
 	. "github.com/daytonaio/daytona/pkg/cmd/apikey"
+	. "github.com/daytonaio/daytona/pkg/cmd/audit"
 	. "github.com/daytonaio/daytona/pkg/cmd/autocomplete"
 	. "github.com/daytonaio/daytona/pkg/cmd/build"
 	. "github.com/daytonaio/daytona/pkg/cmd/containerregistry"
+	. "github.com/daytonaio/daytona/pkg/cmd/events"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
 	. "github.com/daytonaio/daytona/pkg/cmd/prebuild"
@@ -25,9 +27,12 @@ import (
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
 	. "github.com/daytonaio/daytona/pkg/cmd/projectconfig"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
+	. "github.com/daytonaio/daytona/pkg/cmd/secret"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
 	. "github.com/daytonaio/daytona/pkg/cmd/target"
 	. "github.com/daytonaio/daytona/pkg/cmd/telemetry"
+	. "github.com/daytonaio/daytona/pkg/cmd/user"
+	. "github.com/daytonaio/daytona/pkg/cmd/webhook"
 	. "github.com/daytonaio/daytona/pkg/cmd/workspace"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
@@ -63,6 +68,11 @@ func Execute() error {
 	rootCmd.AddCommand(DaemonServeCmd)
 	rootCmd.AddCommand(ServerCmd)
 	rootCmd.AddCommand(ApiKeyCmd)
+	rootCmd.AddCommand(UserCmd)
+	rootCmd.AddCommand(SecretCmd)
+	rootCmd.AddCommand(AuditCmd)
+	rootCmd.AddCommand(EventsCmd)
+	rootCmd.AddCommand(WebhookCmd)
 	rootCmd.AddCommand(ContainerRegistryCmd)
 	rootCmd.AddCommand(ProviderCmd)
 	rootCmd.AddCommand(TargetCmd)
@@ -71,10 +81,12 @@ func Execute() error {
 	rootCmd.AddCommand(ProfileCmd)
 	rootCmd.AddCommand(ProfileUseCmd)
 	rootCmd.AddCommand(whoamiCmd)
+	rootCmd.AddCommand(loginCmd)
 	rootCmd.AddCommand(purgeCmd)
 	rootCmd.AddCommand(GitProviderCmd)
 	rootCmd.AddCommand(StartCmd)
 	rootCmd.AddCommand(StopCmd)
+	rootCmd.AddCommand(SnapshotCmd)
 	rootCmd.AddCommand(RestartCmd)
 	rootCmd.AddCommand(InfoCmd)
 	rootCmd.AddCommand(PrebuildCmd)
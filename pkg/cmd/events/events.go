@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/events"
+	views_events "github.com/daytonaio/daytona/pkg/views/events"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var followFlag bool
+var typeFlag []string
+
+var EventsCmd = &cobra.Command{
+	Use:     "events",
+	Short:   "Output workspace, project and build events",
+	Args:    cobra.NoArgs,
+	GroupID: util.SERVER_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := ""
+		if len(typeFlag) > 0 {
+			query = "resourceType=" + strings.Join(typeFlag, ",")
+		}
+
+		if followFlag {
+			if query != "" {
+				query += "&"
+			}
+			query += "follow=true"
+		}
+
+		ws, res, err := apiclient_util.GetWebsocketConn(context.Background(), "/events", nil, &query)
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		defer ws.Close()
+
+		received := false
+		for {
+			var event events.Event
+			err := ws.ReadJSON(&event)
+			if err != nil {
+				break
+			}
+
+			received = true
+			views_events.RenderEvent(event)
+		}
+
+		if !received && !followFlag {
+			views_util.NotifyEmptyEventList(true)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	EventsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow new events as they are published")
+	EventsCmd.Flags().StringSliceVarP(&typeFlag, "type", "t", []string{}, "Only show events for the given resource types (workspace, project, build)")
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	view "github.com/daytonaio/daytona/pkg/views/login"
+	"github.com/pkg/browser"
+	"github.com/spf13/cobra"
+)
+
+var loginCmd = &cobra.Command{
+	Use:     "login",
+	Short:   "Log in to the server with its OIDC provider",
+	Long:    "Log in to the server with its OIDC provider and store a short-lived API key in the profile. The key is refreshed automatically if the provider issues refresh tokens.",
+	Args:    cobra.NoArgs,
+	GroupID: util.PROFILE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		profile, isNewProfile, err := getLoginProfile(c)
+		if err != nil {
+			return err
+		}
+
+		apiClient := apiclient_util.GetLoginApiClient(profile.Api.Url)
+
+		deviceLogin, res, err := apiClient.AuthAPI.StartOidcDeviceLogin(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		view.RenderDeviceLogin(deviceLogin)
+
+		verificationUri := deviceLogin.VerificationUri
+		if deviceLogin.VerificationUriComplete != nil {
+			verificationUri = *deviceLogin.VerificationUriComplete
+		}
+		_ = browser.OpenURL(verificationUri)
+
+		login, err := waitForLogin(ctx, apiClient, deviceLogin)
+		if err != nil {
+			return err
+		}
+
+		if isNewProfile {
+			err = c.AddProfile(profile)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = apiclient_util.SaveLogin(c, &profile, login)
+		if err != nil {
+			return err
+		}
+
+		view.RenderLogin(login, profile.Name)
+		return nil
+	},
+}
+
+// Returns the active profile or the profile given with the flags, which is created if it does not exist
+func getLoginProfile(c *config.Config) (config.Profile, bool, error) {
+	if loginApiUrlFlag == "" {
+		profile, err := c.GetActiveProfile()
+		return profile, false, err
+	}
+
+	if loginProfileNameFlag == "" {
+		return config.Profile{}, false, errors.New("a profile name is required when logging in to a new API URL")
+	}
+
+	profile, err := c.GetProfile(util.GenerateIdFromName(loginProfileNameFlag))
+	if err == nil {
+		profile.Api.Url = loginApiUrlFlag
+		return profile, false, nil
+	}
+
+	return config.Profile{
+		Id:   util.GenerateIdFromName(loginProfileNameFlag),
+		Name: loginProfileNameFlag,
+		Api: config.ServerApi{
+			Url: loginApiUrlFlag,
+		},
+	}, true, nil
+}
+
+// Polls the server until the user has logged in at the OIDC provider or the device code expires
+func waitForLogin(ctx context.Context, apiClient *apiclient.APIClient, deviceLogin *apiclient.DeviceLoginDTO) (*apiclient.LoginDTO, error) {
+	interval := time.Duration(deviceLogin.Interval) * time.Second
+
+	for {
+		time.Sleep(interval)
+
+		login, res, err := apiClient.AuthAPI.CompleteOidcDeviceLogin(ctx).DeviceLogin(apiclient.CompleteDeviceLoginDTO{
+			DeviceCode: deviceLogin.DeviceCode,
+		}).Execute()
+		if res != nil && res.StatusCode == http.StatusAccepted {
+			continue
+		}
+		if res != nil && res.StatusCode == http.StatusTooManyRequests {
+			interval += 5 * time.Second
+			continue
+		}
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return login, nil
+	}
+}
+
+var loginApiUrlFlag string
+var loginProfileNameFlag string
+
+func init() {
+	loginCmd.Flags().StringVarP(&loginApiUrlFlag, "api-url", "a", "", "Log in to the server at the API URL instead of the server of the active profile")
+	loginCmd.Flags().StringVarP(&loginProfileNameFlag, "name", "n", "", "Name of the profile to store the login in when logging in with --api-url")
+}
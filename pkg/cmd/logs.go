@@ -18,6 +18,8 @@ import (
 
 var followFlag bool
 var workspaceFlag bool
+var sinceFlag string
+var tailFlag int
 
 var logsCmd = &cobra.Command{
 	Use:     "logs [WORKSPACE] [PROJECT_NAME]",
@@ -97,7 +99,24 @@ var logsCmd = &cobra.Command{
 			})
 		}
 
-		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, followFlag, showWorkspaceLogs, nil)
+		if sinceFlag != "" {
+			_, err := util.ParseLogSince(sinceFlag)
+			if err != nil {
+				return err
+			}
+		}
+
+		var tail *int
+		if cmd.Flags().Changed("tail") {
+			if tailFlag < 0 {
+				return errors.New("tail must be a non-negative number")
+			}
+			tail = &tailFlag
+		}
+
+		query := apiclient_util.GetLogQuery(followFlag, sinceFlag, tail)
+
+		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, query, showWorkspaceLogs, nil)
 
 		return nil
 	},
@@ -106,4 +125,6 @@ var logsCmd = &cobra.Command{
 func init() {
 	logsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
 	logsCmd.Flags().BoolVarP(&workspaceFlag, "workspace", "w", false, "View workspace logs")
+	logsCmd.Flags().StringVar(&sinceFlag, "since", "", "Show logs since a timestamp (e.g. 2024-01-02T13:23:37Z) or relative duration (e.g. 42m)")
+	logsCmd.Flags().IntVar(&tailFlag, "tail", 0, "Number of log entries to show from the end of the logs")
 }
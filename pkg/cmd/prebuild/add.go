@@ -47,7 +47,7 @@ var prebuildAddCmd = &cobra.Command{
 
 		// If no arguments and no flags are provided, run the interactive CLI
 		if len(args) == 0 && branchFlag == "" && retentionFlag == 0 &&
-			commitIntervalFlag == 0 && triggerFilesFlag == nil {
+			commitIntervalFlag == 0 && triggerFilesFlag == nil && !pullRequestsFlag && !reviewWorkspaceFlag && !forkPullRequestsFlag && buildTimeoutFlag == 0 {
 			// Interactive CLI logic
 
 			projectConfigList, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
@@ -109,11 +109,26 @@ var prebuildAddCmd = &cobra.Command{
 			}
 
 			// Validate and handle required flags
-			if branchFlag == "" {
+			if branchFlag == "" && !pullRequestsFlag {
 				return errors.New("Branch flag is required when using flags")
 			}
 			prebuildAddView.Branch = branchFlag
 
+			if reviewWorkspaceFlag && !pullRequestsFlag {
+				return errors.New("Review workspaces can only be enabled for prebuilds that target pull requests")
+			}
+
+			if forkPullRequestsFlag && !pullRequestsFlag {
+				return errors.New("Pull requests from forks can only be enabled for prebuilds that target pull requests")
+			}
+
+			if runFlag && pullRequestsFlag {
+				return errors.New("Prebuilds that target pull requests run when a pull request is opened or updated and can not be run on add")
+			}
+			prebuildAddView.PullRequests = pullRequestsFlag
+			prebuildAddView.ReviewWorkspace = reviewWorkspaceFlag
+			prebuildAddView.ForkPullRequests = forkPullRequestsFlag
+
 			if retentionFlag <= 0 {
 				return errors.New("Retention must be a positive integer")
 			}
@@ -123,6 +138,10 @@ var prebuildAddCmd = &cobra.Command{
 				prebuildAddView.CommitInterval = strconv.Itoa(commitIntervalFlag)
 			}
 
+			if buildTimeoutFlag < 0 {
+				return errors.New("Build timeout must not be negative")
+			}
+
 			prebuildAddView.TriggerFiles = triggerFilesFlag
 			prebuildAddView.RunBuildOnAdd = runFlag
 		}
@@ -145,8 +164,11 @@ var prebuildAddCmd = &cobra.Command{
 		}
 
 		newPrebuild := apiclient.CreatePrebuildDTO{
-			Branch:    &prebuildAddView.Branch,
-			Retention: int32(retention),
+			Branch:           &prebuildAddView.Branch,
+			Retention:        int32(retention),
+			PullRequests:     &prebuildAddView.PullRequests,
+			ReviewWorkspace:  &prebuildAddView.ReviewWorkspace,
+			ForkPullRequests: &prebuildAddView.ForkPullRequests,
 		}
 
 		if commitInterval != 0 {
@@ -157,6 +179,10 @@ var prebuildAddCmd = &cobra.Command{
 			newPrebuild.TriggerFiles = prebuildAddView.TriggerFiles
 		}
 
+		if buildTimeoutFlag > 0 {
+			newPrebuild.BuildTimeout = util.Pointer(int32(buildTimeoutFlag))
+		}
+
 		prebuildId, res, err := apiClient.PrebuildAPI.SetPrebuild(ctx, prebuildAddView.ProjectConfigName).Prebuild(newPrebuild).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -183,4 +209,8 @@ func init() {
 	prebuildAddCmd.Flags().IntVarP(&retentionFlag, "retention", "r", 0, "Maximum number of resulting builds stored at a time")
 	prebuildAddCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	prebuildAddCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
+	prebuildAddCmd.Flags().BoolVar(&pullRequestsFlag, "pull-requests", false, "Run the prebuild for pull requests instead of pushes - the branch flag then sets the base branch and can be left blank to match all pull requests")
+	prebuildAddCmd.Flags().BoolVar(&reviewWorkspaceFlag, "review-workspace", false, "Create a workspace for each matching pull request and remove it once the pull request is closed")
+	prebuildAddCmd.Flags().BoolVar(&forkPullRequestsFlag, "fork-pull-requests", false, "Also run the prebuild for pull requests from forks - their code is not trusted and runs with the project config's environment")
+	prebuildAddCmd.Flags().IntVar(&buildTimeoutFlag, "build-timeout", 0, "Minutes after which builds of the prebuild are stopped - leave blank to use the server build timeout")
 }
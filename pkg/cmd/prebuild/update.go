@@ -47,7 +47,8 @@ var prebuildUpdateCmd = &cobra.Command{
 		}
 
 		// Determine the mode of operation: interactive or non-interactive
-		if len(args) == 2 || (branchFlag != "" || retentionFlag != 0 || commitIntervalFlag != 0 || len(triggerFilesFlag) > 0) {
+		if len(args) == 2 || (branchFlag != "" || retentionFlag != 0 || commitIntervalFlag != 0 || len(triggerFilesFlag) > 0 ||
+			cmd.Flags().Changed("pull-requests") || cmd.Flags().Changed("review-workspace") || cmd.Flags().Changed("build-timeout")) {
 			// Non-interactive mode: use provided arguments and flags
 			if len(args) < 2 {
 				return errors.New("Both project config name and prebuild ID must be specified when using flags")
@@ -77,11 +78,32 @@ var prebuildUpdateCmd = &cobra.Command{
 			if len(triggerFilesFlag) > 0 {
 				prebuild.TriggerFiles = triggerFilesFlag
 			}
+
+			if cmd.Flags().Changed("pull-requests") {
+				prebuild.PullRequests = &pullRequestsFlag
+			}
+
+			if cmd.Flags().Changed("review-workspace") {
+				prebuild.ReviewWorkspace = &reviewWorkspaceFlag
+			}
+
+			if cmd.Flags().Changed("fork-pull-requests") {
+				prebuild.ForkPullRequests = &forkPullRequestsFlag
+			}
+
+			if cmd.Flags().Changed("build-timeout") {
+				if buildTimeoutFlag < 0 {
+					return errors.New("Build timeout must not be negative")
+				}
+				prebuild.BuildTimeout = util.Pointer(int32(buildTimeoutFlag))
+			}
 			prebuildAddView.Branch = prebuild.Branch
 			prebuildAddView.Retention = strconv.Itoa(int(prebuild.Retention))
 			prebuildAddView.ProjectConfigName = projectConfigRecieved
 			prebuildAddView.TriggerFiles = prebuild.TriggerFiles
-			prebuildAddView.CommitInterval = strconv.Itoa(int(*prebuild.CommitInterval))
+			if prebuild.CommitInterval != nil {
+				prebuildAddView.CommitInterval = strconv.Itoa(int(*prebuild.CommitInterval))
+			}
 			retention = int(prebuild.Retention)
 		} else {
 			// Interactive mode: Prompt for details
@@ -131,8 +153,15 @@ var prebuildUpdateCmd = &cobra.Command{
 			add.PrebuildCreationView(&prebuildAddView, false)
 		}
 
+		prebuildAddView.PullRequests = prebuild.GetPullRequests()
+		prebuildAddView.ReviewWorkspace = prebuild.GetReviewWorkspace()
+		prebuildAddView.ForkPullRequests = prebuild.GetForkPullRequests()
 		prebuildAddView.RunBuildOnAdd = runFlag
 
+		if prebuildAddView.RunBuildOnAdd && prebuildAddView.PullRequests {
+			return errors.New("Prebuilds that target pull requests run when a pull request is opened or updated and can not be run on update")
+		}
+
 		var commitInterval int
 		if prebuildAddView.CommitInterval != "" {
 			commitInterval, err = strconv.Atoi(prebuildAddView.CommitInterval)
@@ -142,9 +171,13 @@ var prebuildUpdateCmd = &cobra.Command{
 		}
 
 		newPrebuild := apiclient.CreatePrebuildDTO{
-			Id:        &prebuild.Id,
-			Branch:    &prebuildAddView.Branch,
-			Retention: int32(retention),
+			Id:               &prebuild.Id,
+			Branch:           &prebuildAddView.Branch,
+			Retention:        int32(retention),
+			PullRequests:     &prebuildAddView.PullRequests,
+			ReviewWorkspace:  &prebuildAddView.ReviewWorkspace,
+			ForkPullRequests: &prebuildAddView.ForkPullRequests,
+			BuildTimeout:     prebuild.BuildTimeout,
 		}
 
 		if commitInterval != 0 {
@@ -181,11 +214,15 @@ var prebuildUpdateCmd = &cobra.Command{
 }
 
 var (
-	branchFlag         string
-	retentionFlag      int
-	commitIntervalFlag int
-	triggerFilesFlag   []string
-	runFlag            bool
+	branchFlag           string
+	retentionFlag        int
+	commitIntervalFlag   int
+	triggerFilesFlag     []string
+	runFlag              bool
+	pullRequestsFlag     bool
+	reviewWorkspaceFlag  bool
+	forkPullRequestsFlag bool
+	buildTimeoutFlag     int
 )
 
 func init() {
@@ -194,4 +231,8 @@ func init() {
 	prebuildUpdateCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	prebuildUpdateCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
 	prebuildUpdateCmd.Flags().BoolVar(&runFlag, "run", false, "Run the prebuild once after updating it")
+	prebuildUpdateCmd.Flags().BoolVar(&pullRequestsFlag, "pull-requests", false, "Run the prebuild for pull requests instead of pushes - the branch flag then sets the base branch")
+	prebuildUpdateCmd.Flags().BoolVar(&reviewWorkspaceFlag, "review-workspace", false, "Create a workspace for each matching pull request and remove it once the pull request is closed")
+	prebuildUpdateCmd.Flags().BoolVar(&forkPullRequestsFlag, "fork-pull-requests", false, "Also run the prebuild for pull requests from forks - their code is not trusted and runs with the project config's environment")
+	prebuildUpdateCmd.Flags().IntVar(&buildTimeoutFlag, "build-timeout", 0, "Minutes after which builds of the prebuild are stopped - 0 disables the timeout")
 }
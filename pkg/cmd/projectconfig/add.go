@@ -145,6 +145,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		RepositoryUrl:       createDtos[0].Source.Repository.Url,
 		EnvVars:             createDtos[0].EnvVars,
 		GitProviderConfigId: createDtos[0].GitProviderConfigId,
+		Resources:           createDtos[0].Resources,
 	}
 
 	res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(createProjectConfig).Execute()
@@ -160,6 +161,7 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		Prebuilds:           nil,
 		RepositoryUrl:       createProjectConfig.RepositoryUrl,
 		GitProviderConfigId: createProjectConfig.GitProviderConfigId,
+		Resources:           createProjectConfig.Resources,
 	}
 
 	if createProjectConfig.Image != nil {
@@ -186,6 +188,11 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
 	}
 
+	err := workspace_util.ValidateDockerfileFlags(projectConfigurationFlags)
+	if err != nil {
+		return nil, err
+	}
+
 	apiServerConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
@@ -242,6 +249,7 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		RepositoryUrl:       repoUrl,
 		EnvVars:             project.EnvVars,
 		GitProviderConfigId: project.GitProviderConfigId,
+		Resources:           project.Resources,
 	}
 
 	if newProjectConfig.Image == nil {
@@ -283,9 +291,17 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	CustomImageUser:   new(string),
 	Branches:          new([]string),
 	DevcontainerPath:  new(string),
+	Dockerfile:        new(string),
+	DockerfileContext: new(string),
+	DockerfileTarget:  new(string),
+	BuildArgs:         new([]string),
 	EnvVars:           new([]string),
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
+	Cpus:              new(float64),
+	Memory:            new(int),
+	Disk:              new(int),
+	PidsLimit:         new(int),
 }
 
 func init() {
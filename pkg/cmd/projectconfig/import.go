@@ -165,6 +165,7 @@ func importProjectConfig(ctx context.Context, apiClient *apiclient.APIClient, co
 		RepositoryUrl:       config.RepositoryUrl,
 		EnvVars:             config.EnvVars,
 		GitProviderConfigId: config.GitProviderConfigId,
+		Resources:           config.Resources,
 	}
 
 	if newProjectConfig.Image == nil {
@@ -203,6 +204,7 @@ func importProjectConfig(ctx context.Context, apiClient *apiclient.APIClient, co
 			BuildConfig:         config.BuildConfig,
 			EnvVars:             config.EnvVars,
 			GitProviderConfigId: config.GitProviderConfigId,
+			Resources:           config.Resources,
 		},
 	}
 
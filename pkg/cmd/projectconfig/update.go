@@ -68,6 +68,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 				BuildConfig:         projectConfig.BuildConfig,
 				EnvVars:             projectConfig.EnvVars,
 				GitProviderConfigId: projectConfig.GitProviderConfigId,
+				Resources:           projectConfig.Resources,
 			},
 		}
 
@@ -118,6 +119,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 			RepositoryUrl:       createDto[0].Source.Repository.Url,
 			EnvVars:             createDto[0].EnvVars,
 			GitProviderConfigId: createDto[0].GitProviderConfigId,
+			Resources:           createDto[0].Resources,
 		}
 
 		res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
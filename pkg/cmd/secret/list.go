@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	views_secret "github.com/daytonaio/daytona/pkg/views/secret"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List secrets",
+	Long:    "List secrets. Secret values are never returned by the server.",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		secretList, res, err := apiClient.SecretAPI.ListSecrets(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(secretList)
+			formattedData.Print()
+			return nil
+		}
+
+		views_secret.ListSecrets(secretList)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(listCmd)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_secret "github.com/daytonaio/daytona/pkg/views/secret"
+	"github.com/spf13/cobra"
+)
+
+var setCmd = &cobra.Command{
+	Use:     "set [NAME] [VALUE]",
+	Aliases: []string{"add", "update"},
+	Short:   "Create or update a secret",
+	Long:    "Create or update a secret. The value is read from stdin when piped and prompted for otherwise if it is not passed as an argument.",
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		value, err := getSecretValue(args)
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		s, res, err := apiClient.SecretAPI.SetSecret(ctx, args[0]).Secret(apiclient.SetSecretDTO{
+			Value: value,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Secret %s set successfully", s.Name))
+		views.RenderTip(fmt.Sprintf("Reference the secret in project config env vars as ${secret:%s}", s.Name))
+		return nil
+	},
+}
+
+func getSecretValue(args []string) (string, error) {
+	if len(args) == 2 {
+		return args[1], nil
+	}
+
+	stat, err := os.Stdin.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	if stat.Mode()&os.ModeCharDevice == 0 {
+		value, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(value), "\n"), nil
+	}
+
+	var value string
+	err = views_secret.SecretValueInput(args[0], &value)
+	return value, err
+}
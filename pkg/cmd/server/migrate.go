@@ -0,0 +1,176 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/db/migrations"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+var migrateYesFlag bool
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage the Daytona Server database schema",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateUpCmd.RunE(cmd, args)
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, dbConnection, err := openDbConnection()
+		if err != nil {
+			return err
+		}
+
+		pending, err := migrations.HasPendingMigrations(dbConnection)
+		if err != nil {
+			return err
+		}
+
+		if !pending {
+			views.RenderInfoMessage("The database is up to date")
+			return nil
+		}
+
+		err = backupDb(c, dbConnection)
+		if err != nil {
+			return err
+		}
+
+		err = migrations.Migrate(dbConnection)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage("Migrations applied successfully")
+		return nil
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the last applied migration",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !migrateYesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title("Revert the last migration?").
+						Description("Reverting a migration can remove data from the database. Make sure the Daytona Server is not running.").
+						Value(&migrateYesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				return err
+			}
+
+			if !migrateYesFlag {
+				fmt.Println("Operation canceled.")
+				return nil
+			}
+		}
+
+		c, dbConnection, err := openDbConnection()
+		if err != nil {
+			return err
+		}
+
+		err = backupDb(c, dbConnection)
+		if err != nil {
+			return err
+		}
+
+		err = migrations.RollbackLast(dbConnection)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage("Last migration reverted successfully")
+		return nil
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List migrations and whether they are applied",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, dbConnection, err := openDbConnection()
+		if err != nil {
+			return err
+		}
+
+		status, err := migrations.Status(dbConnection)
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(status)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderMigrationStatus(status)
+		return nil
+	},
+}
+
+func openDbConnection() (*server.Config, *gorm.DB, error) {
+	c, err := server.GetConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dbConnection, err := db.OpenConnection(c.Database.Driver, c.Database.Dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, dbConnection, nil
+}
+
+func backupDb(c *server.Config, dbConnection *gorm.DB) error {
+	if c.Database.Driver != db.SQLiteDriver {
+		return nil
+	}
+
+	backupPath, err := db.BackupSQLite(dbConnection, c.Database.Dsn)
+	if err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	if backupPath != "" {
+		views.RenderInfoMessage(fmt.Sprintf("Database backed up to %s", backupPath))
+	}
+
+	return nil
+}
+
+func init() {
+	migrateDownCmd.Flags().BoolVarP(&migrateYesFlag, "yes", "y", false, "Skip the confirmation prompt")
+	format.RegisterFormatFlag(migrateStatusCmd)
+
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateDownCmd)
+	migrateCmd.AddCommand(migrateStatusCmd)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete [API_KEY_NAME]",
+	Aliases: []string{"remove", "rm"},
+	Short:   "Delete the quota of a client API key, of a user or the default quota if neither is given",
+	Args:    validateQuotaArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if userFlag != "" {
+			u, err := getUser(ctx, apiClient, userFlag)
+			if err != nil {
+				return err
+			}
+
+			res, err := apiClient.ServerAPI.DeleteUserQuota(ctx, u.Id).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Quota of user %s deleted successfully", u.Name))
+			return nil
+		}
+
+		apiKeyName := getApiKeyName(args)
+
+		res, err := apiClient.ServerAPI.DeleteQuota(ctx, apiKeyName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if apiKeyName == quota.DefaultQuotaName {
+			views.RenderInfoMessage("Default quota deleted successfully")
+		} else {
+			views.RenderInfoMessage(fmt.Sprintf("Quota of API key %s deleted successfully", apiKeyName))
+		}
+		return nil
+	},
+}
+
+func init() {
+	deleteCmd.Flags().StringVar(&userFlag, "user", "", "Delete the quota of the user with the given ID or name")
+}
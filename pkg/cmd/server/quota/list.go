@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List quotas and the usage of the API keys and users they limit",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		quotas, res, err := apiClient.ServerAPI.ListQuotas(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(quotas)
+			formattedData.Print()
+			return nil
+		}
+
+		userList, res, err := apiClient.UserAPI.ListUsers(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		userNames := map[string]string{}
+		for _, u := range userList {
+			userNames[u.Id] = u.Name
+		}
+
+		view.ListQuotas(quotas, userNames)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(listCmd)
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/spf13/cobra"
+)
+
+var QuotaCmd = &cobra.Command{
+	Use:     "quota",
+	Aliases: []string{"quotas"},
+	Short:   "Manage the workspace and resource quotas of client API keys and users",
+}
+
+func init() {
+	QuotaCmd.AddCommand(listCmd)
+	QuotaCmd.AddCommand(setCmd)
+	QuotaCmd.AddCommand(deleteCmd)
+}
+
+// Returns the API key name of the quota, the default quota applies to API keys and users without a quota of their own
+func getApiKeyName(args []string) string {
+	if len(args) == 0 {
+		return quota.DefaultQuotaName
+	}
+
+	return args[0]
+}
+
+func validateQuotaArgs(cmd *cobra.Command, args []string) error {
+	if userFlag != "" && len(args) > 0 {
+		return errors.New("either an API key name or a user can be given")
+	}
+
+	return cobra.MaximumNArgs(1)(cmd, args)
+}
+
+// Returns the user with the given ID or name
+func getUser(ctx context.Context, apiClient *apiclient.APIClient, idOrName string) (*apiclient.User, error) {
+	userList, res, err := apiClient.UserAPI.ListUsers(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, u := range userList {
+		if u.Id == idOrName || u.Name == idOrName {
+			return &u, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user %s not found", idOrName)
+}
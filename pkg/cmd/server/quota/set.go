@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var workspacesFlag int32
+var runningProjectsFlag int32
+var cpusFlag float32
+var memoryFlag int32
+var diskFlag int32
+var userFlag string
+
+var setCmd = &cobra.Command{
+	Use:   "set [API_KEY_NAME]",
+	Short: "Set the quota of a client API key, of a user or the default quota if neither is given",
+	Long:  "Set the quota of a client API key, of a user or the default quota if neither is given.\nThe workspaces of a user are counted together across all of their API keys. The quota of an API key takes precedence over the quota of its user and the default quota applies to API keys and users without a quota of their own. Limits that are not passed keep their current value and a value of 0 removes the limit.",
+	Args:  validateQuotaArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !cmd.Flags().Changed("workspaces") && !cmd.Flags().Changed("running-projects") && !cmd.Flags().Changed("cpus") && !cmd.Flags().Changed("memory") && !cmd.Flags().Changed("disk") {
+			return errors.New("at least one limit must be set")
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		q := apiclient.Quota{}
+		var u *apiclient.User
+		if userFlag != "" {
+			u, err = getUser(ctx, apiClient, userFlag)
+			if err != nil {
+				return err
+			}
+			q.UserId = &u.Id
+		} else {
+			apiKeyName := getApiKeyName(args)
+			q.ApiKeyName = &apiKeyName
+		}
+
+		quotas, res, err := apiClient.ServerAPI.ListQuotas(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		for _, existing := range quotas {
+			if existing.GetApiKeyName() == q.GetApiKeyName() && existing.GetUserId() == q.GetUserId() {
+				q = apiclient.Quota{
+					ApiKeyName:         existing.ApiKeyName,
+					UserId:             existing.UserId,
+					MaxWorkspaces:      existing.MaxWorkspaces,
+					MaxRunningProjects: existing.MaxRunningProjects,
+					MaxCpus:            existing.MaxCpus,
+					MaxMemory:          existing.MaxMemory,
+					MaxDisk:            existing.MaxDisk,
+				}
+				break
+			}
+		}
+
+		if cmd.Flags().Changed("workspaces") {
+			q.MaxWorkspaces = &workspacesFlag
+		}
+		if cmd.Flags().Changed("running-projects") {
+			q.MaxRunningProjects = &runningProjectsFlag
+		}
+		if cmd.Flags().Changed("cpus") {
+			q.MaxCpus = &cpusFlag
+		}
+		if cmd.Flags().Changed("memory") {
+			q.MaxMemory = &memoryFlag
+		}
+		if cmd.Flags().Changed("disk") {
+			q.MaxDisk = &diskFlag
+		}
+
+		_, res, err = apiClient.ServerAPI.SetQuota(ctx).Quota(q).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if u != nil {
+			views.RenderInfoMessage(fmt.Sprintf("Quota of user %s set successfully", u.Name))
+		} else if q.GetApiKeyName() == quota.DefaultQuotaName {
+			views.RenderInfoMessage("Default quota set successfully")
+		} else {
+			views.RenderInfoMessage(fmt.Sprintf("Quota of API key %s set successfully", q.GetApiKeyName()))
+		}
+		return nil
+	},
+}
+
+func init() {
+	setCmd.Flags().Int32Var(&workspacesFlag, "workspaces", 0, "Maximum number of workspaces")
+	setCmd.Flags().Int32Var(&runningProjectsFlag, "running-projects", 0, "Maximum number of running projects")
+	setCmd.Flags().Float32Var(&cpusFlag, "cpus", 0, "Maximum number of CPU cores requested by running projects")
+	setCmd.Flags().Int32Var(&memoryFlag, "memory", 0, "Maximum memory in MB requested by running projects")
+	setCmd.Flags().Int32Var(&diskFlag, "disk", 0, "Maximum disk in GB requested by all projects")
+	setCmd.Flags().StringVar(&userFlag, "user", "", "Set the quota of the user with the given ID or name")
+}
@@ -22,12 +22,16 @@ import (
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/daytonaio/daytona/pkg/server/auth"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
@@ -35,7 +39,11 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -43,6 +51,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"gorm.io/gorm"
 )
 
 var DaemonServeCmd = &cobra.Command{
@@ -102,7 +111,7 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
-		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, telemetryService)
+		buildRunner, err := GetBuildRunner(c, buildRunnerConfig, server.EventBus, telemetryService)
 		if err != nil {
 			return err
 		}
@@ -202,30 +211,38 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	}
 	loggerFactory := logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir)
 
-	dbPath, err := getDbPath()
+	dbConnection, err := getDbConnection(c)
 	if err != nil {
 		return nil, err
 	}
 
-	dbConnection := db.GetSQLiteConnection(dbPath)
+	encrypter, err := getEncrypter(c, dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.EncryptStoredCredentials(dbConnection, encrypter)
+	if err != nil {
+		return nil, err
+	}
 
 	apiKeyStore, err := db.NewApiKeyStore(dbConnection)
 	if err != nil {
 		return nil, err
 	}
-	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection)
+	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
-	buildStore, err := db.NewBuildStore(dbConnection)
+	buildStore, err := db.NewBuildStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
-	projectConfigStore, err := db.NewProjectConfigStore(dbConnection)
+	projectConfigStore, err := db.NewProjectConfigStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
-	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
+	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +254,35 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
-	profileDataStore, err := db.NewProfileDataStore(dbConnection)
+	profileDataStore, err := db.NewProfileDataStore(dbConnection, encrypter)
+	if err != nil {
+		return nil, err
+	}
+	snapshotStore, err := db.NewSnapshotStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	auditStore, err := db.NewAuditStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	webhookStore, err := db.NewWebhookStore(dbConnection, encrypter)
+	if err != nil {
+		return nil, err
+	}
+	webhookDeliveryStore, err := db.NewWebhookDeliveryStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	quotaStore, err := db.NewQuotaStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	userStore, err := db.NewUserStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	secretStore, err := db.NewSecretStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
@@ -265,8 +310,9 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	})
 
 	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
-		ConfigStore:        gitProviderConfigStore,
-		ProjectConfigStore: projectConfigStore,
+		ConfigStore:           gitProviderConfigStore,
+		ProjectConfigStore:    projectConfigStore,
+		PrebuildWebhookSecret: c.PrebuildWebhookSecret,
 	})
 
 	prebuildWebhookEndpoint := fmt.Sprintf("%s%s", util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), constants.WEBHOOK_EVENT_ROUTE)
@@ -276,6 +322,7 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ConfigStore:             projectConfigStore,
 		BuildService:            buildService,
 		GitProviderService:      gitProviderService,
+		WorkspaceStore:          workspaceStore,
 	})
 
 	err = projectConfigService.StartRetentionPoller()
@@ -283,6 +330,13 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		return nil, err
 	}
 
+	go func() {
+		err := projectConfigService.UpdatePrebuildWebhooks()
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+
 	var localContainerRegistry server.ILocalContainerRegistry
 
 	if c.BuilderRegistryServer != "local" {
@@ -320,6 +374,32 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ApiKeyStore: apiKeyStore,
 	})
 
+	err = apiKeyService.StartExpiredKeyPurger()
+	if err != nil {
+		return nil, err
+	}
+
+	userService := users.NewUserService(users.UserServiceConfig{
+		UserStore:     userStore,
+		ApiKeyService: apiKeyService,
+	})
+
+	authServiceConfig := auth.AuthServiceConfig{
+		ApiKeyService: apiKeyService,
+		UserService:   userService,
+	}
+	if c.Oidc != nil {
+		authServiceConfig.Issuer = c.Oidc.Issuer
+		authServiceConfig.ClientId = c.Oidc.ClientId
+		authServiceConfig.ClientSecret = c.Oidc.ClientSecret
+		authServiceConfig.Scopes = c.Oidc.Scopes
+		authServiceConfig.GroupsClaim = c.Oidc.GroupsClaim
+		authServiceConfig.RoleMapping = c.Oidc.RoleMapping
+		authServiceConfig.DefaultRole = c.Oidc.DefaultRole
+		authServiceConfig.ApiKeyTtl = time.Duration(c.Oidc.ApiKeyTtl) * time.Minute
+	}
+	authService := auth.NewAuthService(authServiceConfig)
+
 	headscaleUrl := util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
 
 	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{
@@ -342,11 +422,27 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ProviderManager: providerManager,
 	})
 
+	eventBus := events.NewEventBus()
+
+	quotaService := quotas.NewQuotaService(quotas.QuotaServiceConfig{
+		QuotaStore:     quotaStore,
+		WorkspaceStore: workspaceStore,
+		ApiKeyService:  apiKeyService,
+		UserService:    userService,
+	})
+
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: secretStore,
+	})
+
 	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              providerTargetStore,
+		SnapshotStore:            snapshotStore,
 		ApiKeyService:            apiKeyService,
 		GitProviderService:       gitProviderService,
+		QuotaService:             quotaService,
+		SecretService:            secretService,
 		ContainerRegistryService: containerRegistryService,
 		BuilderImage:             c.BuilderImage,
 		BuildService:             buildService,
@@ -359,12 +455,31 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		Provisioner:              provisioner,
 		LoggerFactory:            loggerFactory,
 		TelemetryService:         telemetryService,
+		EventBus:                 eventBus,
+		IdleTimeout:              c.IdleTimeout,
 	})
 
+	err = workspaceService.StartIdleReaper()
+	if err != nil {
+		return nil, err
+	}
+
 	profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
 		ProfileDataStore: profileDataStore,
 	})
 
+	auditService := audit.NewAuditService(audit.AuditServiceConfig{
+		AuditStore: auditStore,
+	})
+
+	webhookService := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:  webhookStore,
+		DeliveryStore: webhookDeliveryStore,
+		EventBus:      eventBus,
+	})
+
+	webhookService.Start()
+
 	s := server.GetInstance(&server.ServerInstanceConfig{
 		Config:                   *c,
 		Version:                  version,
@@ -379,27 +494,37 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		GitProviderService:       gitProviderService,
 		ProviderManager:          providerManager,
 		ProfileDataService:       profileDataService,
+		AuditService:             auditService,
+		EventBus:                 eventBus,
+		WebhookService:           webhookService,
+		QuotaService:             quotaService,
+		UserService:              userService,
+		AuthService:              authService,
+		SecretService:            secretService,
 		TelemetryService:         telemetryService,
 	})
 
 	return s, s.Initialize()
 }
 
-func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService) (*build.BuildRunner, error) {
+func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, eventBus events.IEventBus, telemetryService telemetry.TelemetryService) (*build.BuildRunner, error) {
 	logsDir, err := build.GetBuildLogsDir()
 	if err != nil {
 		return nil, err
 	}
 	loggerFactory := logs.NewLoggerFactory(nil, &logsDir)
 
-	dbPath, err := getDbPath()
+	dbConnection, err := getDbConnection(c)
 	if err != nil {
 		return nil, err
 	}
 
-	dbConnection := db.GetSQLiteConnection(dbPath)
+	encrypter, err := getEncrypter(c, dbConnection)
+	if err != nil {
+		return nil, err
+	}
 
-	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
+	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
@@ -408,7 +533,7 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		ConfigStore: gitProviderConfigStore,
 	})
 
-	buildStore, err := db.NewBuildStore(dbConnection)
+	buildStore, err := db.NewBuildStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
@@ -419,7 +544,7 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 	}
 	buildImageNamespace = strings.TrimSuffix(buildImageNamespace, "/")
 
-	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection)
+	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection, encrypter)
 	if err != nil {
 		return nil, err
 	}
@@ -461,17 +586,21 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 	})
 
 	return build.NewBuildRunner(build.BuildRunnerInstanceConfig{
-		Interval:          buildRunnerConfig.Interval,
-		Scheduler:         build.NewCronScheduler(),
-		BuildRunnerId:     buildRunnerConfig.Id,
-		ContainerRegistry: buildImageCr,
-		TelemetryEnabled:  buildRunnerConfig.TelemetryEnabled,
-		GitProviderStore:  gitProviderService,
-		BuildStore:        buildStore,
-		BuilderFactory:    builderFactory,
-		LoggerFactory:     loggerFactory,
-		BasePath:          filepath.Join(configDir, "builds"),
-		TelemetryService:  telemetryService,
+		Interval:               buildRunnerConfig.Interval,
+		Scheduler:              build.NewCronScheduler(),
+		BuildRunnerId:          buildRunnerConfig.Id,
+		ContainerRegistry:      buildImageCr,
+		TelemetryEnabled:       buildRunnerConfig.TelemetryEnabled,
+		GitProviderStore:       gitProviderService,
+		BuildStore:             buildStore,
+		BuilderFactory:         builderFactory,
+		LoggerFactory:          loggerFactory,
+		BasePath:               filepath.Join(configDir, "builds"),
+		TelemetryService:       telemetryService,
+		EventBus:               eventBus,
+		MaxConcurrentBuilds:    c.MaxConcurrentBuilds,
+		MaxProjectConfigBuilds: c.MaxProjectConfigBuilds,
+		BuildTimeout:           time.Duration(c.BuildTimeout) * time.Minute,
 	}), nil
 }
 
@@ -499,18 +628,66 @@ func printServerStartedMessage(c *server.Config, runAsDaemon bool) {
 	started_view.Render(c.ApiPort, util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), runAsDaemon)
 }
 
-func getDbPath() (string, error) {
-	configDir, err := config.GetConfigDir()
+func getDbConnection(c *server.Config) (*gorm.DB, error) {
+	if c.Database.Driver == db.SQLiteDriver {
+		err := os.MkdirAll(filepath.Dir(c.Database.Dsn), 0755)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return db.GetConnection(c.Database.Driver, c.Database.Dsn)
+}
+
+// Stored secrets and credentials are encrypted with a key file unless a key command is configured
+func getEncrypter(c *server.Config, dbConnection *gorm.DB) (*encryption.Encrypter, error) {
+	if c.Encryption != nil && c.Encryption.KeyCommand != "" {
+		keyEncrypter, err := encryption.NewCommandKeyEncrypter(c.Encryption.KeyCommand)
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewEncrypter(keyEncrypter), nil
+	}
+
+	keyFile := ""
+	if c.Encryption != nil {
+		keyFile = c.Encryption.KeyFile
+	}
+
+	if keyFile != "" {
+		keyEncrypter, err := encryption.NewFileKeyEncrypter(keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewEncrypter(keyEncrypter), nil
+	}
+
+	configDir, err := server.GetConfigDir()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	keyFile = filepath.Join(configDir, "encryption.key")
+
+	keyEncrypter, err := encryption.NewFileKeyEncrypter(keyFile)
+	if errors.Is(err, encryption.ErrKeyFileNotFound) {
+		// Only generate the default key on first start, a new key would make the stored credentials unreadable
+		hasEncryptedCredentials, err := db.HasEncryptedCredentials(dbConnection)
+		if err != nil {
+			return nil, err
+		}
+		if hasEncryptedCredentials {
+			return nil, fmt.Errorf("encryption key file %s not found but the database contains encrypted credentials, restore the key file or set encryption.keyFile", keyFile)
+		}
 
-	err = os.MkdirAll(configDir, 0755)
+		keyEncrypter, err = encryption.GenerateFileKeyEncrypter(keyFile)
+	}
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return filepath.Join(configDir, "db"), nil
+	return encryption.NewEncrypter(keyEncrypter), nil
 }
 
 func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
 	"github.com/daytonaio/daytona/pkg/cmd/server/logs"
+	"github.com/daytonaio/daytona/pkg/cmd/server/quota"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	view "github.com/daytonaio/daytona/pkg/views/server"
@@ -84,5 +85,7 @@ func init() {
 	ServerCmd.AddCommand(startCmd)
 	ServerCmd.AddCommand(stopCmd)
 	ServerCmd.AddCommand(restartCmd)
+	ServerCmd.AddCommand(migrateCmd)
+	ServerCmd.AddCommand(quota.QuotaCmd)
 	ServerCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
 }
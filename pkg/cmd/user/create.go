@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:     "create [NAME]",
+	Aliases: []string{"add", "new"},
+	Short:   "Create a user",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		u, res, err := apiClient.UserAPI.CreateUser(ctx).User(apiclient.CreateUserDTO{
+			Name: args[0],
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("User %s created with ID %s", u.Name, u.Id))
+		views.RenderTip(fmt.Sprintf("Generate an API key for the user with 'daytona api-key generate --user %s --role developer'", u.Name))
+		return nil
+	},
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete [USER]",
+	Aliases: []string{"remove", "rm"},
+	Short:   "Delete a user and revoke its API keys",
+	Long:    "Delete a user and revoke its API keys. Workspaces, project configs and Git providers of the user are kept and remain accessible to admins.",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.UserAPI.DeleteUser(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage("User deleted successfully")
+		return nil
+	},
+}
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var UserCmd = &cobra.Command{
+	Use:     "user",
+	Aliases: []string{"users"},
+	Short:   "Manage the users that own workspaces, project configs and Git providers",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	UserCmd.AddCommand(createCmd)
+	UserCmd.AddCommand(listCmd)
+	UserCmd.AddCommand(deleteCmd)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var urlFlag string
+var secretFlag string
+var eventFlag []string
+
+var createCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"add", "new"},
+	Short:   "Create a webhook",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		secret := secretFlag
+		if secret == "" {
+			secret, err = generateSecret()
+			if err != nil {
+				return err
+			}
+		}
+
+		eventTypes := []apiclient.EventsEventType{}
+		for _, e := range eventFlag {
+			eventType, err := apiclient.NewEventsEventTypeFromValue(e)
+			if err != nil {
+				return err
+			}
+			eventTypes = append(eventTypes, *eventType)
+		}
+
+		w, res, err := apiClient.WebhookAPI.CreateWebhook(ctx).Webhook(apiclient.CreateWebhookDTO{
+			Url:        urlFlag,
+			Secret:     secret,
+			EventTypes: eventTypes,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Webhook %s created", w.Id))
+
+		if secretFlag == "" {
+			views.RenderInfoMessage(fmt.Sprintf("Payloads are signed with the secret %s\nSave it now, it will not be shown again", secret))
+		}
+
+		return nil
+	},
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func init() {
+	createCmd.Flags().StringVar(&urlFlag, "url", "", "URL that events are delivered to")
+	createCmd.Flags().StringVar(&secretFlag, "secret", "", "Secret used to sign payloads. A random secret is generated if not set")
+	createCmd.Flags().StringSliceVarP(&eventFlag, "event", "e", []string{}, "Event types to deliver (e.g. workspace.created,build.state.changed). All events are delivered if not set")
+	_ = createCmd.MarkFlagRequired("url")
+}
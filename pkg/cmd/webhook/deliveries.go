@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/webhook"
+	"github.com/spf13/cobra"
+)
+
+var limitFlag int32
+
+var deliveriesCmd = &cobra.Command{
+	Use:   "deliveries [WEBHOOK_ID]",
+	Short: "List delivery attempts of a webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		if limitFlag < 1 {
+			return errors.New("limit must be a positive number")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		deliveries, res, err := apiClient.WebhookAPI.ListWebhookDeliveries(ctx, args[0]).Limit(limitFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(deliveries)
+			formattedData.Print()
+			return nil
+		}
+
+		webhook.ListDeliveries(deliveries)
+		return nil
+	},
+}
+
+func init() {
+	deliveriesCmd.Flags().Int32VarP(&limitFlag, "limit", "l", 50, "Maximum number of deliveries to show")
+	format.RegisterFormatFlag(deliveriesCmd)
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var WebhookCmd = &cobra.Command{
+	Use:     "webhook",
+	Aliases: []string{"webhooks"},
+	Short:   "Manage webhooks for workspace, project and build events",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	WebhookCmd.AddCommand(createCmd)
+	WebhookCmd.AddCommand(listCmd)
+	WebhookCmd.AddCommand(deleteCmd)
+	WebhookCmd.AddCommand(deliveriesCmd)
+}
@@ -48,7 +48,8 @@ var CreateCmd = &cobra.Command{
 		var workspaceName string
 		var existingWorkspaceNames []string
 		var existingProjectConfigNames []string
-		promptUsingTUI := len(args) == 0
+		var sourceWorkspace *apiclient.WorkspaceDTO
+		promptUsingTUI := len(args) == 0 && fromFlag == ""
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -82,7 +83,32 @@ var CreateCmd = &cobra.Command{
 			existingWorkspaceNames = append(existingWorkspaceNames, workspaceInfo.Name)
 		}
 
-		if promptUsingTUI {
+		if fromFlag != "" {
+			if len(args) > 0 || workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) {
+				return errors.New("repository urls and project configuration flags can not be used when cloning a workspace")
+			}
+
+			sourceWorkspace, err = apiclient_util.GetWorkspace(url.PathEscape(fromFlag), false)
+			if err != nil {
+				return err
+			}
+
+			if targetNameFlag != "" && targetNameFlag != sourceWorkspace.Target {
+				return fmt.Errorf("a clone of workspace '%s' can only be created on target '%s'", sourceWorkspace.Name, sourceWorkspace.Target)
+			}
+			targetNameFlag = sourceWorkspace.Target
+
+			for _, p := range sourceWorkspace.Projects {
+				projects = append(projects, apiclient.CreateProjectDTO{
+					Name:                p.Name,
+					GitProviderConfigId: p.GitProviderConfigId,
+				})
+			}
+
+			if workspaceName == "" {
+				workspaceName = workspace_util.GetSuggestedName(sourceWorkspace.Name, existingWorkspaceNames)
+			}
+		} else if promptUsingTUI {
 			err = processPrompting(ctx, apiClient, &workspaceName, &projects, existingWorkspaceNames)
 			if err != nil {
 				if common.IsCtrlCAbort(err) {
@@ -171,14 +197,29 @@ var CreateCmd = &cobra.Command{
 		id = stringid.TruncateID(id)
 
 		logsContext, stopLogs := context.WithCancel(context.Background())
-		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
-
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
-			Id:       id,
-			Name:     workspaceName,
-			Target:   target.Name,
-			Projects: projects,
-		}).Execute()
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, apiclient_util.GetLogQuery(true, "", nil), true, nil)
+
+		var idleTimeout *int32
+		if cmd.Flags().Changed("idle-timeout") {
+			idleTimeout = &idleTimeoutFlag
+		}
+
+		var createdWorkspace *apiclient.Workspace
+		if sourceWorkspace != nil {
+			createdWorkspace, res, err = apiClient.WorkspaceAPI.CloneWorkspace(ctx, sourceWorkspace.Id).Workspace(apiclient.CloneWorkspaceDTO{
+				Id:          id,
+				Name:        workspaceName,
+				IdleTimeout: idleTimeout,
+			}).Execute()
+		} else {
+			createdWorkspace, res, err = apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
+				Id:          id,
+				Name:        workspaceName,
+				Target:      target.Name,
+				IdleTimeout: idleTimeout,
+				Projects:    projects,
+			}).Execute()
+		}
 		if err != nil {
 			stopLogs()
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -243,6 +284,8 @@ var targetNameFlag string
 var noIdeFlag bool
 var blankFlag bool
 var multiProjectFlag bool
+var idleTimeoutFlag int32
+var fromFlag string
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -250,9 +293,17 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	CustomImageUser:   new(string),
 	Branches:          new([]string),
 	DevcontainerPath:  new(string),
+	Dockerfile:        new(string),
+	DockerfileContext: new(string),
+	DockerfileTarget:  new(string),
+	BuildArgs:         new([]string),
 	EnvVars:           new([]string),
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
+	Cpus:              new(float64),
+	Memory:            new(int),
+	Disk:              new(int),
+	PidsLimit:         new(int),
 }
 
 func init() {
@@ -270,6 +321,8 @@ func init() {
 	CreateCmd.Flags().BoolVarP(&noIdeFlag, "no-ide", "n", false, "Do not open the workspace in the IDE after workspace creation")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
 	CreateCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	CreateCmd.Flags().Int32Var(&idleTimeoutFlag, "idle-timeout", 0, "Stop the workspace after the specified number of minutes of inactivity (0 to disable). Defaults to the server setting")
+	CreateCmd.Flags().StringVar(&fromFlag, "from", "", "Create the workspace from the current state of an existing workspace, including uncommitted changes")
 	CreateCmd.Flags().StringSliceVar(projectConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branches to use in the projects")
 
 	workspace_util.AddProjectConfigurationFlags(CreateCmd, projectConfigurationFlags, true)
@@ -353,6 +406,11 @@ func processCmdArguments(ctx context.Context, repoUrls []string, apiClient *apic
 		return nil, fmt.Errorf("can't set devcontainer file path if builder is not set to %s", views_util.DEVCONTAINER)
 	}
 
+	err := workspace_util.ValidateDockerfileFlags(projectConfigurationFlags)
+	if err != nil {
+		return nil, err
+	}
+
 	var projectConfig *apiclient.ProjectConfig
 
 	existingProjectConfigNames := []string{}
@@ -0,0 +1,167 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/snapshot/list"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/spf13/cobra"
+)
+
+var snapshotNameFlag string
+
+var SnapshotCmd = &cobra.Command{
+	Use:     "snapshot",
+	Short:   "Manage project snapshots",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create WORKSPACE [PROJECT]",
+	Short: "Snapshot the state of a project",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, projectName, err := getSnapshotProject(args)
+		if err != nil {
+			return err
+		}
+		if projectName == "" {
+			return nil
+		}
+
+		snapshot, res, err := apiClient.WorkspaceAPI.CreateSnapshot(ctx, workspace.Id, projectName).Snapshot(apiclient.CreateSnapshotDTO{
+			Name: &snapshotNameFlag,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Snapshot '%s' of project '%s' created successfully", snapshot.Name, projectName))
+		return nil
+	},
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:     "list WORKSPACE [PROJECT]",
+	Short:   "List project snapshots",
+	Aliases: []string{"ls"},
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, projectName, err := getSnapshotProject(args)
+		if err != nil {
+			return err
+		}
+		if projectName == "" {
+			return nil
+		}
+
+		snapshotList, res, err := apiClient.WorkspaceAPI.ListSnapshots(ctx, workspace.Id, projectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(snapshotList)
+			formattedData.Print()
+			return nil
+		}
+
+		list.ListSnapshots(snapshotList)
+		return nil
+	},
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore WORKSPACE [PROJECT]",
+	Short: "Restore a project from a snapshot",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if snapshotNameFlag == "" {
+			return errors.New("snapshot name is required. You can see all snapshots of a project by running the command `daytona snapshot list`")
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, projectName, err := getSnapshotProject(args)
+		if err != nil {
+			return err
+		}
+		if projectName == "" {
+			return nil
+		}
+
+		res, err := apiClient.WorkspaceAPI.RestoreSnapshot(ctx, workspace.Id, projectName, url.PathEscape(snapshotNameFlag)).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' restored from snapshot '%s'", projectName, snapshotNameFlag))
+		return nil
+	},
+}
+
+func getSnapshotProject(args []string) (*apiclient.WorkspaceDTO, string, error) {
+	workspace, err := apiclient_util.GetWorkspace(url.PathEscape(args[0]), false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(args) == 2 {
+		return workspace, args[1], nil
+	}
+
+	if len(workspace.Projects) == 1 {
+		return workspace, workspace.Projects[0].Name, nil
+	} else if len(workspace.Projects) == 0 {
+		return nil, "", errors.New("no projects found in workspace")
+	}
+
+	project := selection.GetProjectFromPrompt(workspace.Projects, "Snapshot")
+	if project == nil {
+		return workspace, "", nil
+	}
+
+	return workspace, project.Name, nil
+}
+
+func init() {
+	snapshotCreateCmd.Flags().StringVarP(&snapshotNameFlag, "name", "n", "", "Snapshot name (defaults to the current timestamp)")
+
+	snapshotRestoreCmd.Flags().StringVarP(&snapshotNameFlag, "name", "n", "", "Name of the snapshot to restore")
+
+	format.RegisterFormatFlag(snapshotListCmd)
+
+	SnapshotCmd.AddCommand(snapshotCreateCmd)
+	SnapshotCmd.AddCommand(snapshotListCmd)
+	SnapshotCmd.AddCommand(snapshotRestoreCmd)
+}
@@ -80,7 +80,7 @@ var StopCmd = &cobra.Command{
 				projectNames := util.ArrayMap(workspace.Projects, func(p apiclient.Project) string {
 					return p.Name
 				})
-				apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
+				apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, apiclient_util.GetLogQuery(false, "", nil), true, &from)
 				views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
 			}
 		} else {
@@ -105,7 +105,7 @@ var StopCmd = &cobra.Command{
 				})
 			}
 
-			apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
+			apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, apiclient_util.GetLogQuery(false, "", nil), true, &from)
 
 			if stopProjectFlag != "" {
 				views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully stopped", stopProjectFlag, workspaceId))
@@ -148,7 +148,7 @@ func stopAllWorkspaces(activeProfile config.Profile, from time.Time) error {
 			return p.Name
 		})
 
-		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
+		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, apiclient_util.GetLogQuery(false, "", nil), true, &from)
 		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
 	}
 	return nil
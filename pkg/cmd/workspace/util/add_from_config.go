@@ -40,6 +40,7 @@ func AddProjectFromConfig(projectConfig *apiclient.ProjectConfig, apiClient *api
 	project := &apiclient.CreateProjectDTO{
 		Name:                projectConfig.Name,
 		GitProviderConfigId: projectConfig.GitProviderConfigId,
+		Resources:           projectConfig.Resources,
 		Source: apiclient.CreateProjectSourceDTO{
 			Repository: *configRepo,
 		},
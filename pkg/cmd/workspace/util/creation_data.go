@@ -94,6 +94,7 @@ func GetProjectsCreationDataFromPrompt(config ProjectsDataPromptConfig) ([]apicl
 				createProjectDto := apiclient.CreateProjectDTO{
 					Name:                projectName,
 					GitProviderConfigId: projectConfig.GitProviderConfigId,
+					Resources:           projectConfig.Resources,
 					Source: apiclient.CreateProjectSourceDTO{
 						Repository: *configRepo,
 					},
@@ -267,6 +268,34 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 
 	}
 
+	if *projectConfigurationFlags.Builder == views_util.DOCKERFILE || *projectConfigurationFlags.Dockerfile != "" {
+		dockerfileConfig := &apiclient.DockerfileConfig{
+			Path: create.DOCKERFILE_FILEPATH,
+		}
+		if *projectConfigurationFlags.Dockerfile != "" {
+			dockerfileConfig.Path = *projectConfigurationFlags.Dockerfile
+		}
+		if *projectConfigurationFlags.DockerfileContext != "" {
+			dockerfileConfig.Context = projectConfigurationFlags.DockerfileContext
+		}
+		if *projectConfigurationFlags.DockerfileTarget != "" {
+			dockerfileConfig.Target = projectConfigurationFlags.DockerfileTarget
+		}
+
+		if len(*projectConfigurationFlags.BuildArgs) > 0 {
+			dockerfileConfig.BuildArgs = map[string]string{}
+			for _, buildArg := range *projectConfigurationFlags.BuildArgs {
+				parts := strings.SplitN(buildArg, "=", 2)
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("Invalid build argument format: %s\n", buildArg)
+				}
+				dockerfileConfig.BuildArgs[parts[0]] = parts[1]
+			}
+		}
+
+		project.BuildConfig.Dockerfile = dockerfileConfig
+	}
+
 	if *projectConfigurationFlags.Builder == views_util.NONE || *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
 		project.BuildConfig = nil
 		if *projectConfigurationFlags.CustomImage != "" || *projectConfigurationFlags.CustomImageUser != "" {
@@ -288,6 +317,22 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 
 	project.EnvVars = envVars
 
+	if *projectConfigurationFlags.Cpus != 0 || *projectConfigurationFlags.Memory != 0 || *projectConfigurationFlags.Disk != 0 || *projectConfigurationFlags.PidsLimit != 0 {
+		project.Resources = &apiclient.ProjectResources{}
+		if *projectConfigurationFlags.Cpus != 0 {
+			project.Resources.Cpus = apiclient.PtrFloat32(float32(*projectConfigurationFlags.Cpus))
+		}
+		if *projectConfigurationFlags.Memory != 0 {
+			project.Resources.Memory = apiclient.PtrInt32(int32(*projectConfigurationFlags.Memory))
+		}
+		if *projectConfigurationFlags.Disk != 0 {
+			project.Resources.Disk = apiclient.PtrInt32(int32(*projectConfigurationFlags.Disk))
+		}
+		if *projectConfigurationFlags.PidsLimit != 0 {
+			project.Resources.PidsLimit = apiclient.PtrInt32(int32(*projectConfigurationFlags.PidsLimit))
+		}
+	}
+
 	return project, nil
 }
 
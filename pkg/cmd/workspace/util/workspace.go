@@ -18,37 +18,62 @@ type ProjectConfigurationFlags struct {
 	CustomImageUser   *string
 	Branches          *[]string
 	DevcontainerPath  *string
+	Dockerfile        *string
+	DockerfileContext *string
+	DockerfileTarget  *string
+	BuildArgs         *[]string
 	EnvVars           *[]string
 	Manual            *bool
 	GitProviderConfig *string
+	Cpus              *float64
+	Memory            *int
+	Disk              *int
+	PidsLimit         *int
 }
 
 func AddProjectConfigurationFlags(cmd *cobra.Command, flags ProjectConfigurationFlags, multiProjectFlagException bool) {
 	cmd.Flags().StringVar(flags.CustomImage, "custom-image", "", "Create the project with the custom image passed as the flag value; Requires setting --custom-image-user flag as well")
 	cmd.Flags().StringVar(flags.CustomImageUser, "custom-image-user", "", "Create the project with the custom image user passed as the flag value; Requires setting --custom-image flag as well")
 	cmd.Flags().StringVar(flags.DevcontainerPath, "devcontainer-path", "", "Automatically assign the devcontainer builder with the path passed as the flag value")
-	cmd.Flags().Var(flags.Builder, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s)", views_util.AUTOMATIC, views_util.DEVCONTAINER, views_util.NONE))
+	cmd.Flags().StringVar(flags.Dockerfile, "dockerfile", "", "Automatically assign the Dockerfile builder with the Dockerfile path passed as the flag value")
+	cmd.Flags().StringVar(flags.DockerfileContext, "dockerfile-context", "", "Build context of the Dockerfile relative to the project root - defaults to the project root")
+	cmd.Flags().StringVar(flags.DockerfileTarget, "dockerfile-target", "", "Target stage of the Dockerfile to build")
+	cmd.Flags().StringArrayVar(flags.BuildArgs, "build-arg", []string{}, "Specify Dockerfile build arguments (e.g. --build-arg 'KEY1=VALUE1' --build-arg 'KEY2=VALUE2' ...')")
+	cmd.Flags().Var(flags.Builder, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s/%s)", views_util.AUTOMATIC, views_util.DEVCONTAINER, views_util.DOCKERFILE, views_util.NONE))
 	cmd.Flags().StringArrayVar(flags.EnvVars, "env", []string{}, "Specify environment variables (e.g. --env 'KEY1=VALUE1' --env 'KEY2=VALUE2' ...')")
 	cmd.Flags().BoolVar(flags.Manual, "manual", false, "Manually enter the Git repository")
 	cmd.Flags().StringVar(flags.GitProviderConfig, "git-provider-config", "", "Specify the Git provider configuration ID or alias")
+	cmd.Flags().Float64Var(flags.Cpus, "cpus", 0, "Number of CPU cores the project can use. Defaults to the target setting")
+	cmd.Flags().IntVar(flags.Memory, "memory", 0, "Memory limit of the project in MB. Defaults to the target setting")
+	cmd.Flags().IntVar(flags.Disk, "disk", 0, "Disk quota of the project in GB. Defaults to the target setting")
+	cmd.Flags().IntVar(flags.PidsLimit, "pids-limit", 0, "Maximum number of processes in the project. Defaults to the target setting")
 
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image")
 	cmd.MarkFlagsMutuallyExclusive("builder", "custom-image-user")
 	cmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image")
 	cmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image-user")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile", "custom-image")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile", "custom-image-user")
+	cmd.MarkFlagsMutuallyExclusive("dockerfile", "devcontainer-path")
 	cmd.MarkFlagsRequiredTogether("custom-image", "custom-image-user")
 
 	if multiProjectFlagException {
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "custom-image")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "custom-image-user")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "devcontainer-path")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "dockerfile")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "builder")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "env")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "cpus")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "memory")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "disk")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "pids-limit")
 	}
 }
 
 func CheckAnyProjectConfigurationFlagSet(flags ProjectConfigurationFlags) bool {
-	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0
+	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Dockerfile != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0 ||
+		*flags.Cpus != 0 || *flags.Memory != 0 || *flags.Disk != 0 || *flags.PidsLimit != 0
 }
 
 func IsProjectRunning(workspace *apiclient.WorkspaceDTO, projectName string) bool {
@@ -73,3 +98,17 @@ func GetProjectProviderMetadata(workspace *apiclient.WorkspaceDTO, projectName s
 	}
 	return "", nil
 }
+
+// Dockerfile specific flags can only be used together with the Dockerfile builder
+func ValidateDockerfileFlags(flags ProjectConfigurationFlags) error {
+	if *flags.Builder != "" && *flags.Builder != views_util.DOCKERFILE && *flags.Dockerfile != "" {
+		return fmt.Errorf("can't set Dockerfile path if builder is not set to %s", views_util.DOCKERFILE)
+	}
+
+	if *flags.Dockerfile == "" && *flags.Builder != views_util.DOCKERFILE &&
+		(*flags.DockerfileContext != "" || *flags.DockerfileTarget != "" || len(*flags.BuildArgs) > 0) {
+		return errors.New("can't set Dockerfile build options without using the Dockerfile builder")
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerregistry
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+const defaultRegistryHost = "registry-1.docker.io"
+
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+var authChallengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+var registryHttpClient = http.DefaultClient
+
+// Registry metadata of a pushed image
+type ImageManifest struct {
+	Digest string
+	// Sum of the compressed layer sizes and the image config size in bytes
+	CompressedSize int64
+}
+
+type manifest struct {
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+	Manifests []descriptor `json:"manifests"`
+}
+
+type descriptor struct {
+	Digest   string    `json:"digest"`
+	Size     int64     `json:"size"`
+	Platform *platform `json:"platform,omitempty"`
+}
+
+type platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+// Fetches the manifest of the image from the registry. Multi-platform images are
+// resolved to the manifest of the platform the server is running on.
+func GetImageManifest(imageName string, cr *ContainerRegistry) (*ImageManifest, error) {
+	host, repository, reference := parseImageName(imageName)
+
+	m, digest, err := fetchManifest(host, repository, reference, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Manifests) > 0 {
+		platformDigest := m.Manifests[0].Digest
+		for _, d := range m.Manifests {
+			if d.Platform != nil && d.Platform.OS == "linux" && d.Platform.Architecture == runtime.GOARCH {
+				platformDigest = d.Digest
+				break
+			}
+		}
+
+		m, _, err = fetchManifest(host, repository, platformDigest, cr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	size := m.Config.Size
+	for _, layer := range m.Layers {
+		size += layer.Size
+	}
+
+	return &ImageManifest{
+		Digest:         digest,
+		CompressedSize: size,
+	}, nil
+}
+
+func parseImageName(imageName string) (string, string, string) {
+	name := imageName
+	reference := "latest"
+
+	if index := strings.Index(name, "@"); index != -1 {
+		reference = name[index+1:]
+		name = name[:index]
+	} else if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		reference = name[index+1:]
+		name = name[:index]
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], parts[1], reference
+	}
+
+	if len(parts) == 1 {
+		name = "library/" + name
+	}
+
+	return defaultRegistryHost, name, reference
+}
+
+func fetchManifest(host, repository, reference string, cr *ContainerRegistry) (*manifest, string, error) {
+	res, err := doManifestRequest(http.MethodGet, host, repository, reference, cr)
+	if err != nil {
+		return nil, "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to get image manifest: %s", res.Status)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var m manifest
+	err = json.Unmarshal(body, &m)
+	if err != nil {
+		return nil, "", err
+	}
+
+	digest := res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		digestBytes := sha256.Sum256(body)
+		digest = "sha256:" + hex.EncodeToString(digestBytes[:])
+	}
+
+	return &m, digest, nil
+}
+
+// Deletes the image from the registry. Registries only delete manifests by digest so tags
+// are resolved first which also removes the other tags of the same manifest.
+// Images that do not exist in the registry are treated as deleted.
+func DeleteImage(imageName string, cr *ContainerRegistry) error {
+	host, repository, reference := parseImageName(imageName)
+
+	if !strings.HasPrefix(reference, "sha256:") {
+		res, err := doManifestRequest(http.MethodHead, host, repository, reference, cr)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+
+		if res.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get image manifest: %s", res.Status)
+		}
+
+		reference = res.Header.Get("Docker-Content-Digest")
+		if reference == "" {
+			return errors.New("registry did not return the image digest")
+		}
+	}
+
+	res, err := doManifestRequest(http.MethodDelete, host, repository, reference, cr)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete image: %s", res.Status)
+	}
+
+	return nil
+}
+
+// Sends the manifest request and retries it with the authorization the registry asks for
+func doManifestRequest(method, host, repository, reference string, cr *ContainerRegistry) (*http.Response, error) {
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, reference)
+
+	res, err := sendManifestRequest(method, manifestUrl, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusUnauthorized {
+		return res, nil
+	}
+
+	res.Body.Close()
+
+	authorization, err := getRegistryAuthorization(res.Header.Get("WWW-Authenticate"), cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return sendManifestRequest(method, manifestUrl, authorization)
+}
+
+func sendManifestRequest(method, manifestUrl, authorization string) (*http.Response, error) {
+	req, err := http.NewRequest(method, manifestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	return registryHttpClient.Do(req)
+}
+
+// Resolves the authorization header for the challenge of the registry.
+// Bearer challenges are exchanged for a token at the token endpoint of the registry.
+func getRegistryAuthorization(challenge string, cr *ContainerRegistry) (string, error) {
+	scheme, _, _ := strings.Cut(challenge, " ")
+
+	if strings.EqualFold(scheme, "basic") {
+		if cr == nil {
+			return "", errors.New("registry requires credentials")
+		}
+
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(cr.Username+":"+cr.Password)), nil
+	}
+
+	if !strings.EqualFold(scheme, "bearer") {
+		return "", fmt.Errorf("unsupported registry authentication scheme: %s", scheme)
+	}
+
+	params := map[string]string{}
+	for _, match := range authChallengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+
+	if params["realm"] == "" {
+		return "", errors.New("registry authentication realm not found")
+	}
+
+	query := url.Values{}
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	if params["scope"] != "" {
+		query.Set("scope", params["scope"])
+	}
+
+	req, err := http.NewRequest(http.MethodGet, params["realm"]+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if cr != nil && cr.Username != "" {
+		req.SetBasicAuth(cr.Username, cr.Password)
+	}
+
+	res, err := registryHttpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get registry token: %s", res.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&token)
+	if err != nil {
+		return "", err
+	}
+
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+
+	return "Bearer " + token.Token, nil
+}
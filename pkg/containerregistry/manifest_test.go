@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerregistry
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImageName(t *testing.T) {
+	tests := []struct {
+		imageName  string
+		host       string
+		repository string
+		reference  string
+	}{
+		{"ubuntu", defaultRegistryHost, "library/ubuntu", "latest"},
+		{"daytonaio/workspace-project:latest", defaultRegistryHost, "daytonaio/workspace-project", "latest"},
+		{"registry.example.com/ns/p-1234:abcd", "registry.example.com", "ns/p-1234", "abcd"},
+		{"localhost:5000/p-1234", "localhost:5000", "p-1234", "latest"},
+		{"registry.example.com/p-1234@sha256:abcd", "registry.example.com", "p-1234", "sha256:abcd"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.imageName, func(t *testing.T) {
+			host, repository, reference := parseImageName(test.imageName)
+			require.Equal(t, test.host, host)
+			require.Equal(t, test.repository, repository)
+			require.Equal(t, test.reference, reference)
+		})
+	}
+}
+
+func TestGetImageManifest(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			username, password, _ := r.BasicAuth()
+			require.Equal(t, "user", username)
+			require.Equal(t, "pass", password)
+			require.Equal(t, "repository:p-1234:pull", r.URL.Query().Get("scope"))
+
+			fmt.Fprint(w, `{"token": "token"}`)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:p-1234:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/p-1234/manifests/tag":
+			w.Header().Set("Docker-Content-Digest", "sha256:index")
+			fmt.Fprintf(w, `{"manifests": [
+				{"digest": "sha256:other", "size": 10, "platform": {"os": "linux", "architecture": "other"}},
+				{"digest": "sha256:platform", "size": 10, "platform": {"os": "linux", "architecture": "%s"}}
+			]}`, runtime.GOARCH)
+		case "/v2/p-1234/manifests/sha256:platform":
+			fmt.Fprint(w, `{"config": {"size": 100}, "layers": [{"size": 1000}, {"size": 2000}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	registryHttpClient = server.Client()
+	defer func() {
+		registryHttpClient = http.DefaultClient
+	}()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	manifest, err := GetImageManifest(host+"/p-1234:tag", &ContainerRegistry{
+		Server:   host,
+		Username: "user",
+		Password: "pass",
+	})
+	require.Nil(t, err)
+	require.Equal(t, &ImageManifest{
+		Digest:         "sha256:index",
+		CompressedSize: 3100,
+	}, manifest)
+
+	_, err = GetImageManifest(host+"/p-1234:missing", &ContainerRegistry{
+		Server:   host,
+		Username: "user",
+		Password: "pass",
+	})
+	require.NotNil(t, err)
+}
+
+func TestDeleteImage(t *testing.T) {
+	deleted := []string{}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/v2/p-1234/manifests/tag":
+			w.Header().Set("Docker-Content-Digest", "sha256:digest")
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	registryHttpClient = server.Client()
+	defer func() {
+		registryHttpClient = http.DefaultClient
+	}()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	err := DeleteImage(host+"/p-1234:tag", nil)
+	require.Nil(t, err)
+
+	err = DeleteImage(host+"/p-1234:missing", nil)
+	require.Nil(t, err)
+
+	require.Equal(t, []string{"/v2/p-1234/manifests/sha256:digest"}, deleted)
+}
@@ -14,11 +14,6 @@ type ApiKeyStore struct {
 }
 
 func NewApiKeyStore(db *gorm.DB) (*ApiKeyStore, error) {
-	err := db.AutoMigrate(&ApiKeyDTO{})
-	if err != nil {
-		return nil, err
-	}
-
 	return &ApiKeyStore{db: db}, nil
 }
 
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type AuditStore struct {
+	db *gorm.DB
+}
+
+func NewAuditStore(db *gorm.DB) (*AuditStore, error) {
+	return &AuditStore{db: db}, nil
+}
+
+func (s *AuditStore) List(filter *audit.Filter) ([]*audit.AuditLog, error) {
+	auditLogDTOs := []AuditLogDTO{}
+	tx := processAuditFilters(s.db, filter).Order("created_at desc").Find(&auditLogDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	auditLogs := []*audit.AuditLog{}
+	for _, auditLogDTO := range auditLogDTOs {
+		auditLogs = append(auditLogs, ToAuditLog(auditLogDTO))
+	}
+
+	return auditLogs, nil
+}
+
+func (s *AuditStore) Save(auditLog *audit.AuditLog) error {
+	auditLogDTO := ToAuditLogDTO(auditLog)
+	tx := s.db.Create(&auditLogDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processAuditFilters(tx *gorm.DB, filter *audit.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.ApiKeyName != nil {
+			tx = tx.Where("api_key_name = ?", *filter.ApiKeyName)
+		}
+		if filter.Method != nil {
+			tx = tx.Where("method = ?", *filter.Method)
+		}
+		if filter.ResourceId != nil {
+			tx = tx.Where("resource_id = ?", *filter.ResourceId)
+		}
+		if filter.Outcome != nil {
+			tx = tx.Where("outcome = ?", *filter.Outcome)
+		}
+		if filter.Since != nil {
+			tx = tx.Where("created_at >= ?", *filter.Since)
+		}
+		if filter.Until != nil {
+			tx = tx.Where("created_at <= ?", *filter.Until)
+		}
+		if filter.Limit != nil {
+			tx = tx.Limit(*filter.Limit)
+		}
+	}
+	return tx
+}
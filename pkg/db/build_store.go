@@ -6,33 +6,44 @@ package db
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/daytonaio/daytona/pkg/build"
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 	"gorm.io/gorm"
 )
 
 type BuildStore struct {
-	db   *gorm.DB
-	Lock sync.Mutex
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
+	Lock      sync.Mutex
 }
 
-func NewBuildStore(db *gorm.DB) (*BuildStore, error) {
-	err := db.AutoMigrate(&BuildDTO{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &BuildStore{db: db}, nil
+func NewBuildStore(db *gorm.DB, encrypter *encryption.Encrypter) (*BuildStore, error) {
+	return &BuildStore{db: db, encrypter: encrypter}, nil
 }
 
 func (b *BuildStore) Find(filter *build.Filter) (*build.Build, error) {
 	b.Lock.Lock()
 	defer b.Lock.Unlock()
 
+	if hasEnvVarsFilter(filter) {
+		builds, err := b.list(filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(builds) == 0 {
+			return nil, build.ErrBuildNotFound
+		}
+
+		return builds[0], nil
+	}
+
 	buildDTO := BuildDTO{}
 	tx := processBuildFilters(b.db, filter).First(&buildDTO)
 
@@ -43,15 +54,18 @@ func (b *BuildStore) Find(filter *build.Filter) (*build.Build, error) {
 		return nil, tx.Error
 	}
 
-	build := ToBuild(buildDTO)
-
-	return build, nil
+	return b.toBuild(buildDTO)
 }
 
 func (b *BuildStore) List(filter *build.Filter) ([]*build.Build, error) {
 	b.Lock.Lock()
 	defer b.Lock.Unlock()
 
+	return b.list(filter)
+}
+
+// Env vars are encrypted with a random nonce so builds are filtered by their env vars after decrypting them
+func (b *BuildStore) list(filter *build.Filter) ([]*build.Build, error) {
 	buildDTOs := []BuildDTO{}
 	tx := processBuildFilters(b.db, filter).Find(&buildDTOs)
 
@@ -61,38 +75,124 @@ func (b *BuildStore) List(filter *build.Filter) ([]*build.Build, error) {
 
 	builds := []*build.Build{}
 	for _, buildDTO := range buildDTOs {
-		builds = append(builds, ToBuild(buildDTO))
+		foundBuild, err := b.toBuild(buildDTO)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasEnvVarsFilter(filter) {
+			if !maps.Equal(foundBuild.EnvVars, *filter.EnvVars) {
+				continue
+			}
+
+			// Builds are ordered from newest to oldest when only the newest is requested
+			if filter.GetNewest != nil && *filter.GetNewest {
+				return []*build.Build{foundBuild}, nil
+			}
+		}
+
+		builds = append(builds, foundBuild)
 	}
 
 	return builds, nil
 }
 
+func (b *BuildStore) toBuild(buildDTO BuildDTO) (*build.Build, error) {
+	var err error
+	buildDTO.EnvVars, err = b.encrypter.DecryptMap(buildDTO.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToBuild(buildDTO), nil
+}
+
 func (b *BuildStore) Save(build *build.Build) error {
 	b.Lock.Lock()
 	defer b.Lock.Unlock()
 
-	buildDTO := ToBuildDTO(build)
-	tx := b.db.Save(&buildDTO)
-	if tx.Error != nil {
-		return tx.Error
-	}
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		return b.saveBuild(tx, build)
+	})
+}
 
-	return nil
+func (b *BuildStore) CompareAndSave(newBuild *build.Build, expectedStates ...build.BuildState) error {
+	b.Lock.Lock()
+	defer b.Lock.Unlock()
+
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		currentDTO := BuildDTO{}
+		err := tx.Where("id = ?", newBuild.Id).First(&currentDTO).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return build.ErrBuildNotFound
+			}
+			return err
+		}
+
+		if !slices.Contains(expectedStates, build.BuildState(currentDTO.State)) {
+			return build.ErrBuildStateChanged
+		}
+
+		return b.saveBuild(tx, newBuild)
+	})
 }
 
-func (b *BuildStore) Delete(id string) error {
+func (b *BuildStore) FindSbom(buildId string) (*build.BuildSbom, error) {
 	b.Lock.Lock()
 	defer b.Lock.Unlock()
 
-	tx := b.db.Where("id = ?", id).Delete(&BuildDTO{})
+	sbomDTO := BuildSbomDTO{}
+	tx := b.db.Where("build_id = ?", buildId).First(&sbomDTO)
 	if tx.Error != nil {
-		return tx.Error
+		if IsRecordNotFound(tx.Error) {
+			return nil, build.ErrSbomNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToBuildSbom(sbomDTO), nil
+}
+
+// Builds loaded from the store do not include the SBOM document so
+// the stored document is only replaced when the build has one
+func (b *BuildStore) saveBuild(tx *gorm.DB, newBuild *build.Build) error {
+	buildDTO := ToBuildDTO(newBuild)
+
+	var err error
+	buildDTO.EnvVars, err = b.encrypter.EncryptMap(buildDTO.EnvVars)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Save(&buildDTO).Error
+	if err != nil {
+		return err
 	}
-	if tx.RowsAffected == 0 {
-		return build.ErrBuildNotFound
+
+	if newBuild.Artifact == nil || newBuild.Artifact.Sbom == nil || newBuild.Artifact.Sbom.Document == "" {
+		return nil
 	}
 
-	return nil
+	sbomDTO := ToBuildSbomDTO(newBuild.Id, newBuild.Artifact.Sbom)
+	return tx.Save(&sbomDTO).Error
+}
+
+func (b *BuildStore) Delete(id string) error {
+	b.Lock.Lock()
+	defer b.Lock.Unlock()
+
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", id).Delete(&BuildDTO{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return build.ErrBuildNotFound
+		}
+
+		return tx.Where("build_id = ?", id).Delete(&BuildSbomDTO{}).Error
+	})
 }
 
 func processBuildFilters(tx *gorm.DB, filter *build.Filter) *gorm.DB {
@@ -113,31 +213,32 @@ func processBuildFilters(tx *gorm.DB, filter *build.Filter) *gorm.DB {
 			tx = tx.Where(fmt.Sprintf("prebuild_id IN (%s)", placeholders), stringsToInterface(*filter.PrebuildIds)...)
 		}
 		if filter.GetNewest != nil && *filter.GetNewest {
-			tx = tx.Order("created_at desc").Limit(1)
+			tx = tx.Order("created_at desc")
+			if !hasEnvVarsFilter(filter) {
+				tx = tx.Limit(1)
+			}
 		}
 		// Skip filtering when an automatic build config is provided
 		if filter.BuildConfig != nil && *filter.BuildConfig != (buildconfig.BuildConfig{}) {
-			buildConfigJSON, err := json.Marshal(filter.BuildConfig)
+			buildConfigJSON, err := json.Marshal(ToProjectBuildDTO(filter.BuildConfig))
 			if err == nil {
 				tx = tx.Where("build_config = ?", string(buildConfigJSON))
 			}
 		}
 		if filter.RepositoryUrl != nil {
-			tx = tx.Where("json_extract(repository, '$.url') = ?", *filter.RepositoryUrl)
+			tx = tx.Where(fmt.Sprintf("%s = ?", jsonExtract(tx, "repository", "url")), *filter.RepositoryUrl)
 		}
 		if filter.Branch != nil {
-			tx = tx.Where("json_extract(repository, '$.branch') = ?", *filter.Branch)
-		}
-		if filter.EnvVars != nil && len(*filter.EnvVars) > 0 {
-			envVarsJSON, err := json.Marshal(filter.EnvVars)
-			if err == nil {
-				tx = tx.Where("env_vars = ?", string(envVarsJSON))
-			}
+			tx = tx.Where(fmt.Sprintf("%s = ?", jsonExtract(tx, "repository", "branch")), *filter.Branch)
 		}
 	}
 	return tx
 }
 
+func hasEnvVarsFilter(filter *build.Filter) bool {
+	return filter != nil && filter.EnvVars != nil && len(*filter.EnvVars) > 0
+}
+
 func stringsToInterface(slice []string) []interface{} {
 	args := make([]interface{}, len(slice))
 	for i, v := range slice {
@@ -5,22 +5,77 @@ package db
 
 import (
 	"fmt"
+	"os"
+	"sync"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/db/migrations"
 	"github.com/glebarez/sqlite"
 	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-var _db *gorm.DB
+const (
+	SQLiteDriver   = "sqlite"
+	PostgresDriver = "postgres"
+)
+
+var connections = map[string]*gorm.DB{}
+var connectionsMutex sync.Mutex
+
+// GetConnection opens a connection to the database and applies pending schema migrations.
+// The dsn is the database file path for SQLite or a connection string for PostgreSQL.
+// SQLite databases are backed up before pending migrations are applied.
+// Connections are reused for the same driver and dsn.
+func GetConnection(driver, dsn string) (*gorm.DB, error) {
+	connectionsMutex.Lock()
+	defer connectionsMutex.Unlock()
+
+	key := fmt.Sprintf("%s:%s", driver, dsn)
+	if db, ok := connections[key]; ok {
+		return db, nil
+	}
+
+	db, err := OpenConnection(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := migrations.HasPendingMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending && driver == SQLiteDriver {
+		backupPath, err := BackupSQLite(db, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up database before migrating: %w", err)
+		}
+		if backupPath != "" {
+			log.Infof("Database backed up to %s", backupPath)
+		}
+	}
+
+	err = migrations.Migrate(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	connections[key] = db
+
+	return db, nil
+}
 
-func GetSQLiteConnection(dbPath string) *gorm.DB {
-	if _db != nil {
-		return _db
+// OpenConnection opens a connection to the database without applying migrations
+func OpenConnection(driver, dsn string) (*gorm.DB, error) {
+	dialector, err := getDialector(driver, dsn)
+	if err != nil {
+		return nil, err
 	}
 
-	log.Debug(fmt.Sprintf("Opening db at %s", dbPath))
+	log.Debug(fmt.Sprintf("Opening %s db", driver))
 
 	logLevel := log.ErrorLevel
 	if log.GetLevel() == log.DebugLevel {
@@ -38,14 +93,57 @@ func GetSQLiteConnection(dbPath string) *gorm.DB {
 		},
 	)
 
-	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger: newLogger,
 	})
 	if err != nil {
-		panic("failed to connect database")
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return db, nil
+}
+
+// BackupSQLite writes a consistent copy of the SQLite database next to the database file and returns its path.
+// Nothing is written if the database file is empty or does not exist yet.
+func BackupSQLite(db *gorm.DB, dbPath string) (string, error) {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if info.Size() == 0 {
+		return "", nil
 	}
 
-	_db = db
+	backupPath := fmt.Sprintf("%s.backup-%s", dbPath, time.Now().Format("20060102-150405"))
+
+	tx := db.Exec("VACUUM INTO ?", backupPath)
+	if tx.Error != nil {
+		return "", tx.Error
+	}
+
+	return backupPath, nil
+}
+
+func getDialector(driver, dsn string) (gorm.Dialector, error) {
+	switch driver {
+	case SQLiteDriver:
+		return sqlite.Open(dsn), nil
+	case PostgresDriver:
+		return postgres.Open(dsn), nil
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
+// jsonExtract returns the dialect specific expression for reading a key from a JSON serialized column
+func jsonExtract(db *gorm.DB, column, key string) string {
+	if db.Dialector.Name() == PostgresDriver {
+		return fmt.Sprintf("(%s::json->>'%s')", column, key)
+	}
 
-	return _db
+	return fmt.Sprintf("json_extract(%s, '$.%s')", column, key)
 }
@@ -0,0 +1,115 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/db/migrations"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// Set to a PostgreSQL connection string to run the tests against a local PostgreSQL instance
+const postgresDsnEnv = "DAYTONA_TEST_POSTGRES_DSN"
+
+func TestSQLiteConnection(t *testing.T) {
+	conn, err := db.GetConnection(db.SQLiteDriver, filepath.Join(t.TempDir(), "db"))
+	require.Nil(t, err)
+
+	testConnection(t, conn)
+}
+
+func TestPostgresConnection(t *testing.T) {
+	dsn := os.Getenv(postgresDsnEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDsnEnv)
+	}
+
+	conn, err := db.GetConnection(db.PostgresDriver, dsn)
+	require.Nil(t, err)
+
+	testConnection(t, conn)
+}
+
+func TestBackupSQLite(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "db")
+
+	conn, err := db.OpenConnection(db.SQLiteDriver, dbPath)
+	require.Nil(t, err)
+
+	backupPath, err := db.BackupSQLite(conn, dbPath)
+	require.Nil(t, err)
+	require.Empty(t, backupPath)
+
+	err = migrations.Migrate(conn)
+	require.Nil(t, err)
+
+	backupPath, err = db.BackupSQLite(conn, dbPath)
+	require.Nil(t, err)
+	require.FileExists(t, backupPath)
+
+	backup, err := db.OpenConnection(db.SQLiteDriver, backupPath)
+	require.Nil(t, err)
+
+	pending, err := migrations.HasPendingMigrations(backup)
+	require.Nil(t, err)
+	require.False(t, pending)
+}
+
+func TestUnsupportedDriver(t *testing.T) {
+	_, err := db.GetConnection("mysql", "")
+	require.NotNil(t, err)
+}
+
+func testConnection(t *testing.T, conn *gorm.DB) {
+	t.Helper()
+
+	require.True(t, conn.Migrator().HasTable("schema_migrations"))
+	require.True(t, conn.Migrator().HasTable("workspace_dtos"))
+
+	keyEncrypter, err := encryption.GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "encryption.key"))
+	require.Nil(t, err)
+
+	buildStore, err := db.NewBuildStore(conn, encryption.NewEncrypter(keyEncrypter))
+	require.Nil(t, err)
+
+	b := &build.Build{
+		Id:    "test-build",
+		State: build.BuildStatePendingRun,
+		Repository: &gitprovider.GitRepository{
+			Url:    "https://github.com/daytonaio/daytona",
+			Branch: "main",
+		},
+		EnvVars:   map[string]string{},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	err = buildStore.Save(b)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = buildStore.Delete(b.Id)
+	})
+
+	found, err := buildStore.Find(&build.Filter{
+		RepositoryUrl: &b.Repository.Url,
+		Branch:        &b.Repository.Branch,
+	})
+	require.Nil(t, err)
+	require.Equal(t, b.Id, found.Id)
+
+	otherBranch := "other"
+	_, err = buildStore.Find(&build.Filter{
+		RepositoryUrl: &b.Repository.Url,
+		Branch:        &otherBranch,
+	})
+	require.Equal(t, build.ErrBuildNotFound, err)
+}
@@ -8,19 +8,16 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 )
 
 type ContainerRegistryStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
 }
 
-func NewContainerRegistryStore(db *gorm.DB) (*ContainerRegistryStore, error) {
-	err := db.AutoMigrate(&ContainerRegistryDTO{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &ContainerRegistryStore{db: db}, nil
+func NewContainerRegistryStore(db *gorm.DB, encrypter *encryption.Encrypter) (*ContainerRegistryStore, error) {
+	return &ContainerRegistryStore{db: db, encrypter: encrypter}, nil
 }
 
 func (s *ContainerRegistryStore) List() ([]*containerregistry.ContainerRegistry, error) {
@@ -32,7 +29,11 @@ func (s *ContainerRegistryStore) List() ([]*containerregistry.ContainerRegistry,
 
 	containerregistryTargets := []*containerregistry.ContainerRegistry{}
 	for _, containerRegistryDTO := range containerRegistryDTOs {
-		containerregistryTargets = append(containerregistryTargets, ToContainerRegistry(containerRegistryDTO))
+		cr, err := s.toContainerRegistry(containerRegistryDTO)
+		if err != nil {
+			return nil, err
+		}
+		containerregistryTargets = append(containerregistryTargets, cr)
 	}
 
 	return containerregistryTargets, nil
@@ -48,11 +49,19 @@ func (s *ContainerRegistryStore) Find(server string) (*containerregistry.Contain
 		return nil, tx.Error
 	}
 
-	return ToContainerRegistry(containerRegistryDTO), nil
+	return s.toContainerRegistry(containerRegistryDTO)
 }
 
 func (s *ContainerRegistryStore) Save(cr *containerregistry.ContainerRegistry) error {
-	tx := s.db.Save(ToContainerRegistryDTO(cr))
+	containerRegistryDTO := ToContainerRegistryDTO(cr)
+
+	var err error
+	containerRegistryDTO.Password, err = s.encrypter.Encrypt(containerRegistryDTO.Password)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(&containerRegistryDTO)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -71,3 +80,13 @@ func (s *ContainerRegistryStore) Delete(cr *containerregistry.ContainerRegistry)
 
 	return nil
 }
+
+func (s *ContainerRegistryStore) toContainerRegistry(containerRegistryDTO ContainerRegistryDTO) (*containerregistry.ContainerRegistry, error) {
+	var err error
+	containerRegistryDTO.Password, err = s.encrypter.Decrypt(containerRegistryDTO.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToContainerRegistry(containerRegistryDTO), nil
+}
@@ -4,27 +4,44 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 )
 
 type ApiKeyDTO struct {
-	KeyHash string `gorm:"primaryKey"`
-	Type    apikey.ApiKeyType
-	Name    string `gorm:"uniqueIndex"`
+	KeyHash    string `gorm:"primaryKey"`
+	Type       apikey.ApiKeyType
+	Name       string `gorm:"uniqueIndex"`
+	Role       apikey.ApiKeyRole
+	Scope      *apikey.ApiKeyScope `gorm:"serializer:json"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	UserId     string
 }
 
 func ToApiKeyDTO(apiKey apikey.ApiKey) ApiKeyDTO {
 	return ApiKeyDTO{
-		KeyHash: apiKey.KeyHash,
-		Type:    apiKey.Type,
-		Name:    apiKey.Name,
+		KeyHash:    apiKey.KeyHash,
+		Type:       apiKey.Type,
+		Name:       apiKey.Name,
+		Role:       apiKey.Role,
+		Scope:      apiKey.Scope,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		UserId:     apiKey.UserId,
 	}
 }
 
 func ToApiKey(apiKeyDTO ApiKeyDTO) apikey.ApiKey {
 	return apikey.ApiKey{
-		KeyHash: apiKeyDTO.KeyHash,
-		Type:    apiKeyDTO.Type,
-		Name:    apiKeyDTO.Name,
+		KeyHash:    apiKeyDTO.KeyHash,
+		Type:       apiKeyDTO.Type,
+		Name:       apiKeyDTO.Name,
+		Role:       apiKeyDTO.Role,
+		Scope:      apiKeyDTO.Scope,
+		ExpiresAt:  apiKeyDTO.ExpiresAt,
+		LastUsedAt: apiKeyDTO.LastUsedAt,
+		UserId:     apiKeyDTO.UserId,
 	}
 }
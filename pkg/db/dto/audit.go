@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type AuditLogDTO struct {
+	Id         string `gorm:"primaryKey"`
+	ApiKeyName string `gorm:"index"`
+	ApiKeyType apikey.ApiKeyType
+	Method     string
+	Route      string
+	Path       string
+	ResourceId string `gorm:"index"`
+	StatusCode int
+	Outcome    audit.Outcome
+	Error      string
+	Duration   int64
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func ToAuditLogDTO(auditLog *audit.AuditLog) AuditLogDTO {
+	return AuditLogDTO{
+		Id:         auditLog.Id,
+		ApiKeyName: auditLog.ApiKeyName,
+		ApiKeyType: auditLog.ApiKeyType,
+		Method:     auditLog.Method,
+		Route:      auditLog.Route,
+		Path:       auditLog.Path,
+		ResourceId: auditLog.ResourceId,
+		StatusCode: auditLog.StatusCode,
+		Outcome:    auditLog.Outcome,
+		Error:      auditLog.Error,
+		Duration:   auditLog.Duration,
+		CreatedAt:  auditLog.CreatedAt,
+	}
+}
+
+func ToAuditLog(auditLogDTO AuditLogDTO) *audit.AuditLog {
+	return &audit.AuditLog{
+		Id:         auditLogDTO.Id,
+		ApiKeyName: auditLogDTO.ApiKeyName,
+		ApiKeyType: auditLogDTO.ApiKeyType,
+		Method:     auditLogDTO.Method,
+		Route:      auditLogDTO.Route,
+		Path:       auditLogDTO.Path,
+		ResourceId: auditLogDTO.ResourceId,
+		StatusCode: auditLogDTO.StatusCode,
+		Outcome:    auditLogDTO.Outcome,
+		Error:      auditLogDTO.Error,
+		Duration:   auditLogDTO.Duration,
+		CreatedAt:  auditLogDTO.CreatedAt,
+	}
+}
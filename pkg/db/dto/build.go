@@ -11,47 +11,76 @@ import (
 )
 
 type BuildDTO struct {
-	Id              string                          `json:"id" gorm:"primaryKey"`
-	State           string                          `json:"state"`
-	Image           *string                         `json:"image,omitempty"`
-	User            *string                         `json:"user,omitempty"`
-	ContainerConfig containerconfig.ContainerConfig `gorm:"serializer:json"`
-	BuildConfig     *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
-	Repository      RepositoryDTO                   `gorm:"serializer:json"`
-	EnvVars         map[string]string               `json:"envVars" gorm:"serializer:json"`
-	PrebuildId      string                          `json:"prebuildId"`
-	CreatedAt       time.Time                       `json:"createdAt"`
-	UpdatedAt       time.Time                       `json:"updatedAt"`
+	Id                string                          `json:"id" gorm:"primaryKey"`
+	State             string                          `json:"state"`
+	Image             *string                         `json:"image,omitempty"`
+	User              *string                         `json:"user,omitempty"`
+	ContainerConfig   containerconfig.ContainerConfig `gorm:"serializer:json"`
+	BuildConfig       *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
+	Repository        RepositoryDTO                   `gorm:"serializer:json"`
+	EnvVars           map[string]string               `json:"envVars" gorm:"serializer:json"`
+	PrebuildId        string                          `json:"prebuildId"`
+	ProjectConfigName string                          `json:"projectConfigName"`
+	Priority          string                          `json:"priority"`
+	Timeout           *int                            `json:"timeout,omitempty"`
+	LayerCache        *build.LayerCacheStats          `json:"layerCache,omitempty" gorm:"serializer:json"`
+	Artifact          *build.BuildArtifact            `json:"artifact,omitempty" gorm:"serializer:json"`
+	CreatedAt         time.Time                       `json:"createdAt"`
+	UpdatedAt         time.Time                       `json:"updatedAt"`
 }
 
 func ToBuildDTO(build *build.Build) BuildDTO {
 	return BuildDTO{
-		Id:              build.Id,
-		State:           string(build.State),
-		Image:           build.Image,
-		User:            build.User,
-		ContainerConfig: build.ContainerConfig,
-		BuildConfig:     ToProjectBuildDTO(build.BuildConfig),
-		Repository:      ToRepositoryDTO(build.Repository),
-		EnvVars:         build.EnvVars,
-		PrebuildId:      build.PrebuildId,
-		CreatedAt:       build.CreatedAt,
-		UpdatedAt:       build.UpdatedAt,
+		Id:                build.Id,
+		State:             string(build.State),
+		Image:             build.Image,
+		User:              build.User,
+		ContainerConfig:   build.ContainerConfig,
+		BuildConfig:       ToProjectBuildDTO(build.BuildConfig),
+		Repository:        ToRepositoryDTO(build.Repository),
+		EnvVars:           build.EnvVars,
+		PrebuildId:        build.PrebuildId,
+		ProjectConfigName: build.ProjectConfigName,
+		Priority:          string(build.Priority),
+		Timeout:           build.Timeout,
+		LayerCache:        build.LayerCache,
+		Artifact:          toBuildArtifactDTO(build.Artifact),
+		CreatedAt:         build.CreatedAt,
+		UpdatedAt:         build.UpdatedAt,
 	}
 }
 
 func ToBuild(buildDTO BuildDTO) *build.Build {
 	return &build.Build{
-		Id:              buildDTO.Id,
-		State:           build.BuildState(buildDTO.State),
-		Image:           buildDTO.Image,
-		User:            buildDTO.User,
-		ContainerConfig: buildDTO.ContainerConfig,
-		BuildConfig:     ToProjectBuild(buildDTO.BuildConfig),
-		Repository:      ToRepository(buildDTO.Repository),
-		EnvVars:         buildDTO.EnvVars,
-		PrebuildId:      buildDTO.PrebuildId,
-		CreatedAt:       buildDTO.CreatedAt,
-		UpdatedAt:       buildDTO.UpdatedAt,
+		Id:                buildDTO.Id,
+		State:             build.BuildState(buildDTO.State),
+		Image:             buildDTO.Image,
+		User:              buildDTO.User,
+		ContainerConfig:   buildDTO.ContainerConfig,
+		BuildConfig:       ToProjectBuild(buildDTO.BuildConfig),
+		Repository:        ToRepository(buildDTO.Repository),
+		EnvVars:           buildDTO.EnvVars,
+		PrebuildId:        buildDTO.PrebuildId,
+		ProjectConfigName: buildDTO.ProjectConfigName,
+		Priority:          build.BuildPriority(buildDTO.Priority),
+		Timeout:           buildDTO.Timeout,
+		LayerCache:        buildDTO.LayerCache,
+		Artifact:          buildDTO.Artifact,
+		CreatedAt:         buildDTO.CreatedAt,
+		UpdatedAt:         buildDTO.UpdatedAt,
 	}
 }
+
+// The SBOM document is stored in the build SBOM table
+func toBuildArtifactDTO(artifact *build.BuildArtifact) *build.BuildArtifact {
+	if artifact == nil || artifact.Sbom == nil {
+		return artifact
+	}
+
+	artifactDTO := *artifact
+	sbom := *artifact.Sbom
+	sbom.Document = ""
+	artifactDTO.Sbom = &sbom
+
+	return &artifactDTO
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/build"
+
+// SBOM documents are stored apart from the builds so that they are not loaded with every build
+type BuildSbomDTO struct {
+	BuildId  string `gorm:"primaryKey"`
+	Format   string
+	Packages int
+	Document string
+}
+
+func ToBuildSbomDTO(buildId string, sbom *build.BuildSbom) BuildSbomDTO {
+	return BuildSbomDTO{
+		BuildId:  buildId,
+		Format:   sbom.Format,
+		Packages: sbom.Packages,
+		Document: sbom.Document,
+	}
+}
+
+func ToBuildSbom(sbomDTO BuildSbomDTO) *build.BuildSbom {
+	return &build.BuildSbom{
+		Format:   sbomDTO.Format,
+		Packages: sbomDTO.Packages,
+		Document: sbomDTO.Document,
+	}
+}
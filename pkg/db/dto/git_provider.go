@@ -16,6 +16,7 @@ type GitProviderConfigDTO struct {
 	Alias         string                     `gorm:"uniqueIndex" json:"alias"`
 	SigningKey    *string                    `json:"siginingKey,omitempty"`
 	SigningMethod *gitprovider.SigningMethod `json:"siginingMethod,omitempty"`
+	OwnerId       string                     `json:"ownerId,omitempty"`
 }
 
 func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProviderConfigDTO {
@@ -28,6 +29,7 @@ func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProvid
 		Alias:         gitProvider.Alias,
 		SigningKey:    gitProvider.SigningKey,
 		SigningMethod: gitProvider.SigningMethod,
+		OwnerId:       gitProvider.OwnerId,
 	}
 
 	return gitProviderDTO
@@ -43,5 +45,6 @@ func ToGitProviderConfig(gitProviderDTO GitProviderConfigDTO) gitprovider.GitPro
 		Alias:         gitProviderDTO.Alias,
 		SigningKey:    gitProviderDTO.SigningKey,
 		SigningMethod: gitProviderDTO.SigningMethod,
+		OwnerId:       gitProviderDTO.OwnerId,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 )
 
 type RepositoryDTO struct {
@@ -38,17 +39,45 @@ type GitStatusDTO struct {
 }
 
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt      string        `json:"updatedAt"`
+	Uptime         uint64        `json:"uptime"`
+	GitStatus      *GitStatusDTO `json:"gitStatus"`
+	ActiveSessions uint32        `json:"activeSessions,omitempty"`
+	LastActivityAt string        `json:"lastActivityAt,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
 	FilePath string `json:"filePath"`
 }
 
+type ProjectBuildDockerfileDTO struct {
+	Path      string            `json:"path"`
+	Context   string            `json:"context,omitempty"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty"`
+	Target    string            `json:"target,omitempty"`
+}
+
+type ProjectBuildNixDTO struct {
+	FilePath string `json:"filePath"`
+	DevShell string `json:"devShell,omitempty"`
+}
+
 type ProjectBuildDTO struct {
 	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
+	Dockerfile   *ProjectBuildDockerfileDTO   `json:"dockerfile,omitempty"`
+	Nix          *ProjectBuildNixDTO          `json:"nix,omitempty"`
+}
+
+type ProjectCachedBuildDTO struct {
+	User  string `json:"user"`
+	Image string `json:"image"`
+}
+
+type ProjectResourcesDTO struct {
+	Cpus      float64 `json:"cpus,omitempty"`
+	Memory    int     `json:"memory,omitempty"`
+	Disk      int     `json:"disk,omitempty"`
+	PidsLimit int     `json:"pidsLimit,omitempty"`
 }
 
 type ProjectDTO struct {
@@ -62,9 +91,20 @@ type ProjectDTO struct {
 	ApiKey              string           `json:"apiKey"`
 	State               *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
 	GitProviderConfigId *string          `json:"gitProviderConfigId,omitempty"`
+	// Kept outside of the build config so that build config filters do not depend on it
+	CachedBuild *ProjectCachedBuildDTO `json:"cachedBuild,omitempty"`
+	Resources   *ProjectResourcesDTO   `json:"resources,omitempty"`
 }
 
 func ToProjectDTO(project *project.Project) ProjectDTO {
+	var cachedBuild *ProjectCachedBuildDTO
+	if project.BuildConfig != nil && project.BuildConfig.CachedBuild != nil {
+		cachedBuild = &ProjectCachedBuildDTO{
+			User:  project.BuildConfig.CachedBuild.User,
+			Image: project.BuildConfig.CachedBuild.Image,
+		}
+	}
+
 	return ProjectDTO{
 		Name:                project.Name,
 		Image:               project.Image,
@@ -76,6 +116,8 @@ func ToProjectDTO(project *project.Project) ProjectDTO {
 		State:               ToProjectStateDTO(project.State),
 		ApiKey:              project.ApiKey,
 		GitProviderConfigId: project.GitProviderConfigId,
+		CachedBuild:         cachedBuild,
+		Resources:           ToProjectResourcesDTO(project.Resources),
 	}
 }
 
@@ -134,9 +176,11 @@ func ToProjectStateDTO(state *project.ProjectState) *ProjectStateDTO {
 	}
 
 	return &ProjectStateDTO{
-		UpdatedAt: state.UpdatedAt,
-		Uptime:    state.Uptime,
-		GitStatus: ToGitStatusDTO(state.GitStatus),
+		UpdatedAt:      state.UpdatedAt,
+		Uptime:         state.Uptime,
+		GitStatus:      ToGitStatusDTO(state.GitStatus),
+		ActiveSessions: state.ActiveSessions,
+		LastActivityAt: state.LastActivityAt,
 	}
 }
 
@@ -145,29 +189,54 @@ func ToProjectBuildDTO(build *buildconfig.BuildConfig) *ProjectBuildDTO {
 		return nil
 	}
 
-	if build.Devcontainer == nil {
-		return &ProjectBuildDTO{}
-	}
+	buildDTO := &ProjectBuildDTO{}
 
-	return &ProjectBuildDTO{
-		Devcontainer: &ProjectBuildDevcontainerDTO{
+	if build.Devcontainer != nil {
+		buildDTO.Devcontainer = &ProjectBuildDevcontainerDTO{
 			FilePath: build.Devcontainer.FilePath,
-		},
+		}
 	}
+
+	if build.Dockerfile != nil {
+		buildDTO.Dockerfile = &ProjectBuildDockerfileDTO{
+			Path:      build.Dockerfile.Path,
+			Context:   build.Dockerfile.Context,
+			BuildArgs: build.Dockerfile.BuildArgs,
+			Target:    build.Dockerfile.Target,
+		}
+	}
+
+	if build.Nix != nil {
+		buildDTO.Nix = &ProjectBuildNixDTO{
+			FilePath: build.Nix.FilePath,
+			DevShell: build.Nix.DevShell,
+		}
+	}
+
+	return buildDTO
 }
 
 func ToProject(projectDTO ProjectDTO) *project.Project {
+	buildConfig := ToProjectBuild(projectDTO.Build)
+	if buildConfig != nil && projectDTO.CachedBuild != nil {
+		buildConfig.CachedBuild = &buildconfig.CachedBuild{
+			User:  projectDTO.CachedBuild.User,
+			Image: projectDTO.CachedBuild.Image,
+		}
+	}
+
 	return &project.Project{
 		Name:                projectDTO.Name,
 		Image:               projectDTO.Image,
 		User:                projectDTO.User,
-		BuildConfig:         ToProjectBuild(projectDTO.Build),
+		BuildConfig:         buildConfig,
 		Repository:          ToRepository(projectDTO.Repository),
 		WorkspaceId:         projectDTO.WorkspaceId,
 		Target:              projectDTO.Target,
 		State:               ToProjectState(projectDTO.State),
 		ApiKey:              projectDTO.ApiKey,
 		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Resources:           ToProjectResources(projectDTO.Resources),
 	}
 }
 
@@ -209,9 +278,11 @@ func ToProjectState(stateDTO *ProjectStateDTO) *project.ProjectState {
 	}
 
 	return &project.ProjectState{
-		UpdatedAt: stateDTO.UpdatedAt,
-		Uptime:    stateDTO.Uptime,
-		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		UpdatedAt:      stateDTO.UpdatedAt,
+		Uptime:         stateDTO.Uptime,
+		GitStatus:      ToGitStatus(stateDTO.GitStatus),
+		ActiveSessions: stateDTO.ActiveSessions,
+		LastActivityAt: stateDTO.LastActivityAt,
 	}
 }
 
@@ -237,13 +308,55 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *buildconfig.BuildConfig {
 		return nil
 	}
 
-	if buildDTO.Devcontainer == nil {
-		return &buildconfig.BuildConfig{}
-	}
+	build := &buildconfig.BuildConfig{}
 
-	return &buildconfig.BuildConfig{
-		Devcontainer: &buildconfig.DevcontainerConfig{
+	if buildDTO.Devcontainer != nil {
+		build.Devcontainer = &buildconfig.DevcontainerConfig{
 			FilePath: buildDTO.Devcontainer.FilePath,
-		},
+		}
+	}
+
+	if buildDTO.Dockerfile != nil {
+		build.Dockerfile = &buildconfig.DockerfileConfig{
+			Path:      buildDTO.Dockerfile.Path,
+			Context:   buildDTO.Dockerfile.Context,
+			BuildArgs: buildDTO.Dockerfile.BuildArgs,
+			Target:    buildDTO.Dockerfile.Target,
+		}
+	}
+
+	if buildDTO.Nix != nil {
+		build.Nix = &buildconfig.NixConfig{
+			FilePath: buildDTO.Nix.FilePath,
+			DevShell: buildDTO.Nix.DevShell,
+		}
+	}
+
+	return build
+}
+
+func ToProjectResourcesDTO(r *resources.Resources) *ProjectResourcesDTO {
+	if r == nil {
+		return nil
+	}
+
+	return &ProjectResourcesDTO{
+		Cpus:      r.Cpus,
+		Memory:    r.Memory,
+		Disk:      r.Disk,
+		PidsLimit: r.PidsLimit,
+	}
+}
+
+func ToProjectResources(resourcesDTO *ProjectResourcesDTO) *resources.Resources {
+	if resourcesDTO == nil {
+		return nil
+	}
+
+	return &resources.Resources{
+		Cpus:      resourcesDTO.Cpus,
+		Memory:    resourcesDTO.Memory,
+		Disk:      resourcesDTO.Disk,
+		PidsLimit: resourcesDTO.PidsLimit,
 	}
 }
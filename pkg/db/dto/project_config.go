@@ -8,23 +8,29 @@ import (
 )
 
 type ProjectConfigDTO struct {
-	Name                string            `gorm:"primaryKey"`
-	Image               string            `json:"image"`
-	User                string            `json:"user"`
-	Build               *ProjectBuildDTO  `json:"build,omitempty" gorm:"serializer:json"`
-	RepositoryUrl       string            `json:"repositoryUrl"`
-	EnvVars             map[string]string `json:"envVars" gorm:"serializer:json"`
-	Prebuilds           []PrebuildDTO     `gorm:"serializer:json"`
-	IsDefault           bool              `json:"isDefault"`
-	GitProviderConfigId *string           `json:"gitProviderConfigId" validate:"optional"`
+	Name                string               `gorm:"primaryKey"`
+	Image               string               `json:"image"`
+	User                string               `json:"user"`
+	Build               *ProjectBuildDTO     `json:"build,omitempty" gorm:"serializer:json"`
+	RepositoryUrl       string               `json:"repositoryUrl"`
+	EnvVars             map[string]string    `json:"envVars" gorm:"serializer:json"`
+	Prebuilds           []PrebuildDTO        `gorm:"serializer:json"`
+	IsDefault           bool                 `json:"isDefault"`
+	GitProviderConfigId *string              `json:"gitProviderConfigId" validate:"optional"`
+	Resources           *ProjectResourcesDTO `json:"resources,omitempty" gorm:"serializer:json"`
+	OwnerId             string               `json:"ownerId,omitempty"`
 }
 
 type PrebuildDTO struct {
-	Id             string   `json:"id"`
-	Branch         string   `json:"branch"`
-	CommitInterval *int     `json:"commitInterval,omitempty"`
-	TriggerFiles   []string `json:"triggerFiles,omitempty"`
-	Retention      int      `json:"retention"`
+	Id               string   `json:"id"`
+	Branch           string   `json:"branch"`
+	CommitInterval   *int     `json:"commitInterval,omitempty"`
+	TriggerFiles     []string `json:"triggerFiles,omitempty"`
+	Retention        int      `json:"retention"`
+	PullRequests     bool     `json:"pullRequests,omitempty"`
+	ReviewWorkspace  bool     `json:"reviewWorkspace,omitempty"`
+	ForkPullRequests bool     `json:"forkPullRequests,omitempty"`
+	BuildTimeout     *int     `json:"buildTimeout,omitempty"`
 }
 
 func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
@@ -43,6 +49,8 @@ func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfig.IsDefault,
 		GitProviderConfigId: projectConfig.GitProviderConfigId,
+		Resources:           ToProjectResourcesDTO(projectConfig.Resources),
+		OwnerId:             projectConfig.OwnerId,
 	}
 }
 
@@ -62,25 +70,35 @@ func ToProjectConfig(projectConfigDTO ProjectConfigDTO) *config.ProjectConfig {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfigDTO.IsDefault,
 		GitProviderConfigId: projectConfigDTO.GitProviderConfigId,
+		Resources:           ToProjectResources(projectConfigDTO.Resources),
+		OwnerId:             projectConfigDTO.OwnerId,
 	}
 }
 
 func ToPrebuildDTO(prebuild *config.PrebuildConfig) PrebuildDTO {
 	return PrebuildDTO{
-		Id:             prebuild.Id,
-		Branch:         prebuild.Branch,
-		CommitInterval: prebuild.CommitInterval,
-		TriggerFiles:   prebuild.TriggerFiles,
-		Retention:      prebuild.Retention,
+		Id:               prebuild.Id,
+		Branch:           prebuild.Branch,
+		CommitInterval:   prebuild.CommitInterval,
+		TriggerFiles:     prebuild.TriggerFiles,
+		Retention:        prebuild.Retention,
+		PullRequests:     prebuild.PullRequests,
+		ReviewWorkspace:  prebuild.ReviewWorkspace,
+		ForkPullRequests: prebuild.ForkPullRequests,
+		BuildTimeout:     prebuild.BuildTimeout,
 	}
 }
 
 func ToPrebuild(prebuildDTO PrebuildDTO) *config.PrebuildConfig {
 	return &config.PrebuildConfig{
-		Id:             prebuildDTO.Id,
-		Branch:         prebuildDTO.Branch,
-		CommitInterval: prebuildDTO.CommitInterval,
-		TriggerFiles:   prebuildDTO.TriggerFiles,
-		Retention:      prebuildDTO.Retention,
+		Id:               prebuildDTO.Id,
+		Branch:           prebuildDTO.Branch,
+		CommitInterval:   prebuildDTO.CommitInterval,
+		TriggerFiles:     prebuildDTO.TriggerFiles,
+		Retention:        prebuildDTO.Retention,
+		PullRequests:     prebuildDTO.PullRequests,
+		ReviewWorkspace:  prebuildDTO.ReviewWorkspace,
+		ForkPullRequests: prebuildDTO.ForkPullRequests,
+		BuildTimeout:     prebuildDTO.BuildTimeout,
 	}
 }
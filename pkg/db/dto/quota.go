@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/quota"
+
+// Quotas of users have an empty API key name and quotas of API keys an empty user ID
+type QuotaDTO struct {
+	ApiKeyName         string `gorm:"primaryKey"`
+	UserId             string `gorm:"primaryKey"`
+	MaxWorkspaces      int
+	MaxRunningProjects int
+	MaxCpus            float64
+	MaxMemory          int
+	MaxDisk            int
+}
+
+func ToQuotaDTO(q *quota.Quota) QuotaDTO {
+	return QuotaDTO{
+		ApiKeyName:         q.ApiKeyName,
+		UserId:             q.UserId,
+		MaxWorkspaces:      q.MaxWorkspaces,
+		MaxRunningProjects: q.MaxRunningProjects,
+		MaxCpus:            q.MaxCpus,
+		MaxMemory:          q.MaxMemory,
+		MaxDisk:            q.MaxDisk,
+	}
+}
+
+func ToQuota(quotaDTO QuotaDTO) *quota.Quota {
+	return &quota.Quota{
+		ApiKeyName:         quotaDTO.ApiKeyName,
+		UserId:             quotaDTO.UserId,
+		MaxWorkspaces:      quotaDTO.MaxWorkspaces,
+		MaxRunningProjects: quotaDTO.MaxRunningProjects,
+		MaxCpus:            quotaDTO.MaxCpus,
+		MaxMemory:          quotaDTO.MaxMemory,
+		MaxDisk:            quotaDTO.MaxDisk,
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretDTO struct {
+	Name      string `gorm:"primaryKey"`
+	Value     string
+	OwnerId   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func ToSecretDTO(s *secret.Secret) SecretDTO {
+	return SecretDTO{
+		Name:      s.Name,
+		Value:     s.Value,
+		OwnerId:   s.OwnerId,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func ToSecret(secretDTO SecretDTO) *secret.Secret {
+	return &secret.Secret{
+		Name:      secretDTO.Name,
+		Value:     secretDTO.Value,
+		OwnerId:   secretDTO.OwnerId,
+		CreatedAt: secretDTO.CreatedAt,
+		UpdatedAt: secretDTO.UpdatedAt,
+	}
+}
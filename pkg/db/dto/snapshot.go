@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/snapshot"
+)
+
+type SnapshotDTO struct {
+	WorkspaceId string    `json:"workspaceId" gorm:"primaryKey"`
+	ProjectName string    `json:"projectName" gorm:"primaryKey"`
+	Name        string    `json:"name" gorm:"primaryKey"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func ToSnapshotDTO(snapshot *snapshot.Snapshot) SnapshotDTO {
+	return SnapshotDTO{
+		WorkspaceId: snapshot.WorkspaceId,
+		ProjectName: snapshot.ProjectName,
+		Name:        snapshot.Name,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
+
+func ToSnapshot(snapshotDTO SnapshotDTO) *snapshot.Snapshot {
+	return &snapshot.Snapshot{
+		WorkspaceId: snapshotDTO.WorkspaceId,
+		ProjectName: snapshotDTO.ProjectName,
+		Name:        snapshotDTO.Name,
+		CreatedAt:   snapshotDTO.CreatedAt,
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type UserDTO struct {
+	Id   string `gorm:"primaryKey"`
+	Name string `gorm:"uniqueIndex"`
+	// Null for users without an OIDC identity so that they do not conflict in the unique index
+	Issuer    *string `gorm:"uniqueIndex:idx_user_dtos_identity"`
+	Subject   *string `gorm:"uniqueIndex:idx_user_dtos_identity"`
+	CreatedAt time.Time
+}
+
+func ToUserDTO(u *user.User) UserDTO {
+	userDTO := UserDTO{
+		Id:        u.Id,
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
+	}
+
+	if u.Issuer != "" || u.Subject != "" {
+		userDTO.Issuer = &u.Issuer
+		userDTO.Subject = &u.Subject
+	}
+
+	return userDTO
+}
+
+func ToUser(userDTO UserDTO) *user.User {
+	u := &user.User{
+		Id:        userDTO.Id,
+		Name:      userDTO.Name,
+		CreatedAt: userDTO.CreatedAt,
+	}
+
+	if userDTO.Issuer != nil {
+		u.Issuer = *userDTO.Issuer
+	}
+	if userDTO.Subject != nil {
+		u.Subject = *userDTO.Subject
+	}
+
+	return u
+}
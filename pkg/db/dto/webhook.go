@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookDTO struct {
+	Id         string `gorm:"primaryKey"`
+	Url        string
+	Secret     string
+	EventTypes []events.EventType `gorm:"serializer:json"`
+	CreatedAt  time.Time
+}
+
+type WebhookDeliveryDTO struct {
+	Id         string `gorm:"primaryKey"`
+	WebhookId  string `gorm:"index"`
+	EventId    string
+	EventType  events.EventType
+	Attempt    int
+	StatusCode int
+	Success    bool
+	Error      string
+	Duration   int64
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func ToWebhookDTO(w *webhook.Webhook) WebhookDTO {
+	return WebhookDTO{
+		Id:         w.Id,
+		Url:        w.Url,
+		Secret:     w.Secret,
+		EventTypes: w.EventTypes,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+func ToWebhook(webhookDTO WebhookDTO) *webhook.Webhook {
+	return &webhook.Webhook{
+		Id:         webhookDTO.Id,
+		Url:        webhookDTO.Url,
+		Secret:     webhookDTO.Secret,
+		EventTypes: webhookDTO.EventTypes,
+		CreatedAt:  webhookDTO.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveryDTO(d *webhook.Delivery) WebhookDeliveryDTO {
+	return WebhookDeliveryDTO{
+		Id:         d.Id,
+		WebhookId:  d.WebhookId,
+		EventId:    d.EventId,
+		EventType:  d.EventType,
+		Attempt:    d.Attempt,
+		StatusCode: d.StatusCode,
+		Success:    d.Success,
+		Error:      d.Error,
+		Duration:   d.Duration,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func ToWebhookDelivery(deliveryDTO WebhookDeliveryDTO) *webhook.Delivery {
+	return &webhook.Delivery{
+		Id:         deliveryDTO.Id,
+		WebhookId:  deliveryDTO.WebhookId,
+		EventId:    deliveryDTO.EventId,
+		EventType:  deliveryDTO.EventType,
+		Attempt:    deliveryDTO.Attempt,
+		StatusCode: deliveryDTO.StatusCode,
+		Success:    deliveryDTO.Success,
+		Error:      deliveryDTO.Error,
+		Duration:   deliveryDTO.Duration,
+		CreatedAt:  deliveryDTO.CreatedAt,
+	}
+}
@@ -10,11 +10,14 @@ import (
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id          string       `gorm:"primaryKey"`
+	Name        string       `json:"name" gorm:"unique"`
+	Target      string       `json:"target"`
+	ApiKey      string       `json:"apiKey"`
+	IdleTimeout *int         `json:"idleTimeout,omitempty"`
+	OwnerId     string       `json:"ownerId,omitempty"`
+	CreatedBy   string       `json:"createdBy,omitempty"`
+	Projects    []ProjectDTO `gorm:"serializer:json"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +32,13 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:          workspace.Id,
+		Name:        workspace.Name,
+		Target:      workspace.Target,
+		ApiKey:      workspace.ApiKey,
+		IdleTimeout: workspace.IdleTimeout,
+		OwnerId:     workspace.OwnerId,
+		CreatedBy:   workspace.CreatedBy,
 	}
 
 	for _, project := range workspace.Projects {
@@ -44,10 +50,13 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:          workspaceDTO.Id,
+		Name:        workspaceDTO.Name,
+		Target:      workspaceDTO.Target,
+		ApiKey:      workspaceDTO.ApiKey,
+		IdleTimeout: workspaceDTO.IdleTimeout,
+		OwnerId:     workspaceDTO.OwnerId,
+		CreatedBy:   workspaceDTO.CreatedBy,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
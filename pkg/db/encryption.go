@@ -0,0 +1,262 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+)
+
+// EncryptStoredCredentials encrypts the Git provider tokens, container registry passwords, webhook secrets and
+// project, profile and build env vars that were stored in plaintext before encryption at rest was introduced. Encrypted values are left as is.
+func EncryptStoredCredentials(db *gorm.DB, encrypter *encryption.Encrypter) error {
+	gitProviderDTOs := []GitProviderConfigDTO{}
+	tx := db.Find(&gitProviderDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, gitProviderDTO := range gitProviderDTOs {
+		if encryption.IsEncrypted(gitProviderDTO.Token) || gitProviderDTO.Token == "" {
+			continue
+		}
+
+		token, err := encrypter.Encrypt(gitProviderDTO.Token)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Model(&gitProviderDTO).Update("token", token)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	containerRegistryDTOs := []ContainerRegistryDTO{}
+	tx = db.Find(&containerRegistryDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, containerRegistryDTO := range containerRegistryDTOs {
+		if encryption.IsEncrypted(containerRegistryDTO.Password) || containerRegistryDTO.Password == "" {
+			continue
+		}
+
+		password, err := encrypter.Encrypt(containerRegistryDTO.Password)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Model(&containerRegistryDTO).Update("password", password)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	webhookDTOs := []WebhookDTO{}
+	tx = db.Find(&webhookDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, webhookDTO := range webhookDTOs {
+		if encryption.IsEncrypted(webhookDTO.Secret) || webhookDTO.Secret == "" {
+			continue
+		}
+
+		secret, err := encrypter.Encrypt(webhookDTO.Secret)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Model(&webhookDTO).Update("secret", secret)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	profileDataDTOs := []ProfileDataDTO{}
+	tx = db.Find(&profileDataDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, profileDataDTO := range profileDataDTOs {
+		if !hasPlaintextValues(profileDataDTO.EnvVars) {
+			continue
+		}
+
+		var err error
+		profileDataDTO.EnvVars, err = encrypter.EncryptMap(profileDataDTO.EnvVars)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Save(&profileDataDTO)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	projectConfigDTOs := []ProjectConfigDTO{}
+	tx = db.Find(&projectConfigDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, projectConfigDTO := range projectConfigDTOs {
+		if !hasPlaintextValues(projectConfigDTO.EnvVars) {
+			continue
+		}
+
+		var err error
+		projectConfigDTO.EnvVars, err = encrypter.EncryptMap(projectConfigDTO.EnvVars)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Save(&projectConfigDTO)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	buildDTOs := []BuildDTO{}
+	tx = db.Find(&buildDTOs)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	for _, buildDTO := range buildDTOs {
+		if !hasPlaintextValues(buildDTO.EnvVars) {
+			continue
+		}
+
+		var err error
+		buildDTO.EnvVars, err = encrypter.EncryptMap(buildDTO.EnvVars)
+		if err != nil {
+			return err
+		}
+
+		tx = db.Save(&buildDTO)
+		if tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
+
+func hasPlaintextValues(values map[string]string) bool {
+	for _, v := range values {
+		if v != "" && !encryption.IsEncrypted(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasEncryptedCredentials reports whether any credential in the database is encrypted, i.e. whether
+// the database can no longer be read without the current encryption key
+func HasEncryptedCredentials(db *gorm.DB) (bool, error) {
+	gitProviderDTOs := []GitProviderConfigDTO{}
+	tx := db.Find(&gitProviderDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, gitProviderDTO := range gitProviderDTOs {
+		if encryption.IsEncrypted(gitProviderDTO.Token) {
+			return true, nil
+		}
+	}
+
+	containerRegistryDTOs := []ContainerRegistryDTO{}
+	tx = db.Find(&containerRegistryDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, containerRegistryDTO := range containerRegistryDTOs {
+		if encryption.IsEncrypted(containerRegistryDTO.Password) {
+			return true, nil
+		}
+	}
+
+	webhookDTOs := []WebhookDTO{}
+	tx = db.Find(&webhookDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, webhookDTO := range webhookDTOs {
+		if encryption.IsEncrypted(webhookDTO.Secret) {
+			return true, nil
+		}
+	}
+
+	secretDTOs := []SecretDTO{}
+	tx = db.Find(&secretDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, secretDTO := range secretDTOs {
+		if encryption.IsEncrypted(secretDTO.Value) {
+			return true, nil
+		}
+	}
+
+	profileDataDTOs := []ProfileDataDTO{}
+	tx = db.Find(&profileDataDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, profileDataDTO := range profileDataDTOs {
+		if hasEncryptedValues(profileDataDTO.EnvVars) {
+			return true, nil
+		}
+	}
+
+	projectConfigDTOs := []ProjectConfigDTO{}
+	tx = db.Find(&projectConfigDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, projectConfigDTO := range projectConfigDTOs {
+		if hasEncryptedValues(projectConfigDTO.EnvVars) {
+			return true, nil
+		}
+	}
+
+	buildDTOs := []BuildDTO{}
+	tx = db.Find(&buildDTOs)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	for _, buildDTO := range buildDTOs {
+		if hasEncryptedValues(buildDTO.EnvVars) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func hasEncryptedValues(values map[string]string) bool {
+	for _, v := range values {
+		if encryption.IsEncrypted(v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptionAtRest(t *testing.T) {
+	conn, err := db.GetConnection(db.SQLiteDriver, filepath.Join(t.TempDir(), "db"))
+	require.Nil(t, err)
+
+	keyEncrypter, err := encryption.GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "encryption.key"))
+	require.Nil(t, err)
+	encrypter := encryption.NewEncrypter(keyEncrypter)
+
+	t.Run("Stores encrypt credentials", func(t *testing.T) {
+		gitProviderConfigStore, err := db.NewGitProviderConfigStore(conn, encrypter)
+		require.Nil(t, err)
+
+		err = gitProviderConfigStore.Save(&gitprovider.GitProviderConfig{Id: "github", ProviderId: "github", Alias: "github", Token: "ghp_token"})
+		require.Nil(t, err)
+
+		gitProviderDTO := dto.GitProviderConfigDTO{}
+		require.Nil(t, conn.Where("id = ?", "github").First(&gitProviderDTO).Error)
+		require.True(t, encryption.IsEncrypted(gitProviderDTO.Token))
+
+		gitProviderConfig, err := gitProviderConfigStore.Find("github")
+		require.Nil(t, err)
+		require.Equal(t, "ghp_token", gitProviderConfig.Token)
+
+		secretStore, err := db.NewSecretStore(conn, encrypter)
+		require.Nil(t, err)
+
+		err = secretStore.Save(&secret.Secret{Name: "NPM_TOKEN", Value: "npm_token"})
+		require.Nil(t, err)
+
+		secretDTO := dto.SecretDTO{}
+		require.Nil(t, conn.Where("name = ?", "NPM_TOKEN").First(&secretDTO).Error)
+		require.True(t, encryption.IsEncrypted(secretDTO.Value))
+
+		s, err := secretStore.Find("NPM_TOKEN")
+		require.Nil(t, err)
+		require.Equal(t, "npm_token", s.Value)
+
+		buildStore, err := db.NewBuildStore(conn, encrypter)
+		require.Nil(t, err)
+
+		envVars := map[string]string{"TOKEN": "build_token"}
+		err = buildStore.Save(&build.Build{Id: "build", State: build.BuildStatePendingRun, Repository: &gitprovider.GitRepository{Url: "https://github.com/daytonaio/daytona"}, EnvVars: envVars})
+		require.Nil(t, err)
+
+		buildDTO := dto.BuildDTO{}
+		require.Nil(t, conn.Where("id = ?", "build").First(&buildDTO).Error)
+		require.True(t, encryption.IsEncrypted(buildDTO.EnvVars["TOKEN"]))
+
+		getNewest := true
+		b, err := buildStore.Find(&build.Filter{EnvVars: &envVars, GetNewest: &getNewest})
+		require.Nil(t, err)
+		require.Equal(t, "build", b.Id)
+		require.Equal(t, envVars, b.EnvVars)
+
+		otherEnvVars := map[string]string{"TOKEN": "other"}
+		_, err = buildStore.Find(&build.Filter{EnvVars: &otherEnvVars})
+		require.Equal(t, build.ErrBuildNotFound, err)
+	})
+
+	t.Run("EncryptStoredCredentials", func(t *testing.T) {
+		require.Nil(t, conn.Create(&dto.ContainerRegistryDTO{Server: "registry.example.com", Username: "user", Password: "plaintext"}).Error)
+		require.Nil(t, conn.Create(&dto.ProjectConfigDTO{Name: "project", EnvVars: map[string]string{"TOKEN": "plaintext"}}).Error)
+		require.Nil(t, conn.Create(&dto.WebhookDTO{Id: "webhook", Url: "https://example.com/hook", Secret: "plaintext"}).Error)
+		require.Nil(t, conn.Create(&dto.BuildDTO{Id: "plaintext-build", EnvVars: map[string]string{"TOKEN": "plaintext"}}).Error)
+
+		err := db.EncryptStoredCredentials(conn, encrypter)
+		require.Nil(t, err)
+
+		containerRegistryDTO := dto.ContainerRegistryDTO{}
+		require.Nil(t, conn.Where("server = ?", "registry.example.com").First(&containerRegistryDTO).Error)
+		require.True(t, encryption.IsEncrypted(containerRegistryDTO.Password))
+
+		projectConfigDTO := dto.ProjectConfigDTO{}
+		require.Nil(t, conn.Where("name = ?", "project").First(&projectConfigDTO).Error)
+		require.True(t, encryption.IsEncrypted(projectConfigDTO.EnvVars["TOKEN"]))
+
+		containerRegistryStore, err := db.NewContainerRegistryStore(conn, encrypter)
+		require.Nil(t, err)
+		cr, err := containerRegistryStore.Find("registry.example.com")
+		require.Nil(t, err)
+		require.Equal(t, &containerregistry.ContainerRegistry{Server: "registry.example.com", Username: "user", Password: "plaintext"}, cr)
+
+		webhookDTO := dto.WebhookDTO{}
+		require.Nil(t, conn.Where("id = ?", "webhook").First(&webhookDTO).Error)
+		require.True(t, encryption.IsEncrypted(webhookDTO.Secret))
+
+		webhookStore, err := db.NewWebhookStore(conn, encrypter)
+		require.Nil(t, err)
+		w, err := webhookStore.Find("webhook")
+		require.Nil(t, err)
+		require.Equal(t, "plaintext", w.Secret)
+
+		projectConfigStore, err := db.NewProjectConfigStore(conn, encrypter)
+		require.Nil(t, err)
+		projectConfig, err := projectConfigStore.Find(&config.ProjectConfigFilter{Name: &projectConfigDTO.Name})
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"TOKEN": "plaintext"}, projectConfig.EnvVars)
+
+		buildDTO := dto.BuildDTO{}
+		require.Nil(t, conn.Where("id = ?", "plaintext-build").First(&buildDTO).Error)
+		require.True(t, encryption.IsEncrypted(buildDTO.EnvVars["TOKEN"]))
+	})
+}
+
+func TestHasEncryptedCredentials(t *testing.T) {
+	conn, err := db.GetConnection(db.SQLiteDriver, filepath.Join(t.TempDir(), "db"))
+	require.Nil(t, err)
+
+	require.Nil(t, conn.Create(&dto.ProjectConfigDTO{Name: "project", EnvVars: map[string]string{"TOKEN": "plaintext"}}).Error)
+
+	hasEncryptedCredentials, err := db.HasEncryptedCredentials(conn)
+	require.Nil(t, err)
+	require.False(t, hasEncryptedCredentials)
+
+	keyEncrypter, err := encryption.GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "encryption.key"))
+	require.Nil(t, err)
+
+	err = db.EncryptStoredCredentials(conn, encryption.NewEncrypter(keyEncrypter))
+	require.Nil(t, err)
+
+	hasEncryptedCredentials, err = db.HasEncryptedCredentials(conn)
+	require.Nil(t, err)
+	require.True(t, hasEncryptedCredentials)
+}
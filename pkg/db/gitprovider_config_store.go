@@ -7,20 +7,17 @@ import (
 	"gorm.io/gorm"
 
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 )
 
 type GitProviderConfigStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
 }
 
-func NewGitProviderConfigStore(db *gorm.DB) (*GitProviderConfigStore, error) {
-	err := db.AutoMigrate(&GitProviderConfigDTO{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &GitProviderConfigStore{db: db}, nil
+func NewGitProviderConfigStore(db *gorm.DB, encrypter *encryption.Encrypter) (*GitProviderConfigStore, error) {
+	return &GitProviderConfigStore{db: db, encrypter: encrypter}, nil
 }
 
 func (p *GitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error) {
@@ -32,8 +29,11 @@ func (p *GitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error
 
 	gitProviders := []*gitprovider.GitProviderConfig{}
 	for _, gitProviderDTO := range gitProviderDTOs {
-		gitProvider := ToGitProviderConfig(gitProviderDTO)
-		gitProviders = append(gitProviders, &gitProvider)
+		gitProvider, err := p.toGitProviderConfig(gitProviderDTO)
+		if err != nil {
+			return nil, err
+		}
+		gitProviders = append(gitProviders, gitProvider)
 	}
 
 	return gitProviders, nil
@@ -49,13 +49,18 @@ func (p *GitProviderConfigStore) Find(id string) (*gitprovider.GitProviderConfig
 		return nil, tx.Error
 	}
 
-	gitProvider := ToGitProviderConfig(gitProviderDTO)
-
-	return &gitProvider, nil
+	return p.toGitProviderConfig(gitProviderDTO)
 }
 
 func (p *GitProviderConfigStore) Save(gitProvider *gitprovider.GitProviderConfig) error {
 	gitProviderDTO := ToGitProviderConfigDTO(*gitProvider)
+
+	var err error
+	gitProviderDTO.Token, err = p.encrypter.Encrypt(gitProviderDTO.Token)
+	if err != nil {
+		return err
+	}
+
 	tx := p.db.Save(&gitProviderDTO)
 	if tx.Error != nil {
 		return tx.Error
@@ -76,3 +81,15 @@ func (p *GitProviderConfigStore) Delete(gitProvider *gitprovider.GitProviderConf
 
 	return nil
 }
+
+func (p *GitProviderConfigStore) toGitProviderConfig(gitProviderDTO GitProviderConfigDTO) (*gitprovider.GitProviderConfig, error) {
+	var err error
+	gitProviderDTO.Token, err = p.encrypter.Decrypt(gitProviderDTO.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	gitProvider := ToGitProviderConfig(gitProviderDTO)
+
+	return &gitProvider, nil
+}
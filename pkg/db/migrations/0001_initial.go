@@ -0,0 +1,161 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// The models of migrations are frozen copies of the DTOs at the time the migration was added so that
+// changes to the DTOs never change what an existing migration does. Serialized JSON columns are strings.
+
+type initialApiKey struct {
+	KeyHash string `gorm:"primaryKey"`
+	Type    string
+	Name    string `gorm:"uniqueIndex"`
+}
+
+func (initialApiKey) TableName() string {
+	return "api_key_dtos"
+}
+
+type initialBuild struct {
+	Id              string `gorm:"primaryKey"`
+	State           string
+	Image           *string
+	User            *string
+	ContainerConfig string
+	BuildConfig     string
+	Repository      string
+	EnvVars         string
+	PrebuildId      string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (initialBuild) TableName() string {
+	return "build_dtos"
+}
+
+type initialContainerRegistry struct {
+	Server   string `gorm:"primaryKey"`
+	Username string
+	Password string
+}
+
+func (initialContainerRegistry) TableName() string {
+	return "container_registry_dtos"
+}
+
+type initialGitProviderConfig struct {
+	Id            string `gorm:"primaryKey"`
+	ProviderId    string
+	Username      string
+	Token         string
+	BaseApiUrl    *string
+	Alias         string `gorm:"uniqueIndex"`
+	SigningKey    *string
+	SigningMethod *string
+}
+
+func (initialGitProviderConfig) TableName() string {
+	return "git_provider_config_dtos"
+}
+
+type initialProfileData struct {
+	Id      string `gorm:"primaryKey"`
+	EnvVars string
+}
+
+func (initialProfileData) TableName() string {
+	return "profile_data_dtos"
+}
+
+type initialProjectConfig struct {
+	Name                string `gorm:"primaryKey"`
+	Image               string
+	User                string
+	Build               string
+	RepositoryUrl       string
+	EnvVars             string
+	Prebuilds           string
+	IsDefault           bool
+	GitProviderConfigId *string
+}
+
+func (initialProjectConfig) TableName() string {
+	return "project_config_dtos"
+}
+
+type initialProviderTarget struct {
+	Name            string `gorm:"primaryKey"`
+	ProviderName    string
+	ProviderLabel   *string
+	ProviderVersion string
+	Options         string
+	IsDefault       bool
+}
+
+func (initialProviderTarget) TableName() string {
+	return "provider_target_dtos"
+}
+
+type initialSnapshot struct {
+	WorkspaceId string `gorm:"primaryKey"`
+	ProjectName string `gorm:"primaryKey"`
+	Name        string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+}
+
+func (initialSnapshot) TableName() string {
+	return "snapshot_dtos"
+}
+
+type initialWorkspace struct {
+	Id          string `gorm:"primaryKey"`
+	Name        string `gorm:"unique"`
+	Target      string
+	ApiKey      string
+	IdleTimeout *int
+	Projects    string
+}
+
+func (initialWorkspace) TableName() string {
+	return "workspace_dtos"
+}
+
+// Creates the tables that were previously managed by AutoMigrate in the store constructors.
+// Databases created before migrations were introduced already contain the tables and are updated in place.
+var initialMigration = &gormigrate.Migration{
+	ID: "0001_initial",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(
+			&initialApiKey{},
+			&initialBuild{},
+			&initialContainerRegistry{},
+			&initialGitProviderConfig{},
+			&initialProfileData{},
+			&initialProjectConfig{},
+			&initialProviderTarget{},
+			&initialSnapshot{},
+			&initialWorkspace{},
+		)
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(
+			&initialApiKey{},
+			&initialBuild{},
+			&initialContainerRegistry{},
+			&initialGitProviderConfig{},
+			&initialProfileData{},
+			&initialProjectConfig{},
+			&initialProviderTarget{},
+			&initialSnapshot{},
+			&initialWorkspace{},
+		)
+	},
+}
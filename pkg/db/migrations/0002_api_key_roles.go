@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type apiKeyRolesApiKey struct {
+	Role string
+}
+
+func (apiKeyRolesApiKey) TableName() string {
+	return "api_key_dtos"
+}
+
+// Adds a role to API keys. Client keys generated before roles were introduced keep full access.
+var apiKeyRolesMigration = &gormigrate.Migration{
+	ID: "0002_api_key_roles",
+	Migrate: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&apiKeyRolesApiKey{}, "Role") {
+			err := tx.Migrator().AddColumn(&apiKeyRolesApiKey{}, "Role")
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&apiKeyRolesApiKey{}).
+			Where("type = ? AND (role IS NULL OR role = ?)", apikey.ApiKeyTypeClient, "").
+			Update("role", apikey.ApiKeyRoleAdmin).Error
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&apiKeyRolesApiKey{}, "Role") {
+			return nil
+		}
+
+		return tx.Migrator().DropColumn(&apiKeyRolesApiKey{}, "Role")
+	},
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type apiKeyExpiryApiKey struct {
+	Scope      string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+func (apiKeyExpiryApiKey) TableName() string {
+	return "api_key_dtos"
+}
+
+var apiKeyExpiryColumns = []string{"Scope", "ExpiresAt", "LastUsedAt"}
+
+// Adds resource scoping, expiry and last use tracking to API keys
+var apiKeyExpiryMigration = &gormigrate.Migration{
+	ID: "0003_api_key_expiry",
+	Migrate: func(tx *gorm.DB) error {
+		for _, column := range apiKeyExpiryColumns {
+			if tx.Migrator().HasColumn(&apiKeyExpiryApiKey{}, column) {
+				continue
+			}
+
+			err := tx.Migrator().AddColumn(&apiKeyExpiryApiKey{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		for _, column := range apiKeyExpiryColumns {
+			if !tx.Migrator().HasColumn(&apiKeyExpiryApiKey{}, column) {
+				continue
+			}
+
+			err := tx.Migrator().DropColumn(&apiKeyExpiryApiKey{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type auditLog struct {
+	Id         string `gorm:"primaryKey"`
+	ApiKeyName string `gorm:"index"`
+	ApiKeyType string
+	Method     string
+	Route      string
+	Path       string
+	ResourceId string `gorm:"index"`
+	StatusCode int
+	Outcome    string
+	Error      string
+	Duration   int64
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func (auditLog) TableName() string {
+	return "audit_log_dtos"
+}
+
+var auditLogsMigration = &gormigrate.Migration{
+	ID: "0004_audit_logs",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&auditLog{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&auditLog{})
+	},
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type webhook struct {
+	Id         string `gorm:"primaryKey"`
+	Url        string
+	Secret     string
+	EventTypes string
+	CreatedAt  time.Time
+}
+
+func (webhook) TableName() string {
+	return "webhook_dtos"
+}
+
+type webhookDelivery struct {
+	Id         string `gorm:"primaryKey"`
+	WebhookId  string `gorm:"index"`
+	EventId    string
+	EventType  string
+	Attempt    int
+	StatusCode int
+	Success    bool
+	Error      string
+	Duration   int64
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func (webhookDelivery) TableName() string {
+	return "webhook_delivery_dtos"
+}
+
+var webhooksMigration = &gormigrate.Migration{
+	ID: "0005_webhooks",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&webhook{}, &webhookDelivery{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&webhookDelivery{}, &webhook{})
+	},
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type buildQueueBuild struct {
+	ProjectConfigName string
+	Priority          string
+}
+
+func (buildQueueBuild) TableName() string {
+	return "build_dtos"
+}
+
+var buildQueueColumns = []string{"ProjectConfigName", "Priority"}
+
+// Adds the project config and the priority used to schedule queued builds
+var buildQueueMigration = &gormigrate.Migration{
+	ID: "0006_build_queue",
+	Migrate: func(tx *gorm.DB) error {
+		for _, column := range buildQueueColumns {
+			if tx.Migrator().HasColumn(&buildQueueBuild{}, column) {
+				continue
+			}
+
+			err := tx.Migrator().AddColumn(&buildQueueBuild{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		for _, column := range buildQueueColumns {
+			if !tx.Migrator().HasColumn(&buildQueueBuild{}, column) {
+				continue
+			}
+
+			err := tx.Migrator().DropColumn(&buildQueueBuild{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type buildTimeoutBuild struct {
+	Timeout *int
+}
+
+func (buildTimeoutBuild) TableName() string {
+	return "build_dtos"
+}
+
+// Adds the timeout after which a running build is stopped
+var buildTimeoutMigration = &gormigrate.Migration{
+	ID: "0007_build_timeout",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&buildTimeoutBuild{}, "Timeout") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&buildTimeoutBuild{}, "Timeout")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&buildTimeoutBuild{}, "Timeout") {
+			return nil
+		}
+
+		return tx.Migrator().DropColumn(&buildTimeoutBuild{}, "Timeout")
+	},
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type buildLayerCacheBuild struct {
+	LayerCache string
+}
+
+func (buildLayerCacheBuild) TableName() string {
+	return "build_dtos"
+}
+
+// Adds the layer cache statistics of builds
+var buildLayerCacheMigration = &gormigrate.Migration{
+	ID: "0008_build_layer_cache",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&buildLayerCacheBuild{}, "LayerCache") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&buildLayerCacheBuild{}, "LayerCache")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&buildLayerCacheBuild{}, "LayerCache") {
+			return nil
+		}
+
+		return tx.Migrator().DropColumn(&buildLayerCacheBuild{}, "LayerCache")
+	},
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type buildArtifactBuild struct {
+	Artifact string
+}
+
+func (buildArtifactBuild) TableName() string {
+	return "build_dtos"
+}
+
+// Adds the metadata of published build images
+var buildArtifactMigration = &gormigrate.Migration{
+	ID: "0009_build_artifact",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&buildArtifactBuild{}, "Artifact") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&buildArtifactBuild{}, "Artifact")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&buildArtifactBuild{}, "Artifact") {
+			return nil
+		}
+
+		return tx.Migrator().DropColumn(&buildArtifactBuild{}, "Artifact")
+	},
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type projectConfigResourcesProjectConfig struct {
+	Resources string
+}
+
+func (projectConfigResourcesProjectConfig) TableName() string {
+	return "project_config_dtos"
+}
+
+// Adds the resource limits of project configs
+var projectConfigResourcesMigration = &gormigrate.Migration{
+	ID: "0010_project_config_resources",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&projectConfigResourcesProjectConfig{}, "Resources") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&projectConfigResourcesProjectConfig{}, "Resources")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&projectConfigResourcesProjectConfig{}, "Resources") {
+			return nil
+		}
+
+		return tx.Migrator().DropColumn(&projectConfigResourcesProjectConfig{}, "Resources")
+	},
+}
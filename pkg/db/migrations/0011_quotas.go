@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type quota struct {
+	ApiKeyName         string `gorm:"primaryKey"`
+	MaxWorkspaces      int
+	MaxRunningProjects int
+	MaxCpus            float64
+	MaxMemory          int
+	MaxDisk            int
+}
+
+func (quota) TableName() string {
+	return "quota_dtos"
+}
+
+type quotasWorkspace struct {
+	CreatedBy string
+}
+
+func (quotasWorkspace) TableName() string {
+	return "workspace_dtos"
+}
+
+// Creates the quota table and records the client API key that created each workspace
+var quotasMigration = &gormigrate.Migration{
+	ID: "0011_quotas",
+	Migrate: func(tx *gorm.DB) error {
+		err := tx.AutoMigrate(&quota{})
+		if err != nil {
+			return err
+		}
+
+		if tx.Migrator().HasColumn(&quotasWorkspace{}, "CreatedBy") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&quotasWorkspace{}, "CreatedBy")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&quotasWorkspace{}, "CreatedBy") {
+			err := tx.Migrator().DropColumn(&quotasWorkspace{}, "CreatedBy")
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropTable(&quota{})
+	},
+}
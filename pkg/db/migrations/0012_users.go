@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type user struct {
+	Id        string `gorm:"primaryKey"`
+	Name      string `gorm:"uniqueIndex"`
+	CreatedAt time.Time
+}
+
+func (user) TableName() string {
+	return "user_dtos"
+}
+
+type usersApiKey struct {
+	UserId string
+}
+
+func (usersApiKey) TableName() string {
+	return "api_key_dtos"
+}
+
+type usersWorkspace struct {
+	OwnerId string
+}
+
+func (usersWorkspace) TableName() string {
+	return "workspace_dtos"
+}
+
+type usersProjectConfig struct {
+	OwnerId string
+}
+
+func (usersProjectConfig) TableName() string {
+	return "project_config_dtos"
+}
+
+type usersGitProviderConfig struct {
+	OwnerId string
+}
+
+func (usersGitProviderConfig) TableName() string {
+	return "git_provider_config_dtos"
+}
+
+// Columns that tie API keys to users and record the owners of workspaces, project configs and Git provider configs
+var userColumns = []struct {
+	model  interface{}
+	column string
+}{
+	{&usersApiKey{}, "UserId"},
+	{&usersWorkspace{}, "OwnerId"},
+	{&usersProjectConfig{}, "OwnerId"},
+	{&usersGitProviderConfig{}, "OwnerId"},
+}
+
+// Creates the user table and adds resource ownership
+var usersMigration = &gormigrate.Migration{
+	ID: "0012_users",
+	Migrate: func(tx *gorm.DB) error {
+		err := tx.AutoMigrate(&user{})
+		if err != nil {
+			return err
+		}
+
+		for _, c := range userColumns {
+			if tx.Migrator().HasColumn(c.model, c.column) {
+				continue
+			}
+
+			err = tx.Migrator().AddColumn(c.model, c.column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		for _, c := range userColumns {
+			if !tx.Migrator().HasColumn(c.model, c.column) {
+				continue
+			}
+
+			err := tx.Migrator().DropColumn(c.model, c.column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().DropTable(&user{})
+	},
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type secret struct {
+	Name      string `gorm:"primaryKey"`
+	Value     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (secret) TableName() string {
+	return "secret_dtos"
+}
+
+// Creates the secret table. Credentials stored before encryption was introduced are encrypted
+// on server start since the encryption key is not available to migrations.
+var secretsMigration = &gormigrate.Migration{
+	ID: "0013_secrets",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&secret{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&secret{})
+	},
+}
@@ -0,0 +1,125 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"encoding/json"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type buildSbom struct {
+	BuildId  string `gorm:"primaryKey"`
+	Format   string
+	Packages int
+	Document string
+}
+
+func (buildSbom) TableName() string {
+	return "build_sbom_dtos"
+}
+
+type buildSbomsBuild struct {
+	Id       string `gorm:"primaryKey"`
+	Artifact string
+}
+
+func (buildSbomsBuild) TableName() string {
+	return "build_dtos"
+}
+
+// Moves the SBOM documents out of the build artifacts into their own table
+// so that they are not loaded with every build
+var buildSbomsMigration = &gormigrate.Migration{
+	ID: "0014_build_sboms",
+	Migrate: func(tx *gorm.DB) error {
+		err := tx.AutoMigrate(&buildSbom{})
+		if err != nil {
+			return err
+		}
+
+		return forEachBuildArtifact(tx, func(b buildSbomsBuild, artifact map[string]interface{}) (bool, error) {
+			sbom, ok := artifact["sbom"].(map[string]interface{})
+			if !ok {
+				return false, nil
+			}
+
+			document, _ := sbom["document"].(string)
+			if document == "" {
+				return false, nil
+			}
+
+			format, _ := sbom["format"].(string)
+			packages, _ := sbom["packages"].(float64)
+
+			err := tx.Save(&buildSbom{BuildId: b.Id, Format: format, Packages: int(packages), Document: document}).Error
+			if err != nil {
+				return false, err
+			}
+
+			delete(sbom, "document")
+			return true, nil
+		})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		err := forEachBuildArtifact(tx, func(b buildSbomsBuild, artifact map[string]interface{}) (bool, error) {
+			sbom, ok := artifact["sbom"].(map[string]interface{})
+			if !ok {
+				return false, nil
+			}
+
+			stored := buildSbom{}
+			err := tx.Where("build_id = ?", b.Id).Limit(1).Find(&stored).Error
+			if err != nil || stored.Document == "" {
+				return false, err
+			}
+
+			sbom["document"] = stored.Document
+			return true, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropTable(&buildSbom{})
+	},
+}
+
+// Calls the function with the parsed artifact of each build and saves the artifact if the function changed it
+func forEachBuildArtifact(tx *gorm.DB, fn func(b buildSbomsBuild, artifact map[string]interface{}) (bool, error)) error {
+	builds := []buildSbomsBuild{}
+	err := tx.Where("artifact IS NOT NULL AND artifact != ''").Find(&builds).Error
+	if err != nil {
+		return err
+	}
+
+	for _, b := range builds {
+		artifact := map[string]interface{}{}
+		err := json.Unmarshal([]byte(b.Artifact), &artifact)
+		if err != nil || artifact == nil {
+			continue
+		}
+
+		changed, err := fn(b, artifact)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			continue
+		}
+
+		updated, err := json.Marshal(artifact)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&buildSbomsBuild{}).Where("id = ?", b.Id).Update("artifact", string(updated)).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
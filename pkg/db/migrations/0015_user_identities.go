@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type userIdentity struct {
+	Issuer  *string `gorm:"uniqueIndex:idx_user_dtos_identity"`
+	Subject *string `gorm:"uniqueIndex:idx_user_dtos_identity"`
+}
+
+func (userIdentity) TableName() string {
+	return "user_dtos"
+}
+
+// Adds the OIDC identity of users so that logins are not matched to users by their name
+var userIdentitiesMigration = &gormigrate.Migration{
+	ID: "0015_user_identities",
+	Migrate: func(tx *gorm.DB) error {
+		for _, column := range []string{"Issuer", "Subject"} {
+			if tx.Migrator().HasColumn(&userIdentity{}, column) {
+				continue
+			}
+
+			err := tx.Migrator().AddColumn(&userIdentity{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Migrator().CreateIndex(&userIdentity{}, "idx_user_dtos_identity")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		err := tx.Migrator().DropIndex(&userIdentity{}, "idx_user_dtos_identity")
+		if err != nil {
+			return err
+		}
+
+		for _, column := range []string{"Issuer", "Subject"} {
+			err = tx.Migrator().DropColumn(&userIdentity{}, column)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type secretOwner struct {
+	OwnerId string
+}
+
+func (secretOwner) TableName() string {
+	return "secret_dtos"
+}
+
+// Adds the owner of secrets. Existing secrets have no owner and remain shared by all users.
+var secretOwnersMigration = &gormigrate.Migration{
+	ID: "0016_secret_owners",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&secretOwner{}, "OwnerId") {
+			return nil
+		}
+
+		return tx.Migrator().AddColumn(&secretOwner{}, "OwnerId")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropColumn(&secretOwner{}, "OwnerId")
+	},
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type userQuota struct {
+	ApiKeyName         string `gorm:"primaryKey"`
+	UserId             string `gorm:"primaryKey"`
+	MaxWorkspaces      int
+	MaxRunningProjects int
+	MaxCpus            float64
+	MaxMemory          int
+	MaxDisk            int
+}
+
+func (userQuota) TableName() string {
+	return "quota_dtos"
+}
+
+// Lets quotas target users. The user ID becomes part of the primary key so the table is recreated.
+var userQuotasMigration = &gormigrate.Migration{
+	ID: "0017_user_quotas",
+	Migrate: func(tx *gorm.DB) error {
+		if tx.Migrator().HasColumn(&userQuota{}, "UserId") {
+			return nil
+		}
+
+		quotas := []quota{}
+		err := tx.Find(&quotas).Error
+		if err != nil {
+			return err
+		}
+
+		userQuotas := []userQuota{}
+		for _, q := range quotas {
+			userQuotas = append(userQuotas, userQuota{
+				ApiKeyName:         q.ApiKeyName,
+				MaxWorkspaces:      q.MaxWorkspaces,
+				MaxRunningProjects: q.MaxRunningProjects,
+				MaxCpus:            q.MaxCpus,
+				MaxMemory:          q.MaxMemory,
+				MaxDisk:            q.MaxDisk,
+			})
+		}
+
+		return recreateQuotaTable(tx, userQuotas)
+	},
+	Rollback: func(tx *gorm.DB) error {
+		if !tx.Migrator().HasColumn(&userQuota{}, "UserId") {
+			return nil
+		}
+
+		// Quotas of users can not be represented without the user ID and are dropped
+		userQuotas := []userQuota{}
+		err := tx.Where("user_id = ?", "").Find(&userQuotas).Error
+		if err != nil {
+			return err
+		}
+
+		quotas := []quota{}
+		for _, q := range userQuotas {
+			quotas = append(quotas, quota{
+				ApiKeyName:         q.ApiKeyName,
+				MaxWorkspaces:      q.MaxWorkspaces,
+				MaxRunningProjects: q.MaxRunningProjects,
+				MaxCpus:            q.MaxCpus,
+				MaxMemory:          q.MaxMemory,
+				MaxDisk:            q.MaxDisk,
+			})
+		}
+
+		return recreateQuotaTable(tx, quotas)
+	},
+}
+
+// The primary key can not be altered in SQLite so the table is dropped and created with the given rows
+func recreateQuotaTable[T userQuota | quota](tx *gorm.DB, rows []T) error {
+	model := new(T)
+	err := tx.Migrator().DropTable(model)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Migrator().CreateTable(model)
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return tx.Create(&rows).Error
+}
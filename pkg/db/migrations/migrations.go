@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+const tableName = "schema_migrations"
+
+// Migrations are applied in order and each one is applied only once.
+// Applied migrations must never be modified, schema changes should be added as a new migration.
+var migrations = []*gormigrate.Migration{
+	initialMigration,
+	apiKeyRolesMigration,
+	apiKeyExpiryMigration,
+	auditLogsMigration,
+	webhooksMigration,
+	buildQueueMigration,
+	buildTimeoutMigration,
+	buildLayerCacheMigration,
+	buildArtifactMigration,
+	projectConfigResourcesMigration,
+	quotasMigration,
+	usersMigration,
+	secretsMigration,
+	buildSbomsMigration,
+	userIdentitiesMigration,
+	secretOwnersMigration,
+	userQuotasMigration,
+}
+
+type MigrationStatus struct {
+	Id      string `json:"id"`
+	Applied bool   `json:"applied"`
+}
+
+func Migrate(db *gorm.DB) error {
+	return newMigrator(db).Migrate()
+}
+
+// RollbackLast reverts the last applied migration
+func RollbackLast(db *gorm.DB) error {
+	return newMigrator(db).RollbackLast()
+}
+
+func Status(db *gorm.DB) ([]MigrationStatus, error) {
+	applied, err := getAppliedMigrations(db)
+	if err != nil {
+		return nil, err
+	}
+
+	status := []MigrationStatus{}
+	for _, m := range migrations {
+		_, ok := applied[m.ID]
+		status = append(status, MigrationStatus{
+			Id:      m.ID,
+			Applied: ok,
+		})
+	}
+
+	return status, nil
+}
+
+func HasPendingMigrations(db *gorm.DB) (bool, error) {
+	applied, err := getAppliedMigrations(db)
+	if err != nil {
+		return false, err
+	}
+
+	for _, m := range migrations {
+		if _, ok := applied[m.ID]; !ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func getAppliedMigrations(db *gorm.DB) (map[string]bool, error) {
+	applied := map[string]bool{}
+
+	if !db.Migrator().HasTable(tableName) {
+		return applied, nil
+	}
+
+	var ids []string
+	tx := db.Table(tableName).Pluck("id", &ids)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	for _, id := range ids {
+		applied[id] = true
+	}
+
+	return applied, nil
+}
+
+func newMigrator(db *gorm.DB) *gormigrate.Gormigrate {
+	return gormigrate.New(db, &gormigrate.Options{
+		TableName:                 tableName,
+		IDColumnName:              "id",
+		IDColumnSize:              255,
+		UseTransaction:            db.Dialector.Name() == "postgres",
+		ValidateUnknownMigrations: false,
+	}, migrations)
+}
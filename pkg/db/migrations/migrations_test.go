@@ -0,0 +1,239 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package migrations_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/db/migrations"
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMigrations(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.Nil(t, err)
+
+	t.Run("Status before migrating", func(t *testing.T) {
+		pending, err := migrations.HasPendingMigrations(db)
+		require.Nil(t, err)
+		require.True(t, pending)
+
+		status, err := migrations.Status(db)
+		require.Nil(t, err)
+		require.NotEmpty(t, status)
+		for _, s := range status {
+			require.False(t, s.Applied)
+		}
+	})
+
+	t.Run("Migrate", func(t *testing.T) {
+		err := migrations.Migrate(db)
+		require.Nil(t, err)
+
+		pending, err := migrations.HasPendingMigrations(db)
+		require.Nil(t, err)
+		require.False(t, pending)
+
+		status, err := migrations.Status(db)
+		require.Nil(t, err)
+		for _, s := range status {
+			require.True(t, s.Applied)
+		}
+
+		require.True(t, db.Migrator().HasTable("workspace_dtos"))
+	})
+
+	t.Run("RollbackLast", func(t *testing.T) {
+		status, err := migrations.Status(db)
+		require.Nil(t, err)
+
+		err = migrations.RollbackLast(db)
+		require.Nil(t, err)
+
+		newStatus, err := migrations.Status(db)
+		require.Nil(t, err)
+		require.False(t, newStatus[len(newStatus)-1].Applied)
+		require.Equal(t, len(status), len(newStatus))
+
+		pending, err := migrations.HasPendingMigrations(db)
+		require.Nil(t, err)
+		require.True(t, pending)
+	})
+
+	t.Run("Migrate after rollback", func(t *testing.T) {
+		err := migrations.Migrate(db)
+		require.Nil(t, err)
+
+		pending, err := migrations.HasPendingMigrations(db)
+		require.Nil(t, err)
+		require.False(t, pending)
+	})
+}
+
+// Migrations use frozen copies of the DTOs so the resulting schema has to be checked against the DTOs
+func TestMigratedSchemaMatchesDTOs(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	models := []interface{}{
+		&dto.ApiKeyDTO{},
+		&dto.AuditLogDTO{},
+		&dto.BuildDTO{},
+		&dto.BuildSbomDTO{},
+		&dto.ContainerRegistryDTO{},
+		&dto.GitProviderConfigDTO{},
+		&dto.ProfileDataDTO{},
+		&dto.ProjectConfigDTO{},
+		&dto.ProviderTargetDTO{},
+		&dto.QuotaDTO{},
+		&dto.SecretDTO{},
+		&dto.SnapshotDTO{},
+		&dto.UserDTO{},
+		&dto.WebhookDTO{},
+		&dto.WebhookDeliveryDTO{},
+		&dto.WorkspaceDTO{},
+	}
+
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		require.Nil(t, stmt.Parse(model))
+		require.True(t, db.Migrator().HasTable(model), stmt.Schema.Table)
+
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			require.True(t, db.Migrator().HasColumn(model, field.DBName), "%s.%s", stmt.Schema.Table, field.DBName)
+		}
+	}
+}
+
+func TestApiKeyRolesMigration(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	// Revert to the schema without roles and add keys the way older servers stored them
+	rollbackTo(t, db, "0001_initial")
+	require.False(t, db.Migrator().HasColumn(&dto.ApiKeyDTO{}, "Role"))
+
+	err = db.Exec("INSERT INTO api_key_dtos (key_hash, type, name) VALUES (?, ?, ?), (?, ?, ?)",
+		"client-hash", apikey.ApiKeyTypeClient, "client",
+		"project-hash", apikey.ApiKeyTypeProject, "project").Error
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	var clientKey dto.ApiKeyDTO
+	err = db.Where("name = ?", "client").First(&clientKey).Error
+	require.Nil(t, err)
+	require.Equal(t, apikey.ApiKeyRoleAdmin, clientKey.Role)
+
+	var projectKey dto.ApiKeyDTO
+	err = db.Where("name = ?", "project").First(&projectKey).Error
+	require.Nil(t, err)
+	require.Empty(t, projectKey.Role)
+}
+
+// rollbackTo reverts migrations until the migration with the given id is the last applied one
+func rollbackTo(t *testing.T, db *gorm.DB, id string) {
+	for {
+		status, err := migrations.Status(db)
+		require.Nil(t, err)
+
+		last := ""
+		for _, s := range status {
+			if s.Applied {
+				last = s.Id
+			}
+		}
+
+		if last == id {
+			return
+		}
+
+		require.NotEmpty(t, last)
+		err = migrations.RollbackLast(db)
+		require.Nil(t, err)
+	}
+}
+
+func TestBuildSbomsMigration(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	// Revert to the schema that stored SBOM documents inline and add a build the way older servers stored it
+	rollbackTo(t, db, "0013_secrets")
+	require.False(t, db.Migrator().HasTable(&dto.BuildSbomDTO{}))
+
+	err = db.Exec("INSERT INTO build_dtos (id, artifact) VALUES (?, ?)",
+		"build-1", `{"imageDigest":"sha256:123","sbom":{"format":"spdx-json","packages":2,"document":"{}"}}`).Error
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	var buildDTO dto.BuildDTO
+	err = db.Where("id = ?", "build-1").First(&buildDTO).Error
+	require.Nil(t, err)
+	require.Equal(t, "sha256:123", buildDTO.Artifact.ImageDigest)
+	require.Equal(t, 2, buildDTO.Artifact.Sbom.Packages)
+	require.Empty(t, buildDTO.Artifact.Sbom.Document)
+
+	var sbomDTO dto.BuildSbomDTO
+	err = db.Where("build_id = ?", "build-1").First(&sbomDTO).Error
+	require.Nil(t, err)
+	require.Equal(t, "spdx-json", sbomDTO.Format)
+	require.Equal(t, "{}", sbomDTO.Document)
+}
+
+func TestUserQuotasMigration(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db")), &gorm.Config{})
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	// Revert to the schema with quotas of API keys only and add a quota the way older servers stored it
+	rollbackTo(t, db, "0016_secret_owners")
+	require.False(t, db.Migrator().HasColumn(&dto.QuotaDTO{}, "UserId"))
+
+	err = db.Exec("INSERT INTO quota_dtos (api_key_name, max_workspaces) VALUES (?, ?)", "ci", 2).Error
+	require.Nil(t, err)
+
+	err = migrations.Migrate(db)
+	require.Nil(t, err)
+
+	var quotaDTO dto.QuotaDTO
+	err = db.Where("api_key_name = ?", "ci").First(&quotaDTO).Error
+	require.Nil(t, err)
+	require.Empty(t, quotaDTO.UserId)
+	require.Equal(t, 2, quotaDTO.MaxWorkspaces)
+
+	// A user can have a quota next to the quota of an API key
+	err = db.Create(&dto.QuotaDTO{UserId: "user1", MaxWorkspaces: 1}).Error
+	require.Nil(t, err)
+
+	err = migrations.RollbackLast(db)
+	require.Nil(t, err)
+
+	var count int64
+	err = db.Table("quota_dtos").Count(&count).Error
+	require.Nil(t, err)
+	require.Equal(t, int64(1), count)
+}
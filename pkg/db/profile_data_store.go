@@ -5,21 +5,18 @@ package db
 
 import (
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/profiledata"
 	"gorm.io/gorm"
 )
 
 type ProfileDataStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
 }
 
-func NewProfileDataStore(db *gorm.DB) (*ProfileDataStore, error) {
-	err := db.AutoMigrate(&ProfileDataDTO{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &ProfileDataStore{db: db}, nil
+func NewProfileDataStore(db *gorm.DB, encrypter *encryption.Encrypter) (*ProfileDataStore, error) {
+	return &ProfileDataStore{db: db, encrypter: encrypter}, nil
 }
 
 func (p *ProfileDataStore) Get() (*profiledata.ProfileData, error) {
@@ -32,13 +29,24 @@ func (p *ProfileDataStore) Get() (*profiledata.ProfileData, error) {
 		return nil, tx.Error
 	}
 
-	profileData := ToProfileData(profileDataDTO)
+	var err error
+	profileDataDTO.EnvVars, err = p.encrypter.DecryptMap(profileDataDTO.EnvVars)
+	if err != nil {
+		return nil, err
+	}
 
-	return profileData, nil
+	return ToProfileData(profileDataDTO), nil
 }
 
 func (p *ProfileDataStore) Save(profileData *profiledata.ProfileData) error {
 	profileDataDTO := ToProfileDataDTO(profileData)
+
+	var err error
+	profileDataDTO.EnvVars, err = p.encrypter.EncryptMap(profileDataDTO.EnvVars)
+	if err != nil {
+		return err
+	}
+
 	tx := p.db.Save(&profileDataDTO)
 	if tx.Error != nil {
 		return tx.Error
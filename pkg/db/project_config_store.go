@@ -7,20 +7,17 @@ import (
 	"gorm.io/gorm"
 
 	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 )
 
 type ProjectConfigStore struct {
-	db *gorm.DB
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
 }
 
-func NewProjectConfigStore(db *gorm.DB) (*ProjectConfigStore, error) {
-	err := db.AutoMigrate(&ProjectConfigDTO{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &ProjectConfigStore{db: db}, nil
+func NewProjectConfigStore(db *gorm.DB, encrypter *encryption.Encrypter) (*ProjectConfigStore, error) {
+	return &ProjectConfigStore{db: db, encrypter: encrypter}, nil
 }
 
 func (s *ProjectConfigStore) List(filter *config.ProjectConfigFilter) ([]*config.ProjectConfig, error) {
@@ -33,7 +30,11 @@ func (s *ProjectConfigStore) List(filter *config.ProjectConfigFilter) ([]*config
 
 	projectConfigs := []*config.ProjectConfig{}
 	for _, projectConfigDTO := range projectConfigsDTOs {
-		projectConfigs = append(projectConfigs, ToProjectConfig(projectConfigDTO))
+		projectConfig, err := s.toProjectConfig(projectConfigDTO)
+		if err != nil {
+			return nil, err
+		}
+		projectConfigs = append(projectConfigs, projectConfig)
 	}
 
 	return projectConfigs, nil
@@ -50,11 +51,19 @@ func (s *ProjectConfigStore) Find(filter *config.ProjectConfigFilter) (*config.P
 		return nil, tx.Error
 	}
 
-	return ToProjectConfig(projectConfigDTO), nil
+	return s.toProjectConfig(projectConfigDTO)
 }
 
 func (s *ProjectConfigStore) Save(projectConfig *config.ProjectConfig) error {
-	tx := s.db.Save(ToProjectConfigDTO(projectConfig))
+	projectConfigDTO := ToProjectConfigDTO(projectConfig)
+
+	var err error
+	projectConfigDTO.EnvVars, err = s.encrypter.EncryptMap(projectConfigDTO.EnvVars)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(&projectConfigDTO)
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -74,6 +83,16 @@ func (s *ProjectConfigStore) Delete(projectConfig *config.ProjectConfig) error {
 	return nil
 }
 
+func (s *ProjectConfigStore) toProjectConfig(projectConfigDTO ProjectConfigDTO) (*config.ProjectConfig, error) {
+	var err error
+	projectConfigDTO.EnvVars, err = s.encrypter.DecryptMap(projectConfigDTO.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToProjectConfig(projectConfigDTO), nil
+}
+
 func processProjectConfigFilters(tx *gorm.DB, filter *config.ProjectConfigFilter) *gorm.DB {
 	if filter != nil {
 		if filter.Name != nil {
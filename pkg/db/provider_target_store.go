@@ -15,11 +15,6 @@ type ProviderTargetStore struct {
 }
 
 func NewProviderTargetStore(db *gorm.DB) (*ProviderTargetStore, error) {
-	err := db.AutoMigrate(&ProviderTargetDTO{})
-	if err != nil {
-		return nil, err
-	}
-
 	return &ProviderTargetStore{db: db}, nil
 }
 
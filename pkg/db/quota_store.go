@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/quota"
+)
+
+type QuotaStore struct {
+	db *gorm.DB
+}
+
+func NewQuotaStore(db *gorm.DB) (*QuotaStore, error) {
+	return &QuotaStore{db: db}, nil
+}
+
+func (s *QuotaStore) List() ([]*quota.Quota, error) {
+	quotaDTOs := []QuotaDTO{}
+	tx := s.db.Order("api_key_name").Order("user_id").Find(&quotaDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	quotas := []*quota.Quota{}
+	for _, quotaDTO := range quotaDTOs {
+		quotas = append(quotas, ToQuota(quotaDTO))
+	}
+
+	return quotas, nil
+}
+
+func (s *QuotaStore) Find(apiKeyName string) (*quota.Quota, error) {
+	return s.find(s.db.Where("api_key_name = ? AND user_id = ?", apiKeyName, ""))
+}
+
+func (s *QuotaStore) FindForUser(userId string) (*quota.Quota, error) {
+	return s.find(s.db.Where("api_key_name = ? AND user_id = ?", "", userId))
+}
+
+func (s *QuotaStore) find(tx *gorm.DB) (*quota.Quota, error) {
+	quotaDTO := QuotaDTO{}
+	tx = tx.First(&quotaDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, quota.ErrQuotaNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToQuota(quotaDTO), nil
+}
+
+func (s *QuotaStore) Save(q *quota.Quota) error {
+	tx := s.db.Save(ToQuotaDTO(q))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *QuotaStore) Delete(q *quota.Quota) error {
+	tx := s.db.Where("api_key_name = ? AND user_id = ?", q.ApiKeyName, q.UserId).Delete(&QuotaDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return quota.ErrQuotaNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+type SecretStore struct {
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
+}
+
+func NewSecretStore(db *gorm.DB, encrypter *encryption.Encrypter) (*SecretStore, error) {
+	return &SecretStore{db: db, encrypter: encrypter}, nil
+}
+
+func (s *SecretStore) List() ([]*secret.Secret, error) {
+	secretDTOs := []SecretDTO{}
+	tx := s.db.Order("name").Find(&secretDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	secrets := []*secret.Secret{}
+	for _, secretDTO := range secretDTOs {
+		sec, err := s.toSecret(secretDTO)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, sec)
+	}
+
+	return secrets, nil
+}
+
+func (s *SecretStore) Find(name string) (*secret.Secret, error) {
+	secretDTO := SecretDTO{}
+	tx := s.db.Where("name = ?", name).First(&secretDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, secret.ErrSecretNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return s.toSecret(secretDTO)
+}
+
+func (s *SecretStore) Save(sec *secret.Secret) error {
+	secretDTO := ToSecretDTO(sec)
+
+	var err error
+	secretDTO.Value, err = s.encrypter.Encrypt(secretDTO.Value)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(&secretDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SecretStore) Delete(sec *secret.Secret) error {
+	tx := s.db.Where("name = ?", sec.Name).Delete(&SecretDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return secret.ErrSecretNotFound
+	}
+
+	return nil
+}
+
+func (s *SecretStore) toSecret(secretDTO SecretDTO) (*secret.Secret, error) {
+	var err error
+	secretDTO.Value, err = s.encrypter.Decrypt(secretDTO.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSecret(secretDTO), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/snapshot"
+)
+
+type SnapshotStore struct {
+	db *gorm.DB
+}
+
+func NewSnapshotStore(db *gorm.DB) (*SnapshotStore, error) {
+	return &SnapshotStore{db: db}, nil
+}
+
+func (s *SnapshotStore) List(filter *snapshot.Filter) ([]*snapshot.Snapshot, error) {
+	snapshotDTOs := []SnapshotDTO{}
+	tx := processSnapshotFilters(s.db, filter).Order("created_at desc").Find(&snapshotDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	snapshots := []*snapshot.Snapshot{}
+	for _, snapshotDTO := range snapshotDTOs {
+		snapshots = append(snapshots, ToSnapshot(snapshotDTO))
+	}
+
+	return snapshots, nil
+}
+
+func (s *SnapshotStore) Find(filter *snapshot.Filter) (*snapshot.Snapshot, error) {
+	snapshotDTO := SnapshotDTO{}
+	tx := processSnapshotFilters(s.db, filter).First(&snapshotDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, snapshot.ErrSnapshotNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSnapshot(snapshotDTO), nil
+}
+
+func (s *SnapshotStore) Save(snapshot *snapshot.Snapshot) error {
+	tx := s.db.Save(ToSnapshotDTO(snapshot))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SnapshotStore) Delete(snap *snapshot.Snapshot) error {
+	tx := s.db.Delete(ToSnapshotDTO(snap))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return snapshot.ErrSnapshotNotFound
+	}
+
+	return nil
+}
+
+func processSnapshotFilters(tx *gorm.DB, filter *snapshot.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+		if filter.Name != nil {
+			tx = tx.Where("name = ?", *filter.Name)
+		}
+	}
+	return tx
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type UserStore struct {
+	db *gorm.DB
+}
+
+func NewUserStore(db *gorm.DB) (*UserStore, error) {
+	return &UserStore{db: db}, nil
+}
+
+func (s *UserStore) List() ([]*user.User, error) {
+	userDTOs := []UserDTO{}
+	tx := s.db.Order("name").Find(&userDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	users := []*user.User{}
+	for _, userDTO := range userDTOs {
+		users = append(users, ToUser(userDTO))
+	}
+
+	return users, nil
+}
+
+func (s *UserStore) Find(idOrName string) (*user.User, error) {
+	userDTO := UserDTO{}
+	tx := s.db.Where("id = ? OR name = ?", idOrName, idOrName).First(&userDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToUser(userDTO), nil
+}
+
+func (s *UserStore) FindByIdentity(issuer, subject string) (*user.User, error) {
+	userDTO := UserDTO{}
+	tx := s.db.Where("issuer = ? AND subject = ?", issuer, subject).First(&userDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToUser(userDTO), nil
+}
+
+func (s *UserStore) Save(u *user.User) error {
+	tx := s.db.Save(ToUserDTO(u))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *UserStore) Delete(u *user.User) error {
+	tx := s.db.Delete(ToUserDTO(u))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return user.ErrUserNotFound
+	}
+
+	return nil
+}
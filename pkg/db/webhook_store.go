@@ -0,0 +1,146 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/encryption"
+	"github.com/daytonaio/daytona/pkg/webhook"
+)
+
+type WebhookStore struct {
+	db        *gorm.DB
+	encrypter *encryption.Encrypter
+}
+
+func NewWebhookStore(db *gorm.DB, encrypter *encryption.Encrypter) (*WebhookStore, error) {
+	return &WebhookStore{db: db, encrypter: encrypter}, nil
+}
+
+func (s *WebhookStore) List() ([]*webhook.Webhook, error) {
+	webhookDTOs := []WebhookDTO{}
+	tx := s.db.Order("created_at").Find(&webhookDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	webhooks := []*webhook.Webhook{}
+	for _, webhookDTO := range webhookDTOs {
+		w, err := s.toWebhook(webhookDTO)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookStore) Find(id string) (*webhook.Webhook, error) {
+	webhookDTO := WebhookDTO{}
+	tx := s.db.Where("id = ?", id).First(&webhookDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, webhook.ErrWebhookNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return s.toWebhook(webhookDTO)
+}
+
+func (s *WebhookStore) Save(w *webhook.Webhook) error {
+	webhookDTO := ToWebhookDTO(w)
+
+	var err error
+	webhookDTO.Secret, err = s.encrypter.Encrypt(webhookDTO.Secret)
+	if err != nil {
+		return err
+	}
+
+	tx := s.db.Save(&webhookDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) Delete(w *webhook.Webhook) error {
+	tx := s.db.Delete(ToWebhookDTO(w))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return webhook.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (s *WebhookStore) toWebhook(webhookDTO WebhookDTO) (*webhook.Webhook, error) {
+	var err error
+	webhookDTO.Secret, err = s.encrypter.Decrypt(webhookDTO.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToWebhook(webhookDTO), nil
+}
+
+type WebhookDeliveryStore struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryStore(db *gorm.DB) (*WebhookDeliveryStore, error) {
+	return &WebhookDeliveryStore{db: db}, nil
+}
+
+func (s *WebhookDeliveryStore) List(filter *webhook.DeliveryFilter) ([]*webhook.Delivery, error) {
+	deliveryDTOs := []WebhookDeliveryDTO{}
+	tx := processWebhookDeliveryFilters(s.db, filter).Order("created_at desc").Find(&deliveryDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	deliveries := []*webhook.Delivery{}
+	for _, deliveryDTO := range deliveryDTOs {
+		deliveries = append(deliveries, ToWebhookDelivery(deliveryDTO))
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookDeliveryStore) Save(delivery *webhook.Delivery) error {
+	deliveryDTO := ToWebhookDeliveryDTO(delivery)
+	tx := s.db.Create(&deliveryDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *WebhookDeliveryStore) DeleteForWebhook(webhookId string) error {
+	tx := s.db.Where("webhook_id = ?", webhookId).Delete(&WebhookDeliveryDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processWebhookDeliveryFilters(tx *gorm.DB, filter *webhook.DeliveryFilter) *gorm.DB {
+	if filter != nil {
+		if filter.WebhookId != nil {
+			tx = tx.Where("webhook_id = ?", *filter.WebhookId)
+		}
+		if filter.Limit != nil {
+			tx = tx.Limit(*filter.Limit)
+		}
+	}
+	return tx
+}
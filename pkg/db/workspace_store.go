@@ -15,11 +15,6 @@ type WorkspaceStore struct {
 }
 
 func NewWorkspaceStore(db *gorm.DB) (*WorkspaceStore, error) {
-	err := db.AutoMigrate(&WorkspaceDTO{})
-	if err != nil {
-		return nil, err
-	}
-
 	return &WorkspaceStore{db: db}, nil
 }
 
@@ -40,11 +40,18 @@ type IDockerClient interface {
 	StartProject(opts *CreateProjectOptions, daytonaDownloadUrl string) error
 	StopProject(project *project.Project, logWriter io.Writer) error
 
+	SnapshotProject(project *project.Project, snapshotName string, logWriter io.Writer) error
+	RestoreProject(project *project.Project, snapshotName string, logWriter io.Writer) error
+	CloneProject(source *project.Project, project *project.Project, snapshotName string, logWriter io.Writer) error
+	DeleteProjectSnapshot(project *project.Project, snapshotName string) error
+	ListProjectSnapshots(project *project.Project) ([]string, error)
+
 	GetProjectInfo(project *project.Project) (*project.ProjectInfo, error)
 	GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.WorkspaceInfo, error)
 
 	GetProjectContainerName(project *project.Project) string
 	GetProjectVolumeName(project *project.Project) string
+	GetProjectImageName(project *project.Project) string
 	ExecSync(containerID string, config container.ExecOptions, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
@@ -52,7 +59,11 @@ type IDockerClient interface {
 	DeleteImage(imageName string, force bool, logWriter io.Writer) error
 
 	CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error)
+	BuildFromDockerfile(opts BuildDockerfileOptions) (RemoteUser, error)
+	BuildFromNix(opts BuildDockerfileOptions) (RemoteUser, error)
+	GenerateSbom(opts GenerateSbomOptions) (string, error)
 	RemoveContainer(containerName string) error
+	RemoveContainersByLabel(label string) error
 }
 
 type DockerClientConfig struct {
@@ -61,6 +61,10 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 		case detect.BuilderTypeDevcontainer:
 			_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
 			return err
+		case detect.BuilderTypeDockerfile:
+			return d.createProjectFromBuiltImage(opts, pulledImages, d.BuildFromDockerfile)
+		case detect.BuilderTypeNix:
+			return d.createProjectFromBuiltImage(opts, pulledImages, d.BuildFromNix)
 		case detect.BuilderTypeImage:
 			return d.createProjectFromImage(opts, pulledImages, true)
 		default:
@@ -215,7 +219,8 @@ func (d *DockerClient) toCreateDevcontainerOptions(opts *CreateProjectOptions, p
 			"daytona.workspace.id": opts.Project.WorkspaceId,
 			"daytona.project.name": opts.Project.Name,
 		},
-		Prebuild: prebuild,
+		Prebuild:  prebuild,
+		Resources: opts.Project.Resources,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
@@ -50,8 +51,13 @@ type CreateDevcontainerOptions struct {
 	Prebuild                 bool
 	EnvVars                  map[string]string
 	IdLabels                 map[string]string
+	BuilderLabels            map[string]string
 	BuilderImage             string
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// Image in the builder registry that image layers are imported from
+	LayerCacheImage string
+	// Resource limits of the devcontainer. Not applied to Docker Compose devcontainers.
+	Resources *resources.Resources
 }
 
 func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
@@ -243,6 +249,18 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		devcontainerConfig["dockerComposeFile"] = path.Join(paths.OverridesTarget, "daytona-compose-override.yml")
 	}
 
+	if !opts.Resources.IsEmpty() {
+		if _, ok := devcontainerConfig["dockerComposeFile"]; ok {
+			opts.LogWriter.Write([]byte("Resource limits are not applied to Docker Compose devcontainers\n"))
+		} else {
+			runArgs, _ := devcontainerConfig["runArgs"].([]interface{})
+			for _, runArg := range getResourceRunArgs(opts.Resources) {
+				runArgs = append(runArgs, runArg)
+			}
+			devcontainerConfig["runArgs"] = runArgs
+		}
+	}
+
 	envVars["DAYTONA_PROJECT_DIR"] = workspaceFolder
 
 	devcontainerConfig["containerEnv"] = envVars
@@ -288,6 +306,13 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", opts.BuildConfig.CachedBuild.Image)))
 	}
 
+	// The image is built with inline cache metadata so that it can be
+	// tagged as the layer cache image for the next build
+	if opts.LayerCacheImage != "" {
+		d.pullLayerCacheImage(opts.LayerCacheImage, opts.ContainerRegistry, opts.LogWriter)
+		devcontainerCmd = append(devcontainerCmd, "--cache-from", opts.LayerCacheImage, "--cache-to", "type=inline")
+	}
+
 	if opts.Prebuild {
 		devcontainerCmd = append(devcontainerCmd, "--prebuild")
 	}
@@ -542,6 +567,7 @@ func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontai
 		Cmd:        append([]string{"-c"}, cmd),
 		Tty:        true,
 		WorkingDir: workdir,
+		Labels:     opts.BuilderLabels,
 	}, &container.HostConfig{
 		Privileged:  true,
 		NetworkMode: container.NetworkMode(fmt.Sprintf("container:%s", socketForwardId)),
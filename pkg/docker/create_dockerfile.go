@@ -0,0 +1,231 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/docker/docker/api/types/mount"
+)
+
+// Options for building project images from Dockerfiles and from Nix files
+type BuildDockerfileOptions struct {
+	ProjectDir string
+	// Name and tag of the resulting image
+	ImageName                string
+	BuildConfig              *buildconfig.BuildConfig
+	LogWriter                io.Writer
+	SshClient                *ssh.Client
+	ContainerRegistry        *containerregistry.ContainerRegistry
+	BuilderLabels            map[string]string
+	BuilderImage             string
+	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// Image in the builder registry that image layers are cached to and imported from
+	LayerCacheImage string
+}
+
+// Builds the image with the docker CLI inside of the builder container so that
+// Dockerfiles can be built on remote docker hosts the same way devcontainers are.
+// The returned remote user is the user set in the image, defaulting to root.
+func (d *DockerClient) BuildFromDockerfile(opts BuildDockerfileOptions) (RemoteUser, error) {
+	if opts.BuildConfig == nil || opts.BuildConfig.Dockerfile == nil {
+		return "", errors.New("dockerfile config is not set")
+	}
+
+	dockerfileConfig := opts.BuildConfig.Dockerfile
+
+	// Ensure that the Dockerfile exists
+	if opts.SshClient != nil {
+		_, err := opts.SshClient.ReadFile(path.Join(opts.ProjectDir, dockerfileConfig.Path))
+		if err != nil {
+			return "", err
+		}
+	} else {
+		_, err := os.Stat(filepath.Join(opts.ProjectDir, dockerfileConfig.Path))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	paths := d.getDevcontainerPaths(opts.ProjectDir, dockerfileConfig.Path)
+
+	buildContext := dockerfileConfig.Context
+	if buildContext == "" {
+		buildContext = "."
+	}
+
+	cacheFrom := []string{}
+	if opts.BuildConfig.CachedBuild != nil {
+		err := d.PullImage(opts.BuildConfig.CachedBuild.Image, opts.ContainerRegistry, opts.LogWriter)
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error pulling cached build image: %v. Continuing without cache.\n", err)))
+		}
+
+		cacheFrom = append(cacheFrom, opts.BuildConfig.CachedBuild.Image)
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Using existing build cache from: %s\n", opts.BuildConfig.CachedBuild.Image)))
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Building image from %s...\n", dockerfileConfig.Path)))
+
+	return d.buildImage(&opts, paths, imageBuildArgs{
+		dockerfilePath: paths.TargetConfigFilePath,
+		contextPath:    path.Join(paths.ProjectTarget, buildContext),
+		target:         dockerfileConfig.Target,
+		buildArgs:      dockerfileConfig.BuildArgs,
+		cacheFrom:      cacheFrom,
+	})
+}
+
+type imageBuildArgs struct {
+	dockerfilePath string
+	contextPath    string
+	target         string
+	buildArgs      map[string]string
+	cacheFrom      []string
+	extraMounts    []mount.Mount
+}
+
+// Paths of the image build args are paths inside of the builder container
+func (d *DockerClient) buildImage(opts *BuildDockerfileOptions, paths DevcontainerPaths, args imageBuildArgs) (RemoteUser, error) {
+	socketForwardId, err := d.ensureDockerSockForward(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
+	if err != nil {
+		return "", err
+	}
+
+	dockerCmd := []string{
+		"docker",
+		"build",
+		"--progress=plain",
+		"--file", shellQuote(args.dockerfilePath),
+		"--tag", shellQuote(opts.ImageName),
+	}
+
+	if args.target != "" {
+		dockerCmd = append(dockerCmd, "--target", shellQuote(args.target))
+	}
+
+	buildArgNames := make([]string, 0, len(args.buildArgs))
+	for name := range args.buildArgs {
+		buildArgNames = append(buildArgNames, name)
+	}
+	sort.Strings(buildArgNames)
+
+	for _, name := range buildArgNames {
+		dockerCmd = append(dockerCmd, "--build-arg", shellQuote(fmt.Sprintf("%s=%s", name, args.buildArgs[name])))
+	}
+
+	for _, cacheFrom := range args.cacheFrom {
+		dockerCmd = append(dockerCmd, "--cache-from", shellQuote(cacheFrom))
+	}
+
+	// The layer cache image is tagged with inline cache metadata so that
+	// the next build of the project config can import its layers
+	if opts.LayerCacheImage != "" {
+		d.pullLayerCacheImage(opts.LayerCacheImage, opts.ContainerRegistry, opts.LogWriter)
+
+		dockerCmd = append(dockerCmd,
+			"--cache-from", shellQuote(opts.LayerCacheImage),
+			"--build-arg", "BUILDKIT_INLINE_CACHE=1",
+			"--tag", shellQuote(opts.LayerCacheImage),
+		)
+	}
+
+	dockerCmd = append(dockerCmd, shellQuote(args.contextPath))
+
+	_, err = d.execDevcontainerCommand(strings.Join(dockerCmd, " "), &CreateDevcontainerOptions{
+		ProjectDir:    opts.ProjectDir,
+		LogWriter:     opts.LogWriter,
+		BuilderImage:  opts.BuilderImage,
+		BuilderLabels: opts.BuilderLabels,
+	}, paths, paths.ProjectTarget, socketForwardId, true, args.extraMounts)
+	if err != nil {
+		return "", err
+	}
+
+	imageInfo, _, err := d.apiClient.ImageInspectWithRaw(context.Background(), opts.ImageName)
+	if err != nil {
+		return "", err
+	}
+
+	if imageInfo.Config == nil || imageInfo.Config.User == "" {
+		return RemoteUser("root"), nil
+	}
+
+	return RemoteUser(imageInfo.Config.User), nil
+}
+
+// Dockerfile and Nix projects are built into a local image and then created the same way as image projects.
+// The project directory is mounted to /workspaces/<project-name> since the home directory of the
+// image user is unknown.
+func (d *DockerClient) createProjectFromBuiltImage(opts *CreateProjectOptions, pulledImages map[string]bool, buildImage func(BuildDockerfileOptions) (RemoteUser, error)) error {
+	imageName := d.GetProjectImageName(opts.Project)
+
+	remoteUser, err := buildImage(BuildDockerfileOptions{
+		ProjectDir:               opts.ProjectDir,
+		ImageName:                imageName,
+		BuildConfig:              opts.Project.BuildConfig,
+		LogWriter:                opts.LogWriter,
+		SshClient:                opts.SshClient,
+		ContainerRegistry:        opts.ContainerRegistry,
+		BuilderImage:             opts.BuilderImage,
+		BuilderContainerRegistry: opts.BuilderContainerRegistry,
+	})
+	if err != nil {
+		return err
+	}
+
+	p := *opts.Project
+	p.Image = imageName
+	p.User = string(remoteUser)
+	p.EnvVars = map[string]string{}
+	for k, v := range opts.Project.EnvVars {
+		p.EnvVars[k] = v
+	}
+	p.EnvVars["DAYTONA_PROJECT_DIR"] = fmt.Sprintf("/workspaces/%s", p.Name)
+
+	projectOpts := *opts
+	projectOpts.Project = &p
+
+	pulledImages[imageName] = true
+
+	return d.createProjectFromImage(&projectOpts, pulledImages, true)
+}
+
+func (d *DockerClient) startBuiltImageProject(opts *CreateProjectOptions) (RemoteUser, error) {
+	err := d.startImageProject(opts)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := d.apiClient.ContainerInspect(context.Background(), d.GetProjectContainerName(opts.Project))
+	if err != nil {
+		return "", err
+	}
+
+	if c.Config == nil || c.Config.User == "" {
+		return RemoteUser("root"), nil
+	}
+
+	return RemoteUser(c.Config.User), nil
+}
+
+func (d *DockerClient) GetProjectImageName(project *project.Project) string {
+	return strings.ToLower(fmt.Sprintf("daytona-%s-%s", project.WorkspaceId, project.Name))
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -41,7 +41,7 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 		mounts = append(mounts, mount.Mount{
 			Type:   mount.TypeBind,
 			Source: opts.ProjectDir,
-			Target: fmt.Sprintf("/home/%s/%s", opts.Project.User, opts.Project.Name),
+			Target: getProjectDirTarget(opts.Project),
 		})
 	}
 
@@ -64,14 +64,18 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 		}
 	}
 
-	c, err := d.apiClient.ContainerCreate(ctx, GetContainerCreateConfig(opts.Project, availablePort), &container.HostConfig{
+	hostConfig := &container.HostConfig{
 		Privileged: true,
 		Mounts:     mounts,
 		ExtraHosts: []string{
 			"host.docker.internal:host-gateway",
 		},
 		PortBindings: portBindings,
-	}, nil, nil, d.GetProjectContainerName(opts.Project))
+	}
+
+	setContainerResources(hostConfig, opts.Project.Resources)
+
+	c, err := d.apiClient.ContainerCreate(ctx, GetContainerCreateConfig(opts.Project, availablePort), hostConfig, nil, nil, d.GetProjectContainerName(opts.Project))
 	if err != nil {
 		return err
 	}
@@ -106,6 +110,14 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 	return nil
 }
 
+// The agent uses DAYTONA_PROJECT_DIR as the project directory when it is set
+func getProjectDirTarget(p *project.Project) string {
+	if projectDir, ok := p.EnvVars["DAYTONA_PROJECT_DIR"]; ok {
+		return projectDir
+	}
+	return fmt.Sprintf("/home/%s/%s", p.User, p.Name)
+}
+
 func GetContainerCreateConfig(project *project.Project, toolboxApiHostPort *uint16) *container.Config {
 	envVars := []string{}
 
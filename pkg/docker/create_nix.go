@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/docker/docker/api/types/mount"
+)
+
+// The Nix image is pinned so that the Nix store cache of previous builds matches the base image
+const defaultNixImage = "nixos/nix:2.24.9"
+
+// OCI annotation of the image that a project image is built on top of
+const BaseImageLabel = "org.opencontainers.image.base.name"
+
+// The environment of the realized dev shell is sourced by bash so that the toolchain is on the PATH
+const nixDevEnvPath = "/etc/daytona/nix-dev-env.sh"
+
+// Builds a project image with the dev shell of the flake.nix or shell.nix file realized.
+// The image is built from a generated Dockerfile on top of the Nix image. The Nix store is kept
+// in a BuildKit cache mount so that only the store paths that are missing from previous builds
+// are built or fetched.
+func (d *DockerClient) BuildFromNix(opts BuildDockerfileOptions) (RemoteUser, error) {
+	if opts.BuildConfig == nil || opts.BuildConfig.Nix == nil {
+		return "", errors.New("nix config is not set")
+	}
+
+	nixConfig := opts.BuildConfig.Nix
+
+	// Ensure that the Nix file exists
+	if opts.SshClient != nil {
+		_, err := opts.SshClient.ReadFile(path.Join(opts.ProjectDir, nixConfig.FilePath))
+		if err != nil {
+			return "", err
+		}
+	} else {
+		_, err := os.Stat(filepath.Join(opts.ProjectDir, nixConfig.FilePath))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	paths := d.getDevcontainerPaths(opts.ProjectDir, nixConfig.FilePath)
+
+	dockerfile := getNixDockerfile(nixConfig)
+
+	if opts.SshClient != nil {
+		err := opts.SshClient.Exec(fmt.Sprintf("mkdir -p %s", paths.OverridesDir), opts.LogWriter)
+		if err != nil {
+			return "", err
+		}
+		res, err := opts.SshClient.WriteFile(dockerfile, path.Join(paths.OverridesDir, "nix.Dockerfile"))
+		if err != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Error writing Nix Dockerfile: %s\n", string(res))))
+			return "", err
+		}
+	} else {
+		err := os.MkdirAll(paths.OverridesDir, 0755)
+		if err != nil {
+			return "", err
+		}
+		err = os.WriteFile(filepath.Join(paths.OverridesDir, "nix.Dockerfile"), []byte(dockerfile), 0644)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Realizing the Nix dev shell from %s...\n", nixConfig.FilePath)))
+
+	return d.buildImage(&opts, paths, imageBuildArgs{
+		dockerfilePath: path.Join(paths.OverridesTarget, "nix.Dockerfile"),
+		contextPath:    paths.ProjectTarget,
+		extraMounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: paths.OverridesDir,
+				Target: paths.OverridesTarget,
+			},
+		},
+	})
+}
+
+func getNixDockerfile(nixConfig *buildconfig.NixConfig) string {
+	projectMountDir := "/tmp/daytona-nix-project"
+	closureDir := "/tmp/daytona-nix-closure"
+
+	printDevEnvCmd := fmt.Sprintf("nix print-dev-env --file %s", shellQuote(path.Join(projectMountDir, nixConfig.FilePath)))
+	if path.Base(nixConfig.FilePath) == "flake.nix" {
+		installable := path.Join(projectMountDir, path.Dir(nixConfig.FilePath))
+		if nixConfig.DevShell != "" {
+			installable = fmt.Sprintf("%s#%s", installable, nixConfig.DevShell)
+		}
+		printDevEnvCmd = fmt.Sprintf("nix print-dev-env %s", shellQuote(installable))
+	}
+
+	// The dev shell is realized in a cache mount so that the Nix store is reused between builds.
+	// The mount is seeded with the store of the base image and the closure of the store paths
+	// referenced by the dev shell environment is copied into the image afterwards.
+	realizeCmd := strings.Join([]string{
+		fmt.Sprintf("%s > %s", printDevEnvCmd, nixDevEnvPath),
+		fmt.Sprintf("mkdir -p %s", closureDir),
+		fmt.Sprintf(`grep -oE '/nix/store/[a-z0-9]{32}-[A-Za-z0-9+._?=-]+' %s | sort -u | while read -r p; do [ -e "$p" ] && echo "$p"; done | xargs -r nix-store --query --requisites | xargs -r -I{} cp -a {} %s/`, nixDevEnvPath, closureDir),
+	}, " && ")
+
+	// The repository is bind mounted instead of copied so that the Nix files can import any other file of the
+	// repository without the repository ending up in a layer of the image. The mount is writable so that a
+	// missing flake.lock can be created, the writes are discarded after the build step.
+	mounts := []string{
+		fmt.Sprintf("--mount=type=cache,id=daytona-nix-store,target=/nix,from=%s,source=/nix,sharing=locked", defaultNixImage),
+		fmt.Sprintf("--mount=type=bind,target=%s,rw", projectMountDir),
+	}
+
+	lines := []string{
+		"# syntax=docker/dockerfile:1",
+		fmt.Sprintf("FROM %s", defaultNixImage),
+		fmt.Sprintf("LABEL %s=%s", BaseImageLabel, defaultNixImage),
+		fmt.Sprintf("RUN mkdir -p /etc/nix %s && (grep -qs flakes /etc/nix/nix.conf || echo 'experimental-features = nix-command flakes' >> /etc/nix/nix.conf)", path.Dir(nixDevEnvPath)),
+		// Flakes in a Git repository are read through Git which refuses repositories owned by another user
+		"RUN git config --global --add safe.directory '*'",
+		fmt.Sprintf("RUN %s %s", strings.Join(mounts, " "), realizeCmd),
+		fmt.Sprintf("RUN cp -an %s/. /nix/store/ && rm -rf %s", closureDir, closureDir),
+		fmt.Sprintf("RUN grep -qs %s /root/.bashrc || echo '. %s' >> /root/.bashrc", nixDevEnvPath, nixDevEnvPath),
+		fmt.Sprintf("ENV BASH_ENV=%s", nixDevEnvPath),
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
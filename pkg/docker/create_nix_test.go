@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNixDockerfile(t *testing.T) {
+	t.Run("Flake", func(t *testing.T) {
+		dockerfile := getNixDockerfile(&buildconfig.NixConfig{FilePath: "nix/flake.nix", DevShell: "ci"})
+
+		require.Contains(t, dockerfile, "FROM "+defaultNixImage+"\n")
+		require.Contains(t, dockerfile, "RUN --mount=type=cache,id=daytona-nix-store,target=/nix,")
+		require.Contains(t, dockerfile, " --mount=type=bind,target=/tmp/daytona-nix-project,rw ")
+		require.Contains(t, dockerfile, "nix print-dev-env '/tmp/daytona-nix-project/nix#ci'")
+		require.NotContains(t, dockerfile, "COPY . ")
+	})
+
+	t.Run("Shell", func(t *testing.T) {
+		dockerfile := getNixDockerfile(&buildconfig.NixConfig{FilePath: "shell.nix"})
+
+		require.NotContains(t, dockerfile, "COPY ")
+		require.Contains(t, dockerfile, "nix print-dev-env --file '/tmp/daytona-nix-project/shell.nix'")
+		require.True(t, strings.HasPrefix(dockerfile, "# syntax=docker/dockerfile:1\n"))
+	})
+}
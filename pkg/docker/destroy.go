@@ -12,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 )
 
@@ -29,6 +30,13 @@ func (d *DockerClient) DestroyProject(project *project.Project, projectDir strin
 		return err
 	}
 
+	if project.BuildConfig != nil && (project.BuildConfig.Dockerfile != nil || project.BuildConfig.Nix != nil) {
+		err = d.DeleteImage(d.GetProjectImageName(project), true, nil)
+		if err != nil && !client.IsErrNotFound(err) {
+			return err
+		}
+	}
+
 	if sshClient == nil {
 		return os.RemoveAll(projectDir)
 	} else {
@@ -82,6 +90,26 @@ func (d *DockerClient) removeProjectContainer(p *project.Project) error {
 	return nil
 }
 
+// Removes all containers with the label, e.g. "daytona.build.id=<id>"
+func (d *DockerClient) RemoveContainersByLabel(label string) error {
+	containers, err := d.apiClient.ContainerList(context.Background(), container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", label)),
+		All:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range containers {
+		err = d.RemoveContainer(c.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (d *DockerClient) RemoveContainer(containerName string) error {
 	ctx := context.Background()
 
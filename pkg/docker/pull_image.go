@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 
@@ -92,3 +93,15 @@ func getRegistryAuth(cr *containerregistry.ContainerRegistry) string {
 
 	return base64.URLEncoding.EncodeToString(encodedJSON)
 }
+
+// The layer cache image does not exist before the first build of a project config
+// so pull errors only disable the layer cache import
+func (d *DockerClient) pullLayerCacheImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) {
+	err := d.PullImage(imageName, cr, logWriter)
+	if err != nil {
+		logWriter.Write([]byte("Layer cache not found. Continuing without layer cache.\n"))
+		return
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Using layer cache from: %s\n", imageName)))
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
+	"github.com/docker/docker/api/types/container"
+)
+
+// Sets the resource limits of the project on the container host config.
+// Disk quotas are only supported by storage drivers with quota support, e.g. overlay2 on xfs with pquota.
+func setContainerResources(hostConfig *container.HostConfig, r *resources.Resources) {
+	if r.IsEmpty() {
+		return
+	}
+
+	if r.Cpus > 0 {
+		hostConfig.NanoCPUs = int64(r.Cpus * 1e9)
+	}
+
+	if r.Memory > 0 {
+		hostConfig.Memory = int64(r.Memory) * 1024 * 1024
+	}
+
+	if r.PidsLimit > 0 {
+		pidsLimit := int64(r.PidsLimit)
+		hostConfig.PidsLimit = &pidsLimit
+	}
+
+	if r.Disk > 0 {
+		if hostConfig.StorageOpt == nil {
+			hostConfig.StorageOpt = map[string]string{}
+		}
+		hostConfig.StorageOpt["size"] = fmt.Sprintf("%dG", r.Disk)
+	}
+}
+
+// Returns the docker run arguments that set the resource limits of the project
+func getResourceRunArgs(r *resources.Resources) []string {
+	runArgs := []string{}
+	if r.IsEmpty() {
+		return runArgs
+	}
+
+	if r.Cpus > 0 {
+		runArgs = append(runArgs, fmt.Sprintf("--cpus=%g", r.Cpus))
+	}
+
+	if r.Memory > 0 {
+		runArgs = append(runArgs, fmt.Sprintf("--memory=%dm", r.Memory))
+	}
+
+	if r.PidsLimit > 0 {
+		runArgs = append(runArgs, fmt.Sprintf("--pids-limit=%d", r.PidsLimit))
+	}
+
+	if r.Disk > 0 {
+		runArgs = append(runArgs, fmt.Sprintf("--storage-opt=size=%dG", r.Disk))
+	}
+
+	return runArgs
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetContainerResources(t *testing.T) {
+	hostConfig := &container.HostConfig{}
+	setContainerResources(hostConfig, nil)
+	require.Equal(t, &container.HostConfig{}, hostConfig)
+
+	setContainerResources(hostConfig, &resources.Resources{
+		Cpus:      1.5,
+		Memory:    2048,
+		Disk:      10,
+		PidsLimit: 512,
+	})
+
+	pidsLimit := int64(512)
+	require.Equal(t, &container.HostConfig{
+		Resources: container.Resources{
+			NanoCPUs:  1500000000,
+			Memory:    2048 * 1024 * 1024,
+			PidsLimit: &pidsLimit,
+		},
+		StorageOpt: map[string]string{"size": "10G"},
+	}, hostConfig)
+}
+
+func TestGetResourceRunArgs(t *testing.T) {
+	require.Empty(t, getResourceRunArgs(nil))
+
+	require.Equal(t, []string{"--cpus=0.5", "--memory=512m", "--pids-limit=100", "--storage-opt=size=5G"}, getResourceRunArgs(&resources.Resources{
+		Cpus:      0.5,
+		Memory:    512,
+		Disk:      5,
+		PidsLimit: 100,
+	}))
+}
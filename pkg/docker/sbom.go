@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/google/uuid"
+)
+
+type GenerateSbomOptions struct {
+	// Name of the pushed image the SBOM is generated for
+	ImageName string
+	// Output format of the generator, e.g. spdx-json or cyclonedx-json
+	Format            string
+	GeneratorImage    string
+	ContainerRegistry *containerregistry.ContainerRegistry
+	Labels            map[string]string
+	LogWriter         io.Writer
+}
+
+// Generates the SBOM with a syft container that reads the image filesystem from the registry
+// so that the docker socket does not have to be shared with the generator.
+func (d *DockerClient) GenerateSbom(opts GenerateSbomOptions) (string, error) {
+	ctx := context.Background()
+
+	err := d.PullImage(opts.GeneratorImage, nil, opts.LogWriter)
+	if err != nil {
+		return "", err
+	}
+
+	env := []string{}
+	if opts.ContainerRegistry != nil && opts.ContainerRegistry.Username != "" {
+		authority, err := containerregistry.GetServerHostname(opts.ContainerRegistry.Server)
+		if err != nil {
+			return "", err
+		}
+
+		env = append(env,
+			fmt.Sprintf("SYFT_REGISTRY_AUTH_AUTHORITY=%s", authority),
+			fmt.Sprintf("SYFT_REGISTRY_AUTH_USERNAME=%s", opts.ContainerRegistry.Username),
+			fmt.Sprintf("SYFT_REGISTRY_AUTH_PASSWORD=%s", opts.ContainerRegistry.Password),
+		)
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:  opts.GeneratorImage,
+		Cmd:    []string{fmt.Sprintf("registry:%s", opts.ImageName), "--output", opts.Format, "--quiet"},
+		Env:    env,
+		Labels: opts.Labels,
+	}, nil, nil, nil, uuid.NewString())
+	if err != nil {
+		return "", err
+	}
+
+	defer d.RemoveContainer(c.ID) // nolint:errcheck
+
+	waitResponse, errChan := d.apiClient.ContainerWait(ctx, c.ID, container.WaitConditionNextExit)
+
+	err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	var exitCode int64
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return "", err
+		}
+	case resp := <-waitResponse:
+		exitCode = resp.StatusCode
+	}
+
+	logs, err := d.apiClient.ContainerLogs(ctx, c.ID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer logs.Close()
+
+	var stdout, stderr bytes.Buffer
+	_, err = stdcopy.StdCopy(&stdout, &stderr, logs)
+	if err != nil {
+		return "", err
+	}
+
+	if exitCode != 0 {
+		return "", fmt.Errorf("failed to generate SBOM: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
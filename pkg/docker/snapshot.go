@@ -0,0 +1,361 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+// Project files live in a bind mount or a volume which are not part of a container commit,
+// so they are stored in the snapshot image under this directory
+const snapshotDataDir = ".daytona-snapshot"
+
+// The current project files are moved to this directory inside the project directory during a restore
+// and are only deleted once the snapshot files have been copied back
+const preRestoreDir = ".daytona-pre-restore"
+
+func GetProjectSnapshotImageRepository(p *project.Project) string {
+	return strings.ToLower(fmt.Sprintf("daytona-snapshot-%s-%s", p.WorkspaceId, p.Name))
+}
+
+func GetProjectSnapshotImageName(p *project.Project, snapshotName string) string {
+	return fmt.Sprintf("%s:%s", GetProjectSnapshotImageRepository(p), snapshotName)
+}
+
+func (d *DockerClient) SnapshotProject(p *project.Project, snapshotName string, logWriter io.Writer) error {
+	ctx := context.Background()
+	containerName := d.GetProjectContainerName(p)
+	imageName := GetProjectSnapshotImageName(p, snapshotName)
+
+	c, err := d.apiClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Committing container %s\n", containerName)))
+	}
+
+	_, err = d.apiClient.ContainerCommit(ctx, containerName, container.CommitOptions{
+		Reference: imageName,
+		Pause:     c.State != nil && c.State.Running,
+	})
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Saving project files\n"))
+	}
+
+	projectDir := getProjectDir(c, p)
+
+	content, _, err := d.apiClient.CopyFromContainer(ctx, containerName, projectDir)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	tmpContainer, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image: imageName,
+	}, nil, nil, nil, "")
+	if err != nil {
+		return err
+	}
+	defer d.RemoveContainer(tmpContainer.ID) // nolint:errcheck
+
+	err = d.apiClient.CopyToContainer(ctx, tmpContainer.ID, "/", prefixTarEntries(content, snapshotDataDir), container.CopyToContainerOptions{})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.apiClient.ContainerCommit(ctx, tmpContainer.ID, container.CommitOptions{
+		Reference: imageName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Snapshot %s created\n", snapshotName)))
+	}
+
+	return nil
+}
+
+// RestoreProject recreates the project container from a snapshot image and restores the project files.
+// The container is left stopped and should be started afterwards.
+func (d *DockerClient) RestoreProject(p *project.Project, snapshotName string, logWriter io.Writer) error {
+	return d.restoreProject(p, p, snapshotName, logWriter)
+}
+
+// CloneProject recreates the project container from a snapshot of the source project.
+// The container is left stopped and should be started afterwards.
+func (d *DockerClient) CloneProject(source *project.Project, p *project.Project, snapshotName string, logWriter io.Writer) error {
+	return d.restoreProject(source, p, snapshotName, logWriter)
+}
+
+func (d *DockerClient) restoreProject(source *project.Project, p *project.Project, snapshotName string, logWriter io.Writer) (err error) {
+	ctx := context.Background()
+	containerName := d.GetProjectContainerName(p)
+	imageName := GetProjectSnapshotImageName(source, snapshotName)
+
+	images, err := d.apiClient.ImageList(ctx, image.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("reference", imageName)),
+	})
+	if err != nil {
+		return err
+	}
+	if len(images) == 0 {
+		return fmt.Errorf("snapshot %s not found", snapshotName)
+	}
+
+	c, err := d.apiClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+
+	if c.State != nil && c.State.Running {
+		err = d.stopProjectContainer(p, logWriter)
+		if err != nil {
+			return err
+		}
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Recreating container %s from snapshot %s\n", containerName, snapshotName)))
+	}
+
+	// The original container is kept under a different name until the restore succeeds
+	// so that it can be put back if the restore fails
+	name := strings.TrimPrefix(c.Name, "/")
+	err = d.apiClient.ContainerRename(ctx, c.ID, name+"-pre-restore")
+	if err != nil {
+		return err
+	}
+
+	config := c.Config
+	config.Image = imageName
+
+	endpoints := map[string]*network.EndpointSettings{}
+	if c.NetworkSettings != nil {
+		for name, endpoint := range c.NetworkSettings.Networks {
+			endpoints[name] = &network.EndpointSettings{
+				NetworkID: endpoint.NetworkID,
+				Aliases:   endpoint.Aliases,
+			}
+		}
+	}
+
+	restored, err := d.apiClient.ContainerCreate(ctx, config, c.HostConfig, &network.NetworkingConfig{
+		EndpointsConfig: endpoints,
+	}, nil, name)
+	if err != nil {
+		return errors.Join(err, d.apiClient.ContainerRename(ctx, c.ID, name))
+	}
+
+	projectDir := getProjectDir(c, p)
+	movedProjectFiles := false
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if logWriter != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Restore failed, putting back container %s\n", containerName)))
+		}
+
+		var rollbackErr error
+		if movedProjectFiles {
+			rollbackErr = d.putBackProjectFiles(containerName, projectDir, logWriter)
+		}
+		if rollbackErr == nil {
+			rollbackErr = d.apiClient.ContainerRemove(ctx, restored.ID, container.RemoveOptions{Force: true})
+		}
+		if rollbackErr == nil {
+			rollbackErr = d.apiClient.ContainerRename(ctx, c.ID, name)
+		}
+		err = errors.Join(err, rollbackErr)
+	}()
+
+	err = d.apiClient.ContainerStart(ctx, containerName, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Restoring project files\n"))
+	}
+
+	// The project directory is the mount point so its content is moved aside instead of the directory itself.
+	// A leftover from an earlier restore whose cleanup failed is replaced.
+	err = d.execAsRoot(containerName, fmt.Sprintf("rm -rf '%[1]s/%[2]s' && mkdir '%[1]s/%[2]s'", projectDir, preRestoreDir), logWriter)
+	if err != nil {
+		return err
+	}
+
+	movedProjectFiles = true
+	err = d.execAsRoot(containerName, fmt.Sprintf("find '%[1]s' -mindepth 1 -maxdepth 1 ! -name '%[2]s' -exec mv {} '%[1]s/%[2]s/' \\;", projectDir, preRestoreDir), logWriter)
+	if err != nil {
+		return err
+	}
+
+	content, _, err := d.apiClient.CopyFromContainer(ctx, containerName, path.Join("/", snapshotDataDir, path.Base(getProjectDir(c, source))))
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	err = d.apiClient.CopyToContainer(ctx, containerName, path.Dir(projectDir), content, container.CopyToContainerOptions{})
+	if err != nil {
+		return err
+	}
+
+	// The snapshot files are in place so the previous project files are no longer put back.
+	// The restore is not rolled back if only they cannot be deleted.
+	movedProjectFiles = false
+	deleteErr := d.execAsRoot(containerName, fmt.Sprintf("rm -rf '%s/%s'", projectDir, preRestoreDir), logWriter)
+	if deleteErr != nil && logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to delete the previous project files: %s\n", deleteErr)))
+	}
+
+	err = d.stopProjectContainer(p, logWriter)
+	if err != nil {
+		return err
+	}
+
+	// The restore is not rolled back if only the original container cannot be removed
+	removeErr := d.apiClient.ContainerRemove(ctx, c.ID, container.RemoveOptions{})
+	if removeErr != nil && logWriter != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to remove the original container: %s\n", removeErr)))
+	}
+
+	return nil
+}
+
+// putBackProjectFiles replaces the partially restored project files with the ones moved aside before the restore
+func (d *DockerClient) putBackProjectFiles(containerName, projectDir string, logWriter io.Writer) error {
+	return d.execAsRoot(containerName, fmt.Sprintf("find '%[1]s' -mindepth 1 -maxdepth 1 ! -name '%[2]s' -exec rm -rf {} + && find '%[1]s/%[2]s' -mindepth 1 -maxdepth 1 -exec mv {} '%[1]s/' \\; && rmdir '%[1]s/%[2]s'", projectDir, preRestoreDir), logWriter)
+}
+
+func (d *DockerClient) execAsRoot(containerName, command string, logWriter io.Writer) error {
+	result, err := d.ExecSync(containerName, container.ExecOptions{
+		Cmd:          []string{"sh", "-c", command},
+		AttachStdout: true,
+		AttachStderr: true,
+		User:         "root",
+	}, logWriter)
+	if err != nil {
+		return err
+	}
+	if result.ExitCode != 0 {
+		return errors.New(result.StdErr)
+	}
+
+	return nil
+}
+
+// DeleteProjectSnapshot removes the snapshot image. Snapshots that no longer exist are ignored.
+func (d *DockerClient) DeleteProjectSnapshot(p *project.Project, snapshotName string) error {
+	_, err := d.apiClient.ImageRemove(context.Background(), GetProjectSnapshotImageName(p, snapshotName), image.RemoveOptions{
+		// Containers restored or cloned from the snapshot keep using the image, so it is only untagged for them
+		Force: true,
+	})
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DockerClient) ListProjectSnapshots(p *project.Project) ([]string, error) {
+	repository := GetProjectSnapshotImageRepository(p)
+
+	images, err := d.apiClient.ImageList(context.Background(), image.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("reference", repository)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []string{}
+	for _, image := range images {
+		for _, tag := range image.RepoTags {
+			if strings.HasPrefix(tag, repository+":") {
+				snapshots = append(snapshots, strings.TrimPrefix(tag, repository+":"))
+			}
+		}
+	}
+
+	return snapshots, nil
+}
+
+// getProjectDir returns the path of the project directory inside the container
+func getProjectDir(c types.ContainerJSON, p *project.Project) string {
+	for _, m := range c.Mounts {
+		if filepath.Base(m.Destination) == p.Name {
+			return m.Destination
+		}
+	}
+
+	return fmt.Sprintf("/home/%s/%s", p.User, p.Name)
+}
+
+// prefixTarEntries streams the tar archive with every entry moved under the given directory
+func prefixTarEntries(content io.Reader, prefix string) io.Reader {
+	r, w := io.Pipe()
+
+	go func() {
+		tr := tar.NewReader(content)
+		tw := tar.NewWriter(w)
+
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				w.CloseWithError(err)
+				return
+			}
+
+			header.Name = path.Join(prefix, header.Name)
+			if header.Typeflag == tar.TypeLink {
+				header.Linkname = path.Join(prefix, header.Linkname)
+			}
+
+			err = tw.WriteHeader(header)
+			if err != nil {
+				w.CloseWithError(err)
+				return
+			}
+
+			_, err = io.Copy(tw, tr)
+			if err != nil {
+				w.CloseWithError(err)
+				return
+			}
+		}
+
+		w.CloseWithError(tw.Close())
+	}()
+
+	return r
+}
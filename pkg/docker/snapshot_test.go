@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types/image"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestListProjectSnapshots() {
+	repository := docker.GetProjectSnapshotImageRepository(project1)
+
+	s.mockClient.On("ImageList", mock.Anything, mock.Anything).Return([]image.Summary{
+		{
+			RepoTags: []string{repository + ":before-migration"},
+		},
+		{
+			RepoTags: []string{repository + ":nightly", "other-image:latest"},
+		},
+	}, nil)
+
+	snapshots, err := s.dockerClient.ListProjectSnapshots(project1)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), []string{"before-migration", "nightly"}, snapshots)
+}
+
+func (s *DockerClientTestSuite) TestDeleteProjectSnapshot() {
+	imageName := docker.GetProjectSnapshotImageName(project1, "nightly")
+
+	s.mockClient.On("ImageRemove", mock.Anything, imageName, image.RemoveOptions{Force: true}).Return([]image.DeleteResponse{}, nil)
+
+	err := s.dockerClient.DeleteProjectSnapshot(project1, "nightly")
+	require.Nil(s.T(), err)
+	s.mockClient.AssertCalled(s.T(), "ImageRemove", mock.Anything, imageName, image.RemoveOptions{Force: true})
+}
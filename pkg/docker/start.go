@@ -30,6 +30,10 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 		var remoteUser RemoteUser
 		remoteUser, err = d.startDevcontainerProject(opts)
 		containerUser = string(remoteUser)
+	case detect.BuilderTypeDockerfile, detect.BuilderTypeNix:
+		var remoteUser RemoteUser
+		remoteUser, err = d.startBuiltImageProject(opts)
+		containerUser = string(remoteUser)
 	case detect.BuilderTypeImage:
 		err = d.startImageProject(opts)
 	default:
@@ -0,0 +1,202 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Prefix of encrypted values. Values without the prefix are treated as plaintext stored before encryption was enabled
+const encryptedValuePrefix = "enc:v1:"
+
+const dataKeySize = 32
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// KeyEncrypter encrypts the data keys that encrypt the stored values (envelope encryption)
+type KeyEncrypter interface {
+	EncryptKey(key []byte) ([]byte, error)
+	DecryptKey(encryptedKey []byte) ([]byte, error)
+}
+
+// Encrypter encrypts values with AES-256-GCM using a data key that is stored next to each value,
+// encrypted by the key encrypter. A single data key is used per process so that the key encrypter
+// is called once when encrypting and once per distinct data key when decrypting.
+type Encrypter struct {
+	keyEncrypter KeyEncrypter
+
+	dataKey          []byte
+	encryptedDataKey string
+	decryptedKeys    map[string][]byte
+	mutex            sync.Mutex
+}
+
+func NewEncrypter(keyEncrypter KeyEncrypter) *Encrypter {
+	return &Encrypter{
+		keyEncrypter:  keyEncrypter,
+		decryptedKeys: map[string][]byte{},
+	}
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedValuePrefix)
+}
+
+// Encrypt returns the value in the format enc:v1:<encrypted data key>:<nonce and ciphertext>.
+// Empty values are returned as is.
+func (e *Encrypter) Encrypt(value string) (string, error) {
+	if value == "" || IsEncrypted(value) {
+		return value, nil
+	}
+
+	dataKey, encryptedDataKey, err := e.getDataKey()
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext := gcm.Seal(nonce, nonce, []byte(value), nil)
+
+	return fmt.Sprintf("%s%s:%s", encryptedValuePrefix, encryptedDataKey, base64.StdEncoding.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt returns the plaintext of an encrypted value. Values that are not encrypted are returned as is.
+func (e *Encrypter) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	encryptedDataKey, encodedCiphertext, ok := strings.Cut(strings.TrimPrefix(value, encryptedValuePrefix), ":")
+	if !ok {
+		return "", ErrInvalidCiphertext
+	}
+
+	dataKey, err := e.decryptDataKey(encryptedDataKey)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encodedCiphertext)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCiphertext, err)
+	}
+
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCiphertext, err)
+	}
+
+	return string(plaintext), nil
+}
+
+// EncryptMap returns a copy of the map with encrypted values
+func (e *Encrypter) EncryptMap(values map[string]string) (map[string]string, error) {
+	return e.mapValues(values, e.Encrypt)
+}
+
+// DecryptMap returns a copy of the map with decrypted values
+func (e *Encrypter) DecryptMap(values map[string]string) (map[string]string, error) {
+	return e.mapValues(values, e.Decrypt)
+}
+
+func (e *Encrypter) mapValues(values map[string]string, fn func(string) (string, error)) (map[string]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]string, len(values))
+	for k, v := range values {
+		value, err := fn(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		result[k] = value
+	}
+
+	return result, nil
+}
+
+func (e *Encrypter) getDataKey() ([]byte, string, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.dataKey != nil {
+		return e.dataKey, e.encryptedDataKey, nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	encryptedDataKey, err := e.keyEncrypter.EncryptKey(dataKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encrypt data key: %w", err)
+	}
+
+	e.dataKey = dataKey
+	e.encryptedDataKey = base64.StdEncoding.EncodeToString(encryptedDataKey)
+	e.decryptedKeys[e.encryptedDataKey] = dataKey
+
+	return e.dataKey, e.encryptedDataKey, nil
+}
+
+func (e *Encrypter) decryptDataKey(encryptedDataKey string) ([]byte, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if dataKey, ok := e.decryptedKeys[encryptedDataKey]; ok {
+		return dataKey, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encryptedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCiphertext, err)
+	}
+
+	dataKey, err := e.keyEncrypter.DecryptKey(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+
+	e.decryptedKeys[encryptedDataKey] = dataKey
+
+	return dataKey, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
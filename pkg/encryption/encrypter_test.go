@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	keyEncrypter, err := GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "encryption.key"))
+	require.Nil(t, err)
+	e := NewEncrypter(keyEncrypter)
+
+	encrypted, err := e.Encrypt("secret-token")
+	require.Nil(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	require.NotContains(t, encrypted, "secret-token")
+
+	again, err := e.Encrypt("secret-token")
+	require.Nil(t, err)
+	require.NotEqual(t, encrypted, again)
+
+	decrypted, err := e.Decrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "secret-token", decrypted)
+
+	// Values are not encrypted twice
+	same, err := e.Encrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, encrypted, same)
+
+	empty, err := e.Encrypt("")
+	require.Nil(t, err)
+	require.Equal(t, "", empty)
+
+	plaintext, err := e.Decrypt("stored-before-encryption")
+	require.Nil(t, err)
+	require.Equal(t, "stored-before-encryption", plaintext)
+
+	_, err = e.Decrypt(encrypted[:len(encrypted)-4])
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+}
+
+func TestDecryptWithNewEncrypter(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "encryption.key")
+
+	_, err := NewFileKeyEncrypter(keyFile)
+	require.ErrorIs(t, err, ErrKeyFileNotFound)
+
+	keyEncrypter, err := GenerateFileKeyEncrypter(keyFile)
+	require.Nil(t, err)
+	encrypted, err := NewEncrypter(keyEncrypter).Encrypt("secret-token")
+	require.Nil(t, err)
+
+	info, err := os.Stat(keyFile)
+	require.Nil(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	keyEncrypter, err = NewFileKeyEncrypter(keyFile)
+	require.Nil(t, err)
+	decrypted, err := NewEncrypter(keyEncrypter).Decrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "secret-token", decrypted)
+
+	_, err = GenerateFileKeyEncrypter(keyFile)
+	require.NotNil(t, err)
+
+	otherKeyEncrypter, err := GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "other.key"))
+	require.Nil(t, err)
+	_, err = NewEncrypter(otherKeyEncrypter).Decrypt(encrypted)
+	require.NotNil(t, err)
+}
+
+func TestEncryptMap(t *testing.T) {
+	keyEncrypter, err := GenerateFileKeyEncrypter(filepath.Join(t.TempDir(), "encryption.key"))
+	require.Nil(t, err)
+	e := NewEncrypter(keyEncrypter)
+
+	envVars := map[string]string{"NPM_TOKEN": "token", "EMPTY": ""}
+
+	encrypted, err := e.EncryptMap(envVars)
+	require.Nil(t, err)
+	require.True(t, IsEncrypted(encrypted["NPM_TOKEN"]))
+	require.Equal(t, "token", envVars["NPM_TOKEN"])
+
+	decrypted, err := e.DecryptMap(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, envVars, decrypted)
+
+	nilMap, err := e.EncryptMap(nil)
+	require.Nil(t, err)
+	require.Nil(t, nilMap)
+}
+
+func TestCommandKeyEncrypter(t *testing.T) {
+	// The command returns its input, standing in for a KMS
+	script := filepath.Join(t.TempDir(), "kms.sh")
+	err := os.WriteFile(script, []byte("#!/bin/sh\ncat\n"), 0700)
+	require.Nil(t, err)
+
+	keyEncrypter, err := NewCommandKeyEncrypter(script)
+	require.Nil(t, err)
+	e := NewEncrypter(keyEncrypter)
+
+	encrypted, err := e.Encrypt("secret-token")
+	require.Nil(t, err)
+
+	decrypted, err := NewEncrypter(keyEncrypter).Decrypt(encrypted)
+	require.Nil(t, err)
+	require.Equal(t, "secret-token", decrypted)
+
+	failing, err := NewCommandKeyEncrypter("false")
+	require.Nil(t, err)
+	_, err = NewEncrypter(failing).Encrypt("secret-token")
+	require.True(t, err != nil && strings.Contains(err.Error(), "key command failed to encrypt"))
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// FileKeyEncrypter encrypts data keys with a key encryption key read from a file
+type FileKeyEncrypter struct {
+	key []byte
+}
+
+var ErrKeyFileNotFound = errors.New("key file not found")
+
+// NewFileKeyEncrypter reads the base64 encoded key from the file. Missing key files are never generated
+// here since data encrypted with a lost key can not be recovered, see GenerateFileKeyEncrypter
+func NewFileKeyEncrypter(path string) (*FileKeyEncrypter, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyFileNotFound, path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key file %s: %w", path, err)
+	}
+
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("key file %s must contain a %d byte key", path, dataKeySize)
+	}
+
+	return &FileKeyEncrypter{key: key}, nil
+}
+
+// GenerateFileKeyEncrypter writes a new random key to the file. It fails if the file already exists
+func GenerateFileKeyEncrypter(path string) (*FileKeyEncrypter, error) {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileKeyEncrypter{key: key}, nil
+}
+
+func (e *FileKeyEncrypter) EncryptKey(key []byte) ([]byte, error) {
+	gcm, err := newGCM(e.key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, key, nil), nil
+}
+
+func (e *FileKeyEncrypter) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	gcm, err := newGCM(e.key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encryptedKey) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return gcm.Open(nil, encryptedKey[:gcm.NonceSize()], encryptedKey[gcm.NonceSize():], nil)
+}
+
+// CommandKeyEncrypter delegates data key encryption to an external command, e.g. a wrapper around a KMS.
+// The command is called with an additional "encrypt" or "decrypt" argument, reads the base64 encoded
+// key from stdin and writes the base64 encoded result to stdout.
+type CommandKeyEncrypter struct {
+	command []string
+}
+
+func NewCommandKeyEncrypter(command string) (*CommandKeyEncrypter, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, errors.New("key command must not be empty")
+	}
+
+	return &CommandKeyEncrypter{command: fields}, nil
+}
+
+func (e *CommandKeyEncrypter) EncryptKey(key []byte) ([]byte, error) {
+	return e.run("encrypt", key)
+}
+
+func (e *CommandKeyEncrypter) DecryptKey(encryptedKey []byte) ([]byte, error) {
+	return e.run("decrypt", encryptedKey)
+}
+
+func (e *CommandKeyEncrypter) run(operation string, input []byte) ([]byte, error) {
+	args := append([]string{}, e.command[1:]...)
+	cmd := exec.Command(e.command[0], append(args, operation)...)
+	cmd.Stdin = strings.NewReader(base64.StdEncoding.EncodeToString(input))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("key command failed to %s: %w: %s", operation, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(stdout.String()))
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/docker/docker/pkg/stringid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Number of events kept in memory for clients that are not following the stream
+const recentEventsSize = 100
+
+// Events are dropped for subscribers that do not keep up instead of blocking the publisher
+const subscriberBufferSize = 64
+
+type IEventBus interface {
+	Publish(event Event)
+	// Subscribe returns a channel that receives all events published after subscribing
+	// and a function that must be called to unsubscribe
+	Subscribe() (<-chan Event, func())
+	Recent() []Event
+}
+
+type EventBus struct {
+	mutex       sync.RWMutex
+	subscribers map[chan Event]bool
+	recent      []Event
+}
+
+func NewEventBus() IEventBus {
+	return &EventBus{
+		subscribers: make(map[chan Event]bool),
+		recent:      []Event{},
+	}
+}
+
+func (b *EventBus) Publish(event Event) {
+	if event.Id == "" {
+		event.Id = stringid.TruncateID(stringid.GenerateRandomID())
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.recent = append(b.recent, event)
+	if len(b.recent) > recentEventsSize {
+		b.recent = b.recent[len(b.recent)-recentEventsSize:]
+	}
+
+	for subscriber := range b.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			log.Debugf("Dropping event %s for a slow subscriber", event.Id)
+		}
+	}
+}
+
+func (b *EventBus) Subscribe() (<-chan Event, func()) {
+	subscriber := make(chan Event, subscriberBufferSize)
+
+	b.mutex.Lock()
+	b.subscribers[subscriber] = true
+	b.mutex.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			delete(b.subscribers, subscriber)
+			b.mutex.Unlock()
+			close(subscriber)
+		})
+	}
+
+	return subscriber, unsubscribe
+}
+
+func (b *EventBus) Recent() []Event {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	recent := make([]Event, len(b.recent))
+	copy(recent, b.recent)
+
+	return recent
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventBus(t *testing.T) {
+	bus := events.NewEventBus()
+
+	t.Run("Subscribe", func(t *testing.T) {
+		ch, unsubscribe := bus.Subscribe()
+		defer unsubscribe()
+
+		bus.Publish(events.NewWorkspaceEvent(events.EventTypeWorkspaceStarted, "ws1", nil))
+
+		event := <-ch
+		require.Equal(t, events.EventTypeWorkspaceStarted, event.Type)
+		require.Equal(t, events.ResourceTypeWorkspace, event.ResourceType)
+		require.Equal(t, "ws1", event.ResourceId)
+		require.NotEmpty(t, event.Id)
+		require.False(t, event.Timestamp.IsZero())
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		ch, unsubscribe := bus.Subscribe()
+		unsubscribe()
+		unsubscribe()
+
+		bus.Publish(events.NewBuildEvent("build1", "running"))
+
+		_, ok := <-ch
+		require.False(t, ok)
+	})
+
+	t.Run("Error events", func(t *testing.T) {
+		event := events.NewProjectEvent(events.EventTypeProjectStarted, "ws1", "p1", "", errors.New("failed"))
+		require.Equal(t, events.EventTypeProjectError, event.Type)
+		require.Equal(t, "ws1/p1", event.ResourceId)
+		require.Equal(t, "failed", event.Error)
+	})
+
+	t.Run("Recent", func(t *testing.T) {
+		for i := 0; i < 150; i++ {
+			bus.Publish(events.NewBuildEvent("build2", "running"))
+		}
+
+		recent := bus.Recent()
+		require.Len(t, recent, 100)
+		require.Equal(t, "build2", recent[len(recent)-1].ResourceId)
+	})
+
+	t.Run("Slow subscriber does not block", func(t *testing.T) {
+		_, unsubscribe := bus.Subscribe()
+		defer unsubscribe()
+
+		for i := 0; i < 200; i++ {
+			bus.Publish(events.NewBuildEvent("build3", "running"))
+		}
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"time"
+)
+
+type ResourceType string
+
+const (
+	ResourceTypeWorkspace ResourceType = "workspace"
+	ResourceTypeProject   ResourceType = "project"
+	ResourceTypeBuild     ResourceType = "build"
+)
+
+var ResourceTypes = []ResourceType{ResourceTypeWorkspace, ResourceTypeProject, ResourceTypeBuild}
+
+type EventType string
+
+const (
+	EventTypeWorkspaceCreated    EventType = "workspace.created"
+	EventTypeWorkspaceStarted    EventType = "workspace.started"
+	EventTypeWorkspaceStopped    EventType = "workspace.stopped"
+	EventTypeWorkspaceRemoved    EventType = "workspace.removed"
+	EventTypeWorkspaceError      EventType = "workspace.error"
+	EventTypeProjectStarted      EventType = "project.started"
+	EventTypeProjectStopped      EventType = "project.stopped"
+	EventTypeProjectError        EventType = "project.error"
+	EventTypeProjectStateUpdated EventType = "project.state.updated"
+	EventTypeBuildStateChanged   EventType = "build.state.changed"
+	EventTypeBuildSuccess        EventType = "build.success"
+	EventTypeBuildError          EventType = "build.error"
+)
+
+var EventTypes = []EventType{
+	EventTypeWorkspaceCreated,
+	EventTypeWorkspaceStarted,
+	EventTypeWorkspaceStopped,
+	EventTypeWorkspaceRemoved,
+	EventTypeWorkspaceError,
+	EventTypeProjectStarted,
+	EventTypeProjectStopped,
+	EventTypeProjectError,
+	EventTypeProjectStateUpdated,
+	EventTypeBuildStateChanged,
+	EventTypeBuildSuccess,
+	EventTypeBuildError,
+}
+
+type Event struct {
+	Id           string       `json:"id" validate:"required"`
+	Type         EventType    `json:"type" validate:"required"`
+	ResourceType ResourceType `json:"resourceType" validate:"required"`
+	// Workspace ID, "<workspaceId>/<projectName>" for projects or build ID
+	ResourceId  string `json:"resourceId" validate:"required"`
+	WorkspaceId string `json:"workspaceId,omitempty" validate:"optional"`
+	// Build state for builds, running or stopped for projects
+	State     string    `json:"state,omitempty" validate:"optional"`
+	Error     string    `json:"error,omitempty" validate:"optional"`
+	Timestamp time.Time `json:"timestamp" validate:"required"`
+	// Owner of the workspace of the event. Used to only stream events to the users that can access them.
+	OwnerId string `json:"-"`
+} // @name Event
+
+func NewWorkspaceEvent(eventType EventType, workspaceId string, err error) Event {
+	event := Event{
+		Type:         eventType,
+		ResourceType: ResourceTypeWorkspace,
+		ResourceId:   workspaceId,
+		WorkspaceId:  workspaceId,
+	}
+
+	if err != nil {
+		event.Type = EventTypeWorkspaceError
+		event.Error = err.Error()
+	}
+
+	return event
+}
+
+func NewProjectEvent(eventType EventType, workspaceId, projectName, state string, err error) Event {
+	event := Event{
+		Type:         eventType,
+		ResourceType: ResourceTypeProject,
+		ResourceId:   workspaceId + "/" + projectName,
+		WorkspaceId:  workspaceId,
+		State:        state,
+	}
+
+	if err != nil {
+		event.Type = EventTypeProjectError
+		event.Error = err.Error()
+	}
+
+	return event
+}
+
+func NewBuildEvent(buildId, state string) Event {
+	return Event{
+		Type:         EventTypeBuildStateChanged,
+		ResourceType: ResourceTypeBuild,
+		ResourceId:   buildId,
+		State:        state,
+	}
+}
+
+// NewBuildResultEvent returns the event of a build that was published or, if err is not nil, that failed
+func NewBuildResultEvent(buildId, state string, err error) Event {
+	event := Event{
+		Type:         EventTypeBuildSuccess,
+		ResourceType: ResourceTypeBuild,
+		ResourceId:   buildId,
+		State:        state,
+	}
+
+	if err != nil {
+		event.Type = EventTypeBuildError
+		event.Error = err.Error()
+	}
+
+	return event
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -24,6 +25,11 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/servicehooks"
 )
 
+// Pull request events are delivered through their own subscriptions that are created and removed along with the push subscription
+var azureDevOpsPullRequestEventTypes = []string{"git.pullrequest.created", "git.pullrequest.updated"}
+
+const azureDevOpsWebhookUsername = "daytona"
+
 type AzureDevOpsGitProvider struct {
 	*AbstractGitProvider
 
@@ -612,7 +618,51 @@ func (g *AzureDevOpsGitProvider) getLocationClient() location.Client {
 	return client
 }
 
-func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *AzureDevOpsGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *AzureDevOpsGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	gitClient, err := g.getGitClient()
+	if err != nil {
+		return err
+	}
+
+	var azureState git.GitStatusState
+	switch state {
+	case CommitStatusPending:
+		azureState = git.GitStatusStateValues.Pending
+	case CommitStatusSuccess:
+		azureState = git.GitStatusStateValues.Succeeded
+	default:
+		azureState = git.GitStatusStateValues.Error
+	}
+
+	genre := "daytona"
+	name := "prebuild"
+	status := &git.GitStatus{
+		State:       &azureState,
+		Description: &description,
+		Context: &git.GitStatusContext{
+			Genre: &genre,
+			Name:  &name,
+		},
+	}
+	if targetUrl != "" {
+		status.TargetUrl = &targetUrl
+	}
+
+	_, err = gitClient.CreateCommitStatus(context.Background(), git.CreateCommitStatusArgs{
+		GitCommitStatusToCreate: status,
+		CommitId:                &sha,
+		RepositoryId:            &repo.Id,
+		Project:                 &repo.Name,
+	})
+
+	return err
+}
+
+func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	coreClient, conn, err := g.getApiClient()
 	if err != nil {
 		return "", err
@@ -628,15 +678,38 @@ func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, en
 	}
 	projectID := project.Id.String()
 
+	hook, err := g.createSubscription(serviceHooksClient, "git.push", projectID, repo, endpointUrl, secret)
+	if err != nil {
+		return "", err
+	}
+
+	for _, eventType := range azureDevOpsPullRequestEventTypes {
+		_, err = g.createSubscription(serviceHooksClient, eventType, projectID, repo, endpointUrl, secret)
+		if err != nil {
+			unregisterErr := g.UnregisterPrebuildWebhook(repo, hook.Id.String())
+			if unregisterErr != nil {
+				return "", errors.Join(err, unregisterErr)
+			}
+			return "", err
+		}
+	}
+
+	return hook.Id.String(), nil
+}
+
+// Service hooks can not sign their payload so the secret is sent as the basic auth password
+func (g *AzureDevOpsGitProvider) createSubscription(serviceHooksClient servicehooks.Client, eventType, projectID string, repo *GitRepository, endpointUrl string, secret string) (*servicehooks.Subscription, error) {
 	subscription := servicehooks.Subscription{
 		PublisherId:      util.Pointer("tfs"),
-		EventType:        util.Pointer("git.push"),
+		EventType:        util.Pointer(eventType),
 		ResourceVersion:  util.Pointer("1.0"),
 		ConsumerActionId: util.Pointer("httpRequest"),
 		ConsumerId:       util.Pointer("webHooks"),
 		ConsumerInputs: &map[string]string{
-			"url":         endpointUrl,
-			"httpHeaders": "X-AzureDevops-Event:git.push\nX-Owner:" + repo.Owner,
+			"url":               endpointUrl,
+			"httpHeaders":       fmt.Sprintf("X-AzureDevops-Event:%s\nX-Owner:%s", eventType, repo.Owner),
+			"basicAuthUsername": azureDevOpsWebhookUsername,
+			"basicAuthPassword": secret,
 		},
 		PublisherInputs: &map[string]string{
 			"projectId":  projectID,
@@ -645,15 +718,14 @@ func (g *AzureDevOpsGitProvider) RegisterPrebuildWebhook(repo *GitRepository, en
 		Status: &servicehooks.SubscriptionStatusValues.Enabled,
 	}
 
-	var hook *servicehooks.Subscription
-	hook, err = serviceHooksClient.CreateSubscription(context.Background(), servicehooks.CreateSubscriptionArgs{
+	hook, err := serviceHooksClient.CreateSubscription(context.Background(), servicehooks.CreateSubscriptionArgs{
 		Subscription: &subscription,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create subscription: %w", err)
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
 	}
 
-	return hook.Id.String(), nil
+	return hook, nil
 }
 
 func (g *AzureDevOpsGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error) {
@@ -682,6 +754,61 @@ func (g *AzureDevOpsGitProvider) GetPrebuildWebhook(repo *GitRepository, endpoin
 	return nil, nil
 }
 
+// UpdatePrebuildWebhook sets the secret of the existing subscriptions and creates the pull request subscriptions
+// that are missing next to the push subscription
+func (g *AzureDevOpsGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	coreClient, conn, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	serviceHooksClient := servicehooks.NewClient(context.Background(), conn)
+
+	project, err := coreClient.GetProject(context.Background(), core.GetProjectArgs{
+		ProjectId: &repo.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	for _, eventType := range append([]string{"git.push"}, azureDevOpsPullRequestEventTypes...) {
+		hooks, err := serviceHooksClient.ListSubscriptions(context.Background(), servicehooks.ListSubscriptionsArgs{
+			PublisherId:      util.Pointer("tfs"),
+			ConsumerActionId: util.Pointer("httpRequest"),
+			ConsumerId:       util.Pointer("webHooks"),
+			EventType:        util.Pointer(eventType),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		index := slices.IndexFunc(*hooks, func(hook servicehooks.Subscription) bool {
+			return hook.ConsumerInputs != nil && (*hook.ConsumerInputs)["url"] == endpointUrl
+		})
+		if index == -1 {
+			_, err = g.createSubscription(serviceHooksClient, eventType, project.Id.String(), repo, endpointUrl, secret)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		hook := (*hooks)[index]
+		(*hook.ConsumerInputs)["basicAuthUsername"] = azureDevOpsWebhookUsername
+		(*hook.ConsumerInputs)["basicAuthPassword"] = secret
+
+		_, err = serviceHooksClient.ReplaceSubscription(context.Background(), servicehooks.ReplaceSubscriptionArgs{
+			Subscription:   &hook,
+			SubscriptionId: hook.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (g *AzureDevOpsGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	_, conn, err := g.getApiClient()
 	if err != nil {
@@ -695,6 +822,17 @@ func (g *AzureDevOpsGitProvider) UnregisterPrebuildWebhook(repo *GitRepository,
 
 	serviceHooksClient := servicehooks.NewClient(context.Background(), conn)
 
+	// Pull request subscriptions are registered alongside the push subscription and share its endpoint
+	hook, err := serviceHooksClient.GetSubscription(context.Background(), servicehooks.GetSubscriptionArgs{
+		SubscriptionId: &uuid,
+	})
+	if err == nil && hook.ConsumerInputs != nil {
+		err = g.deletePullRequestSubscriptions(serviceHooksClient, (*hook.ConsumerInputs)["url"])
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := serviceHooksClient.DeleteSubscription(context.Background(), servicehooks.DeleteSubscriptionArgs{
 		SubscriptionId: &uuid,
 	}); err != nil {
@@ -704,6 +842,35 @@ func (g *AzureDevOpsGitProvider) UnregisterPrebuildWebhook(repo *GitRepository,
 	return nil
 }
 
+func (g *AzureDevOpsGitProvider) deletePullRequestSubscriptions(serviceHooksClient servicehooks.Client, endpointUrl string) error {
+	for _, eventType := range azureDevOpsPullRequestEventTypes {
+		hooks, err := serviceHooksClient.ListSubscriptions(context.Background(), servicehooks.ListSubscriptionsArgs{
+			PublisherId:      util.Pointer("tfs"),
+			ConsumerActionId: util.Pointer("httpRequest"),
+			ConsumerId:       util.Pointer("webHooks"),
+			EventType:        util.Pointer(eventType),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list subscriptions: %w", err)
+		}
+
+		for _, hook := range *hooks {
+			if hook.ConsumerInputs == nil || (*hook.ConsumerInputs)["url"] != endpointUrl {
+				continue
+			}
+
+			err = serviceHooksClient.DeleteSubscription(context.Background(), servicehooks.DeleteSubscriptionArgs{
+				SubscriptionId: hook.Id,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete subscription: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (g *AzureDevOpsGitProvider) GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error) {
 	gitClient, err := g.getGitClient()
 	if err != nil {
@@ -729,9 +896,19 @@ func (g *AzureDevOpsGitProvider) GetCommitsRange(repo *GitRepository, initialSha
 	return *commits.AheadCount, nil
 }
 
+func (g *AzureDevOpsGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	_, password, _ := request.BasicAuth()
+	return verifyWebhookToken(password, secret)
+}
+
 func (g *AzureDevOpsGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-AzureDevops-Event") != "git.push" {
-		return nil, fmt.Errorf("invalid event key: %s", request.Header.Get("X-AzureDevops-Event"))
+	eventKey := request.Header.Get("X-AzureDevops-Event")
+	if slices.Contains(azureDevOpsPullRequestEventTypes, eventKey) {
+		return g.parsePullRequestEventData(request)
+	}
+
+	if eventKey != "git.push" {
+		return nil, fmt.Errorf("invalid event key: %s", eventKey)
 	}
 
 	hook, err := azureWebhook.New()
@@ -750,6 +927,7 @@ func (g *AzureDevOpsGitProvider) ParseEventData(request *http.Request) (*GitEven
 	owner := request.Header.Get("X-Owner")
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Owner:  owner,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Resource.Repository.RemoteURL),
 		Branch: strings.TrimPrefix(pushEvent.Resource.Repository.DefaultBranch, "refs/heads/"),
@@ -763,6 +941,65 @@ func (g *AzureDevOpsGitProvider) ParseEventData(request *http.Request) (*GitEven
 	return gitEventData, nil
 }
 
+// Payload fields of pull request events that the webhook library does not parse
+type azureDevOpsPullRequestFork struct {
+	Resource struct {
+		ForkSource *struct{} `json:"forkSource"`
+	} `json:"resource"`
+}
+
+// Pull request updates are delivered for new commits as well as for other changes to the pull request.
+// Completed and abandoned pull requests are reported as closed.
+func (g *AzureDevOpsGitProvider) parsePullRequestEventData(request *http.Request) (*GitEventData, error) {
+	payload, err := readRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var fork azureDevOpsPullRequestFork
+	err = json.Unmarshal(payload, &fork)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+
+	hook, err := azureWebhook.New()
+	if err != nil {
+		return nil, err
+	}
+	event, err := hook.Parse(request, azureWebhook.GitPullRequestCreatedEventType, azureWebhook.GitPullRequestUpdatedEventType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	pullRequestEvent, ok := event.(azureWebhook.GitPullRequestEvent)
+	if !ok {
+		return nil, errors.New("failed to parse pull request event")
+	}
+
+	pullRequest := pullRequestEvent.Resource
+
+	var eventType GitEventType
+
+	switch {
+	case pullRequest.Status == "completed" || pullRequest.Status == "abandoned":
+		eventType = GitEventTypePullRequestClosed
+	case pullRequestEvent.EventType == azureWebhook.GitPullRequestCreatedEventType:
+		eventType = GitEventTypePullRequestOpened
+	default:
+		eventType = GitEventTypePullRequestSynchronized
+	}
+
+	return &GitEventData{
+		Type:              eventType,
+		Owner:             request.Header.Get("X-Owner"),
+		Url:               util.CleanUpRepositoryUrl(pullRequest.Repository.RemoteURL),
+		Branch:            strings.TrimPrefix(pullRequest.SourceRefName, "refs/heads/"),
+		Sha:               pullRequest.LastMergeSourceCommit.CommitID,
+		PullRequestNumber: util.Pointer(uint32(pullRequest.PullRequestID)),
+		BaseBranch:        strings.TrimPrefix(pullRequest.TargetRefName, "refs/heads/"),
+		FromFork:          fork.Resource.ForkSource != nil,
+	}, nil
+}
+
 func (g *AzureDevOpsGitProvider) FormatError(err error) error {
 	data, marshalErr := json.Marshal(err)
 	if marshalErr != nil {
@@ -534,15 +534,18 @@ type prResponseData struct {
 	} `json:"values"`
 }
 
-func (g *BitbucketGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+var bitbucketPrebuildWebhookEvents = []string{"repo:push", "pullrequest:created", "pullrequest:updated", "pullrequest:fulfilled", "pullrequest:rejected"}
+
+func (g *BitbucketGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
 	hook, err := client.Repositories.Webhooks.Create(&bitbucket.WebhooksOptions{
 		Active:   true,
 		Owner:    repo.Owner,
-		Events:   []string{"repo:push"},
+		Events:   bitbucketPrebuildWebhookEvents,
 		Url:      endpointUrl,
 		RepoSlug: repo.Id,
+		Secret:   secret,
 	})
 
 	if err != nil {
@@ -558,7 +561,7 @@ func (g *BitbucketGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointU
 	hooks, err := client.Repositories.Webhooks.List(&bitbucket.WebhooksOptions{
 		Owner:    repo.Owner,
 		RepoSlug: repo.Id,
-		Events:   []string{"repo:push"},
+		Events:   bitbucketPrebuildWebhookEvents,
 		Active:   true,
 	})
 
@@ -579,6 +582,25 @@ func (g *BitbucketGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointU
 	return nil, nil
 }
 
+func (g *BitbucketGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	client := g.getApiClient()
+
+	_, err := client.Repositories.Webhooks.Update(&bitbucket.WebhooksOptions{
+		Active:   true,
+		Owner:    repo.Owner,
+		Events:   bitbucketPrebuildWebhookEvents,
+		Url:      endpointUrl,
+		RepoSlug: repo.Id,
+		Uuid:     id,
+		Secret:   secret,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *BitbucketGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	client := g.getApiClient()
 
@@ -606,10 +628,69 @@ func (g *BitbucketGitProvider) GetCommitsRange(repo *GitRepository, initialSha s
 	return commits.Size, nil
 }
 
+func (g *BitbucketGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *BitbucketGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	client := g.getApiClient()
+
+	_, err := client.Repositories.Commits.CreateCommitStatus(&bitbucket.CommitsOptions{
+		Owner:    repo.Owner,
+		RepoSlug: repo.Id,
+		Revision: sha,
+	}, &bitbucket.CommitStatusOptions{
+		Key:         commitStatusContext,
+		Name:        commitStatusContext,
+		Url:         getBitbucketStatusUrl(repo, targetUrl),
+		State:       getBitbucketBuildState(state),
+		Description: description,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
+// Bitbucket requires build statuses to link somewhere so they fall back to the repository
+func getBitbucketStatusUrl(repo *GitRepository, targetUrl string) string {
+	if targetUrl != "" {
+		return targetUrl
+	}
+
+	return repo.Url
+}
+
+// Bitbucket Cloud and Bitbucket Server share the same build states
+func getBitbucketBuildState(state CommitStatus) string {
+	switch state {
+	case CommitStatusPending:
+		return "INPROGRESS"
+	case CommitStatusSuccess:
+		return "SUCCESSFUL"
+	default:
+		return "FAILED"
+	}
+}
+
+func (g *BitbucketGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	signature, _ := strings.CutPrefix(request.Header.Get("X-Hub-Signature"), "sha256=")
+	return verifyPayloadSignature(request, signature, secret)
+}
+
 func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Event-Key") != "repo:push" {
+	eventKey := bitbucketWebhook.Event(request.Header.Get("X-Event-Key"))
+
+	switch eventKey {
+	case bitbucketWebhook.PullRequestCreatedEvent, bitbucketWebhook.PullRequestUpdatedEvent, bitbucketWebhook.PullRequestMergedEvent, bitbucketWebhook.PullRequestDeclinedEvent:
+		return g.parsePullRequestEventData(request, eventKey)
+	}
+
+	if eventKey != bitbucketWebhook.RepoPushEvent {
 		return nil, errors.New("invalid event key")
 	}
+
 	hook, err := bitbucketWebhook.New()
 	if err != nil {
 		return nil, err
@@ -627,6 +708,7 @@ func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventD
 	owner := pushEvent.Repository.Owner.DisplayName
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Repository.Links.HTML.Href) + ".git",
 		Branch: pushEvent.Push.Changes[0].New.Name,
 		Sha:    pushEvent.Push.Changes[0].New.Target.Hash,
@@ -642,6 +724,48 @@ func (g *BitbucketGitProvider) ParseEventData(request *http.Request) (*GitEventD
 	return gitEventData, nil
 }
 
+// Bitbucket does not tell apart pull request updates that push new commits from other updates
+// so every update is treated as a synchronization of the pull request head
+func (g *BitbucketGitProvider) parsePullRequestEventData(request *http.Request, eventKey bitbucketWebhook.Event) (*GitEventData, error) {
+	hook, err := bitbucketWebhook.New()
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := hook.Parse(request, eventKey)
+	if err != nil {
+		return nil, errors.New("could not parse event")
+	}
+
+	var eventType GitEventType
+	var pullRequest bitbucketWebhook.PullRequest
+	var repository bitbucketWebhook.Repository
+
+	switch payload := event.(type) {
+	case bitbucketWebhook.PullRequestCreatedPayload:
+		eventType, pullRequest, repository = GitEventTypePullRequestOpened, payload.PullRequest, payload.Repository
+	case bitbucketWebhook.PullRequestUpdatedPayload:
+		eventType, pullRequest, repository = GitEventTypePullRequestSynchronized, payload.PullRequest, payload.Repository
+	case bitbucketWebhook.PullRequestMergedPayload:
+		eventType, pullRequest, repository = GitEventTypePullRequestClosed, payload.PullRequest, payload.Repository
+	case bitbucketWebhook.PullRequestDeclinedPayload:
+		eventType, pullRequest, repository = GitEventTypePullRequestClosed, payload.PullRequest, payload.Repository
+	default:
+		return nil, errors.New("could not parse pull request event")
+	}
+
+	return &GitEventData{
+		Type:              eventType,
+		Url:               util.CleanUpRepositoryUrl(repository.Links.HTML.Href) + ".git",
+		Branch:            pullRequest.Source.Branch.Name,
+		Sha:               pullRequest.Source.Commit.Hash,
+		Owner:             repository.Owner.DisplayName,
+		PullRequestNumber: util.Pointer(uint32(pullRequest.ID)),
+		BaseBranch:        pullRequest.Destination.Branch.Name,
+		FromFork:          pullRequest.Source.Repository.UUID != pullRequest.Destination.Repository.UUID,
+	}, nil
+}
+
 func (b *BitbucketGitProvider) FormatError(err error) error {
 	re := regexp.MustCompile(`(\d{3})\s(.+)`)
 	match := re.FindStringSubmatch(err.Error())
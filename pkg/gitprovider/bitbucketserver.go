@@ -529,7 +529,9 @@ func (b *BitbucketServerGitProvider) FormatError(statusCode int, message string)
 	return fmt.Errorf("status code: %d err: Request failed with %s", statusCode, message)
 }
 
-func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+var bitbucketServerPrebuildWebhookEvents = []string{"repo:refs_changed", "pr:opened", "pr:from_ref_updated", "pr:merged", "pr:declined", "pr:deleted"}
+
+func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client, err := g.getApiClient()
 	if err != nil {
 		return "", err
@@ -537,8 +539,11 @@ func (g *BitbucketServerGitProvider) RegisterPrebuildWebhook(repo *GitRepository
 
 	webhook := map[string]interface{}{
 		"url":    endpointUrl,
-		"events": []string{"repo:refs_changed"},
+		"events": bitbucketServerPrebuildWebhookEvents,
 		"active": true,
+		"configuration": map[string]string{
+			"secret": secret,
+		},
 	}
 
 	contentType := []string{"application/json"}
@@ -579,6 +584,34 @@ func (g *BitbucketServerGitProvider) GetPrebuildWebhook(repo *GitRepository, end
 	return nil, nil
 }
 
+func (g *BitbucketServerGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	client, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	hookId, err := strconv.Atoi(id)
+	if err != nil {
+		return errors.New("unable to convert webhook id to int")
+	}
+
+	webhook := map[string]interface{}{
+		"url":    endpointUrl,
+		"events": bitbucketServerPrebuildWebhookEvents,
+		"active": true,
+		"configuration": map[string]string{
+			"secret": secret,
+		},
+	}
+
+	resp, err := client.DefaultApi.UpdateWebhook(repo.Id, repo.Owner, int32(hookId), webhook, []string{"application/json"})
+	if err != nil {
+		return g.FormatError(resp.StatusCode, resp.Message)
+	}
+
+	return nil
+}
+
 func (g *BitbucketServerGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	client, err := g.getApiClient()
 	if err != nil {
@@ -618,8 +651,47 @@ func (g *BitbucketServerGitProvider) GetCommitsRange(repo *GitRepository, initia
 	return int(size), nil
 }
 
+func (g *BitbucketServerGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *BitbucketServerGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	client, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := client.DefaultApi.SetCommitStatus(sha, bitbucketv1.BuildStatus{
+		State:       getBitbucketBuildState(state),
+		Key:         commitStatusContext,
+		Name:        commitStatusContext,
+		Url:         getBitbucketStatusUrl(repo, targetUrl),
+		Description: description,
+	})
+	if err != nil {
+		if res != nil {
+			return g.FormatError(res.StatusCode, res.Message)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (g *BitbucketServerGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	signature, _ := strings.CutPrefix(request.Header.Get("X-Hub-Signature"), "sha256=")
+	return verifyPayloadSignature(request, signature, secret)
+}
+
 func (g *BitbucketServerGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Event-Key") != "repo:refs_changed" {
+	eventKey := bitbucketWebhook.Event(request.Header.Get("X-Event-Key"))
+
+	switch eventKey {
+	case bitbucketWebhook.PullRequestOpenedEvent, bitbucketWebhook.PullRequestFromReferenceUpdatedEvent, bitbucketWebhook.PullRequestMergedEvent, bitbucketWebhook.PullRequestDeclinedEvent, bitbucketWebhook.PullRequestDeletedEvent:
+		return g.parsePullRequestEventData(request, eventKey)
+	}
+
+	if eventKey != bitbucketWebhook.RepositoryReferenceChangedEvent {
 		return nil, errors.New("invalid event key")
 	}
 	hook, err := bitbucketWebhook.New()
@@ -638,7 +710,8 @@ func (g *BitbucketServerGitProvider) ParseEventData(request *http.Request) (*Git
 	}
 	owner := pushEvent.Actor.DisplayName
 	gitEventData := &GitEventData{
-		Url:    fmt.Sprintf("%s/scm/%s/%s.git", strings.TrimSuffix(g.baseApiUrl, "/rest"), strings.ToLower(pushEvent.Repository.Project.Key), pushEvent.Repository.Slug),
+		Type:   GitEventTypePush,
+		Url:    g.getCloneUrl(pushEvent.Repository),
 		Branch: strings.TrimPrefix(pushEvent.Changes[0].ReferenceID, "refs/heads/"),
 		Sha:    pushEvent.Changes[0].ToHash,
 		Owner:  owner,
@@ -650,3 +723,49 @@ func (g *BitbucketServerGitProvider) ParseEventData(request *http.Request) (*Git
 
 	return gitEventData, nil
 }
+
+func (g *BitbucketServerGitProvider) parsePullRequestEventData(request *http.Request, eventKey bitbucketWebhook.Event) (*GitEventData, error) {
+	hook, err := bitbucketWebhook.New()
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := hook.Parse(request, eventKey)
+	if err != nil {
+		return nil, errors.New("could not parse event")
+	}
+
+	var eventType GitEventType
+	var pullRequest bitbucketWebhook.PullRequest
+	var owner string
+
+	switch payload := event.(type) {
+	case bitbucketWebhook.PullRequestOpenedPayload:
+		eventType, pullRequest, owner = GitEventTypePullRequestOpened, payload.PullRequest, payload.Actor.DisplayName
+	case bitbucketWebhook.PullRequestFromReferenceUpdatedPayload:
+		eventType, pullRequest, owner = GitEventTypePullRequestSynchronized, payload.PullRequest, payload.Actor.DisplayName
+	case bitbucketWebhook.PullRequestMergedPayload:
+		eventType, pullRequest, owner = GitEventTypePullRequestClosed, payload.PullRequest, payload.Actor.DisplayName
+	case bitbucketWebhook.PullRequestDeclinedPayload:
+		eventType, pullRequest, owner = GitEventTypePullRequestClosed, payload.PullRequest, payload.Actor.DisplayName
+	case bitbucketWebhook.PullRequestDeletedPayload:
+		eventType, pullRequest, owner = GitEventTypePullRequestClosed, payload.PullRequest, payload.Actor.DisplayName
+	default:
+		return nil, errors.New("could not parse pull request event")
+	}
+
+	return &GitEventData{
+		Type:              eventType,
+		Url:               g.getCloneUrl(pullRequest.ToRef.Repository),
+		Branch:            pullRequest.FromRef.DisplayID,
+		Sha:               pullRequest.FromRef.LatestCommit,
+		Owner:             owner,
+		PullRequestNumber: util.Pointer(uint32(pullRequest.ID)),
+		BaseBranch:        pullRequest.ToRef.DisplayID,
+		FromFork:          pullRequest.FromRef.Repository.ID != pullRequest.ToRef.Repository.ID,
+	}, nil
+}
+
+func (g *BitbucketServerGitProvider) getCloneUrl(repository bitbucketWebhook.Repository) string {
+	return fmt.Sprintf("%s/scm/%s/%s.git", strings.TrimSuffix(g.baseApiUrl, "/rest"), strings.ToLower(repository.Project.Key), repository.Slug)
+}
@@ -60,11 +60,19 @@ type GitProvider interface {
 	ParseStaticGitContext(repoUrl string) (*StaticGitContext, error)
 	GetDefaultBranch(staticContext *StaticGitContext) (*string, error)
 
-	RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error)
+	// Registers a webhook that signs its events with the secret
+	RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error)
 	GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error)
+	// Updates the events and the secret of an existing webhook to the ones of a newly registered webhook
+	UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error
 	UnregisterPrebuildWebhook(repo *GitRepository, id string) error
 	GetCommitsRange(repo *GitRepository, initialSha string, currentSha string) (int, error)
+	// Returns ErrInvalidWebhookSignature if the webhook request was not signed with the secret
+	VerifyEventSignature(request *http.Request, secret string) error
 	ParseEventData(request *http.Request) (*GitEventData, error)
+	// Returns false if SetCommitStatus is not implemented for the provider
+	SupportsCommitStatus() bool
+	SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error
 }
 
 type AbstractGitProvider struct {
@@ -178,10 +186,14 @@ func (g *AbstractGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUr
 	return nil, errors.New("prebuilds not yet implemented for this git provider")
 }
 
-func (g *AbstractGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *AbstractGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	return "", errors.New("prebuilds not yet implemented for this git provider")
 }
 
+func (g *AbstractGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	return errors.New("prebuilds not yet implemented for this git provider")
+}
+
 func (g *AbstractGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	return errors.New("prebuilds not yet implemented for this git provider")
 }
@@ -190,10 +202,22 @@ func (g *AbstractGitProvider) GetCommitsRange(repo *GitRepository, initialSha st
 	return 0, errors.New("prebuilds not yet implemented for this git provider")
 }
 
+func (g *AbstractGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	return errors.New("prebuilds not yet implemented for this git provider")
+}
+
 func (g *AbstractGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	return nil, errors.New("prebuilds not yet implemented for this git provider")
 }
 
+func (g *AbstractGitProvider) SupportsCommitStatus() bool {
+	return false
+}
+
+func (g *AbstractGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	return errors.New("commit statuses not yet implemented for this git provider")
+}
+
 func (a *AbstractGitProvider) parseSshGitUrl(gitURL string) (*StaticGitContext, error) {
 	re := regexp.MustCompile(`git@([\w\.]+):(.+?)/(.+?)(?:\.git)?$`)
 	matches := re.FindStringSubmatch(gitURL)
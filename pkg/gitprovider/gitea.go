@@ -440,7 +440,32 @@ func (g *GiteaGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*s
 	return &repo.DefaultBranch, nil
 }
 
-func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GiteaGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *GiteaGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	client, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	_, res, err := client.CreateStatus(repo.Owner, repo.Name, sha, gitea.CreateStatusOption{
+		State:       gitea.StatusState(state),
+		TargetURL:   targetUrl,
+		Description: description,
+		Context:     commitStatusContext,
+	})
+	if err != nil {
+		return g.FormatError(res, err)
+	}
+
+	return nil
+}
+
+var giteaPrebuildWebhookEvents = []string{"push", "pull_request", "pull_request_sync"}
+
+func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client, err := g.getApiClient()
 	if err != nil {
 		return "", fmt.Errorf("failed to get api client: %w", err)
@@ -451,8 +476,9 @@ func (g *GiteaGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpoint
 		Config: map[string]string{
 			"url":          endpointUrl,
 			"content_type": "json",
+			"secret":       secret,
 		},
-		Events: []string{"push"},
+		Events: giteaPrebuildWebhookEvents,
 		Active: true,
 	}
 
@@ -489,6 +515,33 @@ func (g *GiteaGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl s
 	return nil, nil
 }
 
+func (g *GiteaGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	client, err := g.getApiClient()
+	if err != nil {
+		return err
+	}
+
+	hookId, err := strconv.Atoi(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.EditRepoHook(repo.Owner, repo.Name, int64(hookId), gitea.EditHookOption{
+		Config: map[string]string{
+			"url":          endpointUrl,
+			"content_type": "json",
+			"secret":       secret,
+		},
+		Events: giteaPrebuildWebhookEvents,
+		Active: util.Pointer(true),
+	})
+	if err != nil {
+		return g.FormatError(res, err)
+	}
+
+	return nil
+}
+
 func (g *GiteaGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	client, err := g.getApiClient()
 	if err != nil {
@@ -528,8 +581,13 @@ func (g *GiteaGitProvider) GetCommitsRange(repo *GitRepository, initialSha strin
 	return (len(currentCommits) - len(initialCommits)), nil
 }
 
+func (g *GiteaGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	return verifyPayloadSignature(request, request.Header.Get("X-Gitea-Signature"), secret)
+}
+
 func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
-	if request.Header.Get("X-Gitea-Event") != "push" {
+	eventKey := request.Header.Get("X-Gitea-Event")
+	if eventKey != "push" && eventKey != "pull_request" {
 		return nil, errors.New("invalid event key")
 	}
 
@@ -538,6 +596,10 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 		return nil, err
 	}
 
+	if eventKey == "pull_request" {
+		return g.parsePullRequestEventData(hook, request)
+	}
+
 	event, err := hook.Parse(request, giteaWebhook.PushEvent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse event: %w", err)
@@ -551,6 +613,7 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 	owner := pushEvent.Repo.Owner.FullName
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Owner:  owner,
 		Url:    util.CleanUpRepositoryUrl(pushEvent.Repo.HTMLURL) + ".git",
 		Branch: strings.TrimPrefix(pushEvent.Ref, "refs/heads/"),
@@ -566,6 +629,56 @@ func (g *GiteaGitProvider) ParseEventData(request *http.Request) (*GitEventData,
 	return gitEventData, nil
 }
 
+func (g *GiteaGitProvider) parsePullRequestEventData(hook *giteaWebhook.Webhook, request *http.Request) (*GitEventData, error) {
+	event, err := hook.Parse(request, giteaWebhook.PullRequestEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+
+	pullRequestEvent, ok := event.(giteaWebhook.PullRequestPayload)
+	if !ok || pullRequestEvent.PullRequest == nil || pullRequestEvent.Repository == nil {
+		return nil, errors.New("failed to parse pull request event")
+	}
+
+	var eventType GitEventType
+
+	switch pullRequestEvent.Action {
+	case "opened", "reopened":
+		eventType = GitEventTypePullRequestOpened
+	case "synchronized":
+		eventType = GitEventTypePullRequestSynchronized
+	case "closed":
+		eventType = GitEventTypePullRequestClosed
+	default:
+		return nil, nil
+	}
+
+	gitEventData := &GitEventData{
+		Type:              eventType,
+		Url:               util.CleanUpRepositoryUrl(pullRequestEvent.Repository.HTMLURL) + ".git",
+		PullRequestNumber: util.Pointer(uint32(pullRequestEvent.PullRequest.Index)),
+	}
+
+	if pullRequestEvent.Repository.Owner != nil {
+		gitEventData.Owner = pullRequestEvent.Repository.Owner.FullName
+	}
+
+	if pullRequestEvent.PullRequest.Head != nil {
+		gitEventData.Branch = pullRequestEvent.PullRequest.Head.Ref
+		gitEventData.Sha = pullRequestEvent.PullRequest.Head.Sha
+	}
+
+	if pullRequestEvent.PullRequest.Base != nil {
+		gitEventData.BaseBranch = pullRequestEvent.PullRequest.Base.Ref
+	}
+
+	if pullRequestEvent.PullRequest.Head == nil || pullRequestEvent.PullRequest.Base == nil || pullRequestEvent.PullRequest.Head.RepoID != pullRequestEvent.PullRequest.Base.RepoID {
+		gitEventData.FromFork = true
+	}
+
+	return gitEventData, nil
+}
+
 func (g *GiteaGitProvider) FormatError(response *gitea.Response, err error) error {
 	return fmt.Errorf("status code: %d err: Request failed with %s", response.StatusCode, err.Error())
 }
@@ -404,6 +404,8 @@ func (g *GitHubGitProvider) ParseStaticGitContext(repoUrl string) (*StaticGitCon
 	return staticContext, nil
 }
 
+var githubPrebuildWebhookEvents = []string{"push", "pull_request"}
+
 func (g *GitHubGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl string) (*string, error) {
 	client := g.getApiClient()
 
@@ -423,15 +425,16 @@ func (g *GitHubGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl
 	return nil, nil
 }
 
-func (g *GitHubGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitHubGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
 	hook, _, err := client.Repositories.CreateHook(context.Background(), repo.Owner, repo.Name, &github.Hook{
 		Active: github.Bool(true),
-		Events: []string{"push"},
+		Events: githubPrebuildWebhookEvents,
 		Config: map[string]interface{}{
 			"url":          endpointUrl,
 			"content_type": "json",
+			"secret":       secret,
 		},
 	})
 
@@ -442,6 +445,30 @@ func (g *GitHubGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpoin
 	return strconv.Itoa(int(*hook.ID)), nil
 }
 
+func (g *GitHubGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	client := g.getApiClient()
+
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.Repositories.EditHook(context.Background(), repo.Owner, repo.Name, int64(idInt), &github.Hook{
+		Active: github.Bool(true),
+		Events: githubPrebuildWebhookEvents,
+		Config: map[string]interface{}{
+			"url":          endpointUrl,
+			"content_type": "json",
+			"secret":       secret,
+		},
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *GitHubGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	client := g.getApiClient()
 
@@ -465,6 +492,35 @@ func (g *GitHubGitProvider) GetCommitsRange(repo *GitRepository, initialSha stri
 	return len(commits.Commits), nil
 }
 
+func (g *GitHubGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *GitHubGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	client := g.getApiClient()
+
+	status := &github.RepoStatus{
+		State:       github.String(string(state)),
+		Description: github.String(description),
+		Context:     github.String(commitStatusContext),
+	}
+	if targetUrl != "" {
+		status.TargetURL = github.String(targetUrl)
+	}
+
+	_, _, err := client.Repositories.CreateStatus(context.Background(), repo.Owner, repo.Name, sha, status)
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
+func (g *GitHubGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	signature, _ := strings.CutPrefix(request.Header.Get("X-Hub-Signature-256"), "sha256=")
+	return verifyPayloadSignature(request, signature, secret)
+}
+
 func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	payload, err := io.ReadAll(request.Body)
 	if err != nil {
@@ -473,7 +529,7 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 
 	webhookEventType := github.WebHookType(request)
 
-	if webhookEventType != "push" {
+	if webhookEventType != "push" && webhookEventType != "pull_request" {
 		return nil, nil
 	}
 
@@ -482,6 +538,10 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 		return nil, err
 	}
 
+	if pullRequestData, ok := data.(*github.PullRequestEvent); ok {
+		return g.parsePullRequestEventData(pullRequestData), nil
+	}
+
 	webhookData, ok := data.(*github.PushEvent)
 	if !ok {
 		return nil, fmt.Errorf("unexpected event type: %T", data)
@@ -493,6 +553,7 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	}
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookData.Repo.GetHTMLURL()) + ".git",
 		Branch: strings.TrimPrefix(webhookData.GetRef(), "refs/heads/"),
 		Sha:    webhookData.HeadCommit.GetID(),
@@ -508,6 +569,35 @@ func (g *GitHubGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	return gitEventData, nil
 }
 
+func (g *GitHubGitProvider) parsePullRequestEventData(webhookData *github.PullRequestEvent) *GitEventData {
+	var eventType GitEventType
+
+	switch webhookData.GetAction() {
+	case "opened", "reopened":
+		eventType = GitEventTypePullRequestOpened
+	case "synchronize":
+		eventType = GitEventTypePullRequestSynchronized
+	case "closed":
+		eventType = GitEventTypePullRequestClosed
+	default:
+		return nil
+	}
+
+	pullRequest := webhookData.GetPullRequest()
+
+	return &GitEventData{
+		Type:              eventType,
+		Url:               util.CleanUpRepositoryUrl(webhookData.Repo.GetHTMLURL()) + ".git",
+		Branch:            pullRequest.GetHead().GetRef(),
+		Sha:               pullRequest.GetHead().GetSHA(),
+		Owner:             webhookData.Repo.GetOwner().GetLogin(),
+		PullRequestNumber: util.Pointer(uint32(webhookData.GetNumber())),
+		BaseBranch:        pullRequest.GetBase().GetRef(),
+		// The head repository is missing if the fork was deleted
+		FromFork: pullRequest.GetHead().GetRepo().GetID() != pullRequest.GetBase().GetRepo().GetID(),
+	}
+}
+
 func (g *GitHubGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*string, error) {
 	client := g.getApiClient()
 
@@ -4,6 +4,10 @@
 package gitprovider
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -248,6 +252,98 @@ func (g *GitHubGitProviderTestSuite) TestGetUrlFromRepo_Commit() {
 	require.Equal("https://github.com/daytonaio/daytona/commit/COMMIT_SHA", url)
 }
 
+func (g *GitHubGitProviderTestSuite) TestParseEventData_PullRequest() {
+	payload := `{
+		"action": "synchronize",
+		"number": 12,
+		"pull_request": {
+			"head": {"ref": "feature", "sha": "HEAD_SHA", "repo": {"id": 1}},
+			"base": {"ref": "main", "sha": "BASE_SHA", "repo": {"id": 1}}
+		},
+		"repository": {
+			"html_url": "https://github.com/daytonaio/daytona",
+			"owner": {"login": "daytonaio"}
+		}
+	}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "pull_request")
+
+	require := g.Require()
+
+	data, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:              GitEventTypePullRequestSynchronized,
+		Url:               "https://github.com/daytonaio/daytona.git",
+		Branch:            "feature",
+		Sha:               "HEAD_SHA",
+		Owner:             "daytonaio",
+		PullRequestNumber: util.Pointer(uint32(12)),
+		BaseBranch:        "main",
+	}, data)
+}
+
+func (g *GitHubGitProviderTestSuite) TestParseEventData_PullRequestFromFork() {
+	payload := `{
+		"action": "opened",
+		"number": 13,
+		"pull_request": {
+			"head": {"ref": "main", "sha": "HEAD_SHA", "repo": {"id": 2}},
+			"base": {"ref": "main", "sha": "BASE_SHA", "repo": {"id": 1}}
+		},
+		"repository": {
+			"html_url": "https://github.com/daytonaio/daytona",
+			"owner": {"login": "daytonaio"}
+		}
+	}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "pull_request")
+
+	require := g.Require()
+
+	data, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.True(data.FromFork)
+}
+
+func (g *GitHubGitProviderTestSuite) TestParseEventData_PullRequestIgnoredAction() {
+	payload := `{"action": "labeled", "number": 12, "pull_request": {}, "repository": {}}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-GitHub-Event", "pull_request")
+
+	require := g.Require()
+
+	data, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Nil(data)
+}
+
+func (g *GitHubGitProviderTestSuite) TestVerifyEventSignature() {
+	payload := `{"zen": "Keep it logically awesome."}`
+	signature := "sha256=e279c786c1c838837b1845e5423948346c454eb4a8d88648405364a97f51ea1f"
+
+	require := g.Require()
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-Hub-Signature-256", signature)
+	require.Nil(g.gitProvider.VerifyEventSignature(request, "s3cret"))
+
+	// The body can still be parsed after it was verified
+	body, err := io.ReadAll(request.Body)
+	require.Nil(err)
+	require.Equal(payload, string(body))
+
+	request = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-Hub-Signature-256", signature)
+	require.True(IsInvalidWebhookSignature(g.gitProvider.VerifyEventSignature(request, "other")))
+
+	request = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	require.True(IsInvalidWebhookSignature(g.gitProvider.VerifyEventSignature(request, "s3cret")))
+}
+
 func TestGitHubGitProvider(t *testing.T) {
 	suite.Run(t, NewGitHubGitProviderTestSuite())
 }
@@ -503,15 +503,18 @@ func (g *GitLabGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (*
 	return &project.DefaultBranch, nil
 }
 
-func (g *GitLabGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitLabGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 
 	pushEvents := true
+	mergeRequestsEvents := true
 	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
 
 	hook, _, err := client.Projects.AddProjectHook(projectID, &gitlab.AddProjectHookOptions{
-		URL:        &endpointUrl,
-		PushEvents: &pushEvents,
+		URL:                 &endpointUrl,
+		PushEvents:          &pushEvents,
+		MergeRequestsEvents: &mergeRequestsEvents,
+		Token:               &secret,
 	})
 	if err != nil {
 		return "", g.FormatError(err)
@@ -540,6 +543,31 @@ func (g *GitLabGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl
 	return nil, nil
 }
 
+func (g *GitLabGitProvider) UpdatePrebuildWebhook(repo *GitRepository, hookId string, endpointUrl string, secret string) error {
+	client := g.getApiClient()
+
+	hookIdInt, err := strconv.Atoi(hookId)
+	if err != nil {
+		return err
+	}
+
+	pushEvents := true
+	mergeRequestsEvents := true
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+
+	_, _, err = client.Projects.EditProjectHook(projectID, hookIdInt, &gitlab.EditProjectHookOptions{
+		URL:                 &endpointUrl,
+		PushEvents:          &pushEvents,
+		MergeRequestsEvents: &mergeRequestsEvents,
+		Token:               &secret,
+	})
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
 func (g *GitLabGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, hookId string) error {
 	client := g.getApiClient()
 
@@ -572,12 +600,56 @@ func (g *GitLabGitProvider) GetCommitsRange(repo *GitRepository, initialSha stri
 	return len(commits.Commits), nil
 }
 
+func (g *GitLabGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *GitLabGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	client := g.getApiClient()
+
+	var gitlabState gitlab.BuildStateValue
+	switch state {
+	case CommitStatusPending:
+		gitlabState = gitlab.Running
+	case CommitStatusSuccess:
+		gitlabState = gitlab.Success
+	default:
+		gitlabState = gitlab.Failed
+	}
+
+	opts := &gitlab.SetCommitStatusOptions{
+		State:       gitlabState,
+		Name:        gitlab.Ptr(commitStatusContext),
+		Description: &description,
+	}
+	if targetUrl != "" {
+		opts.TargetURL = &targetUrl
+	}
+
+	projectID := fmt.Sprintf("%s/%s", repo.Owner, repo.Name)
+	_, _, err := client.Commits.SetCommitStatus(projectID, sha, opts)
+	if err != nil {
+		return g.FormatError(err)
+	}
+
+	return nil
+}
+
+// GitLab sends the secret token of the webhook as is instead of signing the payload
+func (g *GitLabGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	return verifyWebhookToken(request.Header.Get("X-Gitlab-Token"), secret)
+}
+
 func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	payload, err := io.ReadAll(request.Body)
 	if err != nil {
 		return nil, err
 	}
 
+	if gitlab.HookEventType(request) == gitlab.EventTypeMergeRequest {
+		return g.parseMergeRequestEventData(payload)
+	}
+
 	var webhookData gitlab.PushEvent
 	err = json.Unmarshal(payload, &webhookData)
 	if err != nil {
@@ -593,6 +665,7 @@ func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	}
 
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookData.Project.WebURL) + ".git",
 		Branch: strings.TrimPrefix(webhookData.Ref, "refs/heads/"),
 		Sha:    webhookData.After,
@@ -608,6 +681,42 @@ func (g *GitLabGitProvider) ParseEventData(request *http.Request) (*GitEventData
 	return gitEventData, nil
 }
 
+func (g *GitLabGitProvider) parseMergeRequestEventData(payload []byte) (*GitEventData, error) {
+	var webhookData gitlab.MergeEvent
+	err := json.Unmarshal(payload, &webhookData)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventType GitEventType
+
+	switch webhookData.ObjectAttributes.Action {
+	case "open", "reopen":
+		eventType = GitEventTypePullRequestOpened
+	case "update":
+		// Updates that do not push new commits only change the merge request metadata
+		if webhookData.ObjectAttributes.OldRev == "" {
+			return nil, nil
+		}
+		eventType = GitEventTypePullRequestSynchronized
+	case "close", "merge":
+		eventType = GitEventTypePullRequestClosed
+	default:
+		return nil, nil
+	}
+
+	return &GitEventData{
+		Type:              eventType,
+		Url:               util.CleanUpRepositoryUrl(webhookData.Project.WebURL) + ".git",
+		Branch:            webhookData.ObjectAttributes.SourceBranch,
+		Sha:               webhookData.ObjectAttributes.LastCommit.ID,
+		Owner:             webhookData.Project.Namespace,
+		PullRequestNumber: util.Pointer(uint32(webhookData.ObjectAttributes.IID)),
+		BaseBranch:        webhookData.ObjectAttributes.TargetBranch,
+		FromFork:          webhookData.ObjectAttributes.SourceProjectID != webhookData.ObjectAttributes.TargetProjectID,
+	}, nil
+}
+
 func (g *GitLabGitProvider) FormatError(err error) error {
 	re := regexp.MustCompile(`([A-Z]+)\s(https:\/\/\S+):\s(\d{3})\s(\{message:\s\d{3}\s.+\})`)
 	match := re.FindStringSubmatch(err.Error())
@@ -4,6 +4,9 @@
 package gitprovider
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -288,6 +291,56 @@ func (g *GitLabGitProviderTestSuite) TestGetUrlFromRepo_Commit() {
 	require.Equal("https://gitlab.com/daytonaio/daytona/-/commit/COMMIT_SHA", url)
 }
 
+func (g *GitLabGitProviderTestSuite) TestParseEventData_MergeRequest() {
+	payload := `{
+		"object_kind": "merge_request",
+		"project": {
+			"namespace": "daytonaio",
+			"web_url": "https://gitlab.com/daytonaio/daytona"
+		},
+		"object_attributes": {
+			"iid": 3,
+			"action": "merge",
+			"source_branch": "feature",
+			"target_branch": "main",
+			"source_project_id": 5,
+			"target_project_id": 4,
+			"last_commit": {"id": "HEAD_SHA"}
+		}
+	}`
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	request.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+
+	require := g.Require()
+
+	data, err := g.gitProvider.ParseEventData(request)
+	require.Nil(err)
+	require.Equal(&GitEventData{
+		Type:              GitEventTypePullRequestClosed,
+		Url:               "https://gitlab.com/daytonaio/daytona.git",
+		Branch:            "feature",
+		Sha:               "HEAD_SHA",
+		Owner:             "daytonaio",
+		PullRequestNumber: util.Pointer(uint32(3)),
+		BaseBranch:        "main",
+		FromFork:          true,
+	}, data)
+}
+
+func (g *GitLabGitProviderTestSuite) TestVerifyEventSignature() {
+	require := g.Require()
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("{}"))
+	request.Header.Set("X-Gitlab-Token", "s3cret")
+	require.Nil(g.gitProvider.VerifyEventSignature(request, "s3cret"))
+	require.True(IsInvalidWebhookSignature(g.gitProvider.VerifyEventSignature(request, "other")))
+
+	// Webhooks without a token are rejected even if the secret is not set
+	request = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("{}"))
+	require.True(IsInvalidWebhookSignature(g.gitProvider.VerifyEventSignature(request, "")))
+}
+
 func TestGitLabGitProvider(t *testing.T) {
 	suite.Run(t, NewGitLabGitProviderTestSuite())
 }
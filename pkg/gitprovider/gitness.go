@@ -299,14 +299,36 @@ func (g *GitnessGitProvider) GetDefaultBranch(staticContext *StaticGitContext) (
 	return client.GetDefaultBranch(staticContext.Url)
 }
 
-func (g *GitnessGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string) (string, error) {
+func (g *GitnessGitProvider) SupportsCommitStatus() bool {
+	return true
+}
+
+func (g *GitnessGitProvider) SetCommitStatus(repo *GitRepository, sha string, state CommitStatus, description, targetUrl string) error {
+	status := string(state)
+	if state == CommitStatusPending {
+		status = "running"
+	}
+
+	client := g.getApiClient()
+	return client.ReportCommitCheck(repo.Id, repo.Owner, sha, gitnessclient.CommitCheck{
+		Identifier: strings.ReplaceAll(commitStatusContext, "/", "-"),
+		Status:     status,
+		Summary:    description,
+		Link:       targetUrl,
+	})
+}
+
+var gitnessPrebuildWebhookTriggers = []string{"branch_updated", "pullreq_created", "pullreq_reopened", "pullreq_branch_updated", "pullreq_closed", "pullreq_merged"}
+
+func (g *GitnessGitProvider) RegisterPrebuildWebhook(repo *GitRepository, endpointUrl string, secret string) (string, error) {
 	client := g.getApiClient()
 	webhook, err := client.CreateWebhook(repo.Id, repo.Owner, gitnessclient.Webhook{
-		Triggers:    []string{"branch_updated"},
+		Triggers:    gitnessPrebuildWebhookTriggers,
 		Url:         endpointUrl,
 		Identifier:  "daytona-webhook_" + repo.Id,
 		DisplayName: "Daytona Webhook",
 		Enabled:     true,
+		Secret:      secret,
 	})
 	if err != nil {
 		return "", err
@@ -328,6 +350,16 @@ func (g *GitnessGitProvider) GetPrebuildWebhook(repo *GitRepository, endpointUrl
 	return nil, nil
 }
 
+func (g *GitnessGitProvider) UpdatePrebuildWebhook(repo *GitRepository, id string, endpointUrl string, secret string) error {
+	client := g.getApiClient()
+	return client.UpdateWebhook(repo.Id, repo.Owner, id, gitnessclient.Webhook{
+		Triggers: gitnessPrebuildWebhookTriggers,
+		Url:      endpointUrl,
+		Enabled:  true,
+		Secret:   secret,
+	})
+}
+
 func (g *GitnessGitProvider) UnregisterPrebuildWebhook(repo *GitRepository, id string) error {
 	client := g.getApiClient()
 	return client.DeleteWebhook(repo.Id, repo.Owner, id)
@@ -362,6 +394,10 @@ func (g *GitnessGitProvider) GetCommitsRange(repo *GitRepository, initialSha str
 	return commitLength, nil
 }
 
+func (g *GitnessGitProvider) VerifyEventSignature(request *http.Request, secret string) error {
+	return verifyPayloadSignature(request, request.Header.Get("X-Gitness-Signature"), secret)
+}
+
 func (g *GitnessGitProvider) ParseEventData(request *http.Request) (*GitEventData, error) {
 	payload, err := io.ReadAll(request.Body)
 	if err != nil {
@@ -373,11 +409,35 @@ func (g *GitnessGitProvider) ParseEventData(request *http.Request) (*GitEventDat
 		return nil, err
 	}
 
-	if webhookEvent.Trigger != "branch_updated" {
+	var pullRequestEventType GitEventType
+
+	switch webhookEvent.Trigger {
+	case "branch_updated":
+	case "pullreq_created", "pullreq_reopened":
+		pullRequestEventType = GitEventTypePullRequestOpened
+	case "pullreq_branch_updated":
+		pullRequestEventType = GitEventTypePullRequestSynchronized
+	case "pullreq_closed", "pullreq_merged":
+		pullRequestEventType = GitEventTypePullRequestClosed
+	default:
 		return nil, nil
 	}
 
+	if pullRequestEventType != "" {
+		return &GitEventData{
+			Type:              pullRequestEventType,
+			Url:               util.CleanUpRepositoryUrl(webhookEvent.Repo.GitURL),
+			Branch:            webhookEvent.PullReq.SourceBranch,
+			Sha:               webhookEvent.Sha,
+			Owner:             webhookEvent.Principal.DisplayName,
+			PullRequestNumber: util.Pointer(uint32(webhookEvent.PullReq.Number)),
+			BaseBranch:        webhookEvent.PullReq.TargetBranch,
+			FromFork:          webhookEvent.PullReq.SourceRepoID != webhookEvent.PullReq.TargetRepoID,
+		}, nil
+	}
+
 	gitEventData := &GitEventData{
+		Type:   GitEventTypePush,
 		Url:    util.CleanUpRepositoryUrl(webhookEvent.Repo.GitURL),
 		Branch: strings.TrimPrefix(webhookEvent.Ref.Name, "refs/heads/"),
 		Sha:    webhookEvent.Sha,
@@ -389,6 +389,87 @@ func (g *GitnessClient) CreateWebhook(repoId string, namespaceId string, webhook
 	return &newWebhook, nil
 }
 
+func (g *GitnessClient) ReportCommitCheck(repoId string, namespaceId string, sha string, check CommitCheck) error {
+	checkEndpoint, parseErr := g.BaseURL.Parse(fmt.Sprintf("/api/v1/repos/%s/checks/commits/%s", url.PathEscape(namespaceId+"/"+repoId), url.PathEscape(sha)))
+	if parseErr != nil {
+		return parseErr
+	}
+
+	jsonData, err := json.Marshal(check)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", checkEndpoint.String(), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.token)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("status code: %d err: %s", resp.StatusCode, string(responseData))
+	}
+
+	return nil
+}
+
+func (g *GitnessClient) UpdateWebhook(repoId string, namespaceId string, webhookId string, webhook Webhook) error {
+	webhookEndpoint, parseErr := g.BaseURL.Parse(fmt.Sprintf("/api/v1/repos/%s/webhooks/%s", url.PathEscape(namespaceId+"/"+repoId), webhookId))
+	if parseErr != nil {
+		return parseErr
+	}
+
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"url":      webhook.Url,
+		"enabled":  webhook.Enabled,
+		"triggers": webhook.Triggers,
+		"secret":   webhook.Secret,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "PATCH", webhookEndpoint.String(), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.token)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("status code: %d err: %s", resp.StatusCode, string(responseData))
+	}
+
+	return nil
+}
+
 func (g *GitnessClient) DeleteWebhook(repoID string, namespaceId string, webhookID string) error {
 	webhookEndpoint, parseErr := g.BaseURL.Parse(fmt.Sprintf("/api/v1/repos/%s/webhooks/%s", url.PathEscape(namespaceId+"/"+repoID), webhookID))
 	if parseErr != nil {
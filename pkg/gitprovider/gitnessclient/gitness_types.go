@@ -203,6 +203,7 @@ type Webhook struct {
 	Insecure    bool     `json:"insecure"`
 	Triggers    []string `json:"triggers"`
 	HasSecret   bool     `json:"has_secret"`
+	Secret      string   `json:"secret,omitempty"`
 	Uid         string   `json:"uid"`
 }
 
@@ -306,7 +307,22 @@ type WebhookEventData struct {
 		UID           string `json:"uid"`
 		URL           string `json:"url"`
 	} `json:"repo"`
+	PullReq struct {
+		Number       int64  `json:"number"`
+		State        string `json:"state"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		SourceRepoID int    `json:"source_repo_id"`
+		TargetRepoID int    `json:"target_repo_id"`
+	} `json:"pull_req"`
 	Sha               string `json:"sha"`
 	TotalCommitsCount int    `json:"total_commits_count"`
 	Trigger           string `json:"trigger"`
 }
+
+type CommitCheck struct {
+	Identifier string `json:"identifier"`
+	Status     string `json:"status"`
+	Summary    string `json:"summary"`
+	Link       string `json:"link,omitempty"`
+}
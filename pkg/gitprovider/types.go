@@ -19,6 +19,8 @@ type GitProviderConfig struct {
 	Alias         string         `json:"alias" validate:"required"`
 	SigningKey    *string        `json:"signingKey,omitempty" validate:"optional"`
 	SigningMethod *SigningMethod `json:"signingMethod,omitempty" validate:"optional"`
+	// ID of the user that owns the Git provider config
+	OwnerId string `json:"ownerId,omitempty" validate:"optional"`
 } // @name GitProvider
 
 type GitUser struct {
@@ -68,10 +70,45 @@ type GitPullRequest struct {
 	SourceRepoName  string `json:"sourceRepoName" validate:"required"`
 } // @name GitPullRequest
 
+type CommitStatus string
+
+const (
+	CommitStatusPending CommitStatus = "pending"
+	CommitStatusSuccess CommitStatus = "success"
+	CommitStatusError   CommitStatus = "error"
+)
+
+// Name under which Daytona reports commit statuses to git providers
+const commitStatusContext = "daytona/prebuild"
+
+type GitEventType string
+
+const (
+	GitEventTypePush                    GitEventType = "push"
+	GitEventTypePullRequestOpened       GitEventType = "pull_request.opened"
+	GitEventTypePullRequestSynchronized GitEventType = "pull_request.synchronized"
+	GitEventTypePullRequestClosed       GitEventType = "pull_request.closed"
+)
+
+// For pull request events, Branch and Sha describe the head of the pull request
+// while BaseBranch is the branch it is going to be merged into
 type GitEventData struct {
-	Url           string   `json:"url" validate:"required"`
-	Branch        string   `json:"branch" validate:"required"`
-	Sha           string   `json:"sha" validate:"required"`
-	Owner         string   `json:"user" validate:"required"`
-	AffectedFiles []string `json:"affectedFiles" validate:"required"`
+	Type              GitEventType `json:"type" validate:"required"`
+	Url               string       `json:"url" validate:"required"`
+	Branch            string       `json:"branch" validate:"required"`
+	Sha               string       `json:"sha" validate:"required"`
+	Owner             string       `json:"user" validate:"required"`
+	AffectedFiles     []string     `json:"affectedFiles" validate:"required"`
+	PullRequestNumber *uint32      `json:"pullRequestNumber,omitempty" validate:"optional"`
+	BaseBranch        string       `json:"baseBranch,omitempty" validate:"optional"`
+	// Set for pull requests whose head branch is in a different repository than the base branch
+	FromFork bool `json:"fromFork,omitempty" validate:"optional"`
 } //	@name	GitEventData
+
+func (d *GitEventData) IsPullRequest() bool {
+	switch d.Type {
+	case GitEventTypePullRequestOpened, GitEventTypePullRequestSynchronized, GitEventTypePullRequestClosed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+)
+
+var (
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+)
+
+func IsInvalidWebhookSignature(err error) bool {
+	return errors.Is(err, ErrInvalidWebhookSignature)
+}
+
+// readRequestBody returns the body of the request and restores it so that the event can be parsed afterwards
+func readRequestBody(request *http.Request) ([]byte, error) {
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body = io.NopCloser(bytes.NewReader(payload))
+
+	return payload, nil
+}
+
+// verifyPayloadSignature checks that the signature is the hex encoded HMAC-SHA256 of the request body
+func verifyPayloadSignature(request *http.Request, signature string, secret string) error {
+	if secret == "" || signature == "" {
+		return ErrInvalidWebhookSignature
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidWebhookSignature
+	}
+
+	payload, err := readRequestBody(request)
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
+
+// verifyWebhookToken checks a secret that git providers send as is instead of signing the payload with it
+func verifyWebhookToken(token string, secret string) error {
+	if secret == "" || subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrInvalidWebhookSignature
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -40,6 +41,7 @@ func (bl *buildLogger) Write(p []byte) (n int, err error) {
 	entry.Msg = string(p)
 	entry.Source = string(bl.source)
 	entry.BuildId = &bl.buildId
+	entry.Time = time.Now().Format(time.RFC3339)
 
 	b, err := json.Marshal(entry)
 	if err != nil {
@@ -31,6 +31,11 @@ type Provider interface {
 	StopProject(*ProjectRequest) (*util.Empty, error)
 	DestroyProject(*ProjectRequest) (*util.Empty, error)
 	GetProjectInfo(*ProjectRequest) (*project.ProjectInfo, error)
+
+	SnapshotProject(*ProjectSnapshotRequest) (*util.Empty, error)
+	RestoreProject(*ProjectSnapshotRequest) (*util.Empty, error)
+	DeleteSnapshot(*ProjectSnapshotRequest) (*util.Empty, error)
+	ListSnapshots(*ProjectRequest) (*[]string, error)
 }
 
 type ProviderPlugin struct {
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
+	log "github.com/sirupsen/logrus"
+)
+
+// Target options that set the default and maximum resources of the projects created on a target
+const (
+	TargetOptionDefaultCpus      = "Default CPUs"
+	TargetOptionDefaultMemory    = "Default Memory"
+	TargetOptionDefaultDisk      = "Default Disk"
+	TargetOptionDefaultPidsLimit = "Default Pids Limit"
+	TargetOptionMaxCpus          = "Max CPUs"
+	TargetOptionMaxMemory        = "Max Memory"
+	TargetOptionMaxDisk          = "Max Disk"
+	TargetOptionMaxPidsLimit     = "Max Pids Limit"
+)
+
+type TargetResourceLimits struct {
+	// Resources of projects that do not set them
+	Defaults resources.Resources
+	// Upper bound of the project resources. Unset values are not bounded.
+	Maxima resources.Resources
+}
+
+// Returns the target manifest properties that set the project resource limits of a target
+func GetResourceLimitsManifest() ProviderTargetManifest {
+	return ProviderTargetManifest{
+		TargetOptionDefaultCpus: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeFloat,
+			Description: "Number of CPU cores of projects that do not set them. Leave empty for no limit.",
+		},
+		TargetOptionDefaultMemory: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Memory limit in MB of projects that do not set it. Leave empty for no limit.",
+		},
+		TargetOptionDefaultDisk: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Disk quota in GB of projects that do not set it. Leave empty for no limit.",
+		},
+		TargetOptionDefaultPidsLimit: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Maximum number of processes of projects that do not set it. Leave empty for no limit.",
+		},
+		TargetOptionMaxCpus: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeFloat,
+			Description: "Maximum number of CPU cores a project can use. Leave empty for no maximum.",
+		},
+		TargetOptionMaxMemory: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Maximum memory limit in MB a project can use. Leave empty for no maximum.",
+		},
+		TargetOptionMaxDisk: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Maximum disk quota in GB a project can use. Leave empty for no maximum.",
+		},
+		TargetOptionMaxPidsLimit: ProviderTargetProperty{
+			Type:        ProviderTargetPropertyTypeInt,
+			Description: "Maximum number of processes a project can run. Leave empty for no maximum.",
+		},
+	}
+}
+
+// Adds the resource limit properties to the target manifest of a provider so the limits
+// can be set on the targets of every provider. Properties defined by the provider are kept.
+func WithResourceLimits(manifest *ProviderTargetManifest) *ProviderTargetManifest {
+	merged := ProviderTargetManifest{}
+	for name, property := range GetResourceLimitsManifest() {
+		merged[name] = property
+	}
+
+	if manifest != nil {
+		for name, property := range *manifest {
+			merged[name] = property
+		}
+	}
+
+	return &merged
+}
+
+// Reads the project resource limits from the JSON encoded target options.
+// Target options are defined by the provider so options that can not be read set no limits.
+func GetTargetResourceLimits(targetOptions string) *TargetResourceLimits {
+	options := map[string]interface{}{}
+
+	err := json.Unmarshal([]byte(targetOptions), &options)
+	if err != nil {
+		log.Tracef("failed to read resource limits from target options: %v", err)
+	}
+
+	getOption := func(name string) float64 {
+		value, _ := options[name].(float64)
+		return value
+	}
+
+	return &TargetResourceLimits{
+		Defaults: resources.Resources{
+			Cpus:      getOption(TargetOptionDefaultCpus),
+			Memory:    int(getOption(TargetOptionDefaultMemory)),
+			Disk:      int(getOption(TargetOptionDefaultDisk)),
+			PidsLimit: int(getOption(TargetOptionDefaultPidsLimit)),
+		},
+		Maxima: resources.Resources{
+			Cpus:      getOption(TargetOptionMaxCpus),
+			Memory:    int(getOption(TargetOptionMaxMemory)),
+			Disk:      int(getOption(TargetOptionMaxDisk)),
+			PidsLimit: int(getOption(TargetOptionMaxPidsLimit)),
+		},
+	}
+}
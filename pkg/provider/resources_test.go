@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithResourceLimits(t *testing.T) {
+	manifest := &provider.ProviderTargetManifest{
+		"Sock Path": provider.ProviderTargetProperty{
+			Type: provider.ProviderTargetPropertyTypeString,
+		},
+		provider.TargetOptionMaxCpus: provider.ProviderTargetProperty{
+			Type:        provider.ProviderTargetPropertyTypeFloat,
+			Description: "Provider description",
+		},
+	}
+
+	merged := provider.WithResourceLimits(manifest)
+
+	require.Contains(t, *merged, "Sock Path")
+	for name := range provider.GetResourceLimitsManifest() {
+		require.Contains(t, *merged, name)
+	}
+	require.Equal(t, "Provider description", (*merged)[provider.TargetOptionMaxCpus].Description)
+	require.Len(t, *manifest, 2)
+}
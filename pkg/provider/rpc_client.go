@@ -96,3 +96,24 @@ func (m *ProviderRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*project
 	err := m.client.Call("Plugin.GetProjectInfo", projectReq, &resp)
 	return &resp, err
 }
+
+func (m *ProviderRPCClient) SnapshotProject(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.SnapshotProject", snapshotReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) RestoreProject(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.RestoreProject", snapshotReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) DeleteSnapshot(snapshotReq *ProjectSnapshotRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.DeleteSnapshot", snapshotReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) ListSnapshots(projectReq *ProjectRequest) (*[]string, error) {
+	var resp []string
+	err := m.client.Call("Plugin.ListSnapshots", projectReq, &resp)
+	return &resp, err
+}
@@ -116,3 +116,28 @@ func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *project.Pr
 	*resp = *info
 	return nil
 }
+
+func (m *ProviderRPCServer) SnapshotProject(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.SnapshotProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) RestoreProject(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.RestoreProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) DeleteSnapshot(arg *ProjectSnapshotRequest, resp *util.Empty) error {
+	_, err := m.Impl.DeleteSnapshot(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) ListSnapshots(arg *ProjectRequest, resp *[]string) error {
+	snapshots, err := m.Impl.ListSnapshots(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *snapshots
+	return nil
+}
@@ -45,6 +45,15 @@ type ProjectRequest struct {
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
 }
 
+type ProjectSnapshotRequest struct {
+	TargetOptions string
+	Project       *project.Project
+	SnapshotName  string
+	// Project the snapshot belongs to when restoring it into a different project.
+	// If nil, the snapshot belongs to Project
+	SourceProject *project.Project
+}
+
 type ProviderTarget struct {
 	Name         string       `json:"name" validate:"required"`
 	ProviderInfo ProviderInfo `json:"providerInfo" validate:"required"`
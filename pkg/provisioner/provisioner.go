@@ -33,6 +33,11 @@ type IProvisioner interface {
 	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	StopProject(project *project.Project, target *provider.ProviderTarget) error
 	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	SnapshotProject(project *project.Project, target *provider.ProviderTarget, snapshotName string) error
+	RestoreProject(project *project.Project, target *provider.ProviderTarget, snapshotName string) error
+	CloneProject(source *project.Project, project *project.Project, target *provider.ProviderTarget, snapshotName string) error
+	DeleteSnapshot(project *project.Project, target *provider.ProviderTarget, snapshotName string) error
+	ListSnapshots(project *project.Project, target *provider.ProviderTarget) ([]string, error)
 }
 
 type ProvisionerConfig struct {
@@ -0,0 +1,87 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) SnapshotProject(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).SnapshotProject(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotName:  snapshotName,
+	})
+
+	return err
+}
+
+func (p *Provisioner) RestoreProject(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).RestoreProject(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotName:  snapshotName,
+	})
+
+	return err
+}
+
+func (p *Provisioner) CloneProject(source *project.Project, proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).RestoreProject(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotName:  snapshotName,
+		SourceProject: source,
+	})
+
+	return err
+}
+
+func (p *Provisioner) DeleteSnapshot(proj *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).DeleteSnapshot(&provider.ProjectSnapshotRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		SnapshotName:  snapshotName,
+	})
+
+	return err
+}
+
+func (p *Provisioner) ListSnapshots(proj *project.Project, target *provider.ProviderTarget) ([]string, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := (*targetProvider).ListSnapshots(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *snapshots, nil
+}
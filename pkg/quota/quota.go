@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"fmt"
+)
+
+// Name of the quota that applies to client API keys and users without a quota of their own
+const DefaultQuotaName = "*"
+
+// Limits the workspaces and resources of the workspaces of a user or of a client API key without a user.
+// A quota is set either for a client API key or for a user. Zero values are not limited.
+type Quota struct {
+	// Name of the client API key or "*" for the default quota. Empty for the quota of a user
+	ApiKeyName string `json:"apiKeyName,omitempty" validate:"optional"`
+	// ID of the user whose workspaces are limited across all of their API keys
+	UserId string `json:"userId,omitempty" validate:"optional"`
+	// Maximum number of workspaces
+	MaxWorkspaces int `json:"maxWorkspaces,omitempty" validate:"optional"`
+	// Maximum number of running projects
+	MaxRunningProjects int `json:"maxRunningProjects,omitempty" validate:"optional"`
+	// Maximum sum of CPU cores requested by running projects
+	MaxCpus float64 `json:"maxCpus,omitempty" validate:"optional"`
+	// Maximum sum of memory in MB requested by running projects
+	MaxMemory int `json:"maxMemory,omitempty" validate:"optional"`
+	// Maximum sum of disk in GB requested by all projects
+	MaxDisk int `json:"maxDisk,omitempty" validate:"optional"`
+} // @name Quota
+
+// Subject identifies whose usage counts towards a quota. Workspaces are counted per user
+// and only per client API key for API keys without a user, e.g. the ones of OIDC sessions
+// are all counted for their user.
+type Subject struct {
+	ApiKeyName string
+	UserId     string
+}
+
+// Requests that were not made with a client API key have no subject and are not limited
+func (s Subject) IsEmpty() bool {
+	return s.ApiKeyName == "" && s.UserId == ""
+}
+
+// Matches returns true if a workspace with the given creator API key and owner counts towards the usage of the subject
+func (s Subject) Matches(createdBy, ownerId string) bool {
+	if s.UserId != "" {
+		return ownerId == s.UserId
+	}
+
+	return ownerId == "" && createdBy == s.ApiKeyName
+}
+
+// Key identifies the usage of the subject, e.g. to serialize the operations that are checked against it
+func (s Subject) Key() string {
+	if s.UserId != "" {
+		return "user/" + s.UserId
+	}
+
+	return "apikey/" + s.ApiKeyName
+}
+
+// Usage counts the workspaces and resource requests that count towards a quota
+type Usage struct {
+	Workspaces      int     `json:"workspaces" validate:"required"`
+	RunningProjects int     `json:"runningProjects" validate:"required"`
+	Cpus            float64 `json:"cpus" validate:"required"`
+	Memory          int     `json:"memory" validate:"required"`
+	Disk            int     `json:"disk" validate:"required"`
+} // @name QuotaUsage
+
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Workspaces:      u.Workspaces + other.Workspaces,
+		RunningProjects: u.RunningProjects + other.RunningProjects,
+		Cpus:            u.Cpus + other.Cpus,
+		Memory:          u.Memory + other.Memory,
+		Disk:            u.Disk + other.Disk,
+	}
+}
+
+func (q *Quota) IsDefault() bool {
+	return q.ApiKeyName == DefaultQuotaName
+}
+
+func (q *Quota) Validate() error {
+	if (q.ApiKeyName == "") == (q.UserId == "") {
+		return fmt.Errorf("%w: either the API key name or the user must be set", ErrInvalidQuota)
+	}
+
+	if q.MaxWorkspaces < 0 || q.MaxRunningProjects < 0 || q.MaxCpus < 0 || q.MaxMemory < 0 || q.MaxDisk < 0 {
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidQuota)
+	}
+
+	return nil
+}
+
+// Check returns ErrQuotaExceeded if the usage would exceed any of the limits
+func (q *Quota) Check(usage Usage) error {
+	if q.MaxWorkspaces > 0 && usage.Workspaces > q.MaxWorkspaces {
+		return fmt.Errorf("%w: workspace limit of %d reached", ErrQuotaExceeded, q.MaxWorkspaces)
+	}
+	if q.MaxRunningProjects > 0 && usage.RunningProjects > q.MaxRunningProjects {
+		return fmt.Errorf("%w: running project limit of %d reached", ErrQuotaExceeded, q.MaxRunningProjects)
+	}
+	if q.MaxCpus > 0 && usage.Cpus > q.MaxCpus {
+		return fmt.Errorf("%w: %g of %g CPUs would be requested", ErrQuotaExceeded, usage.Cpus, q.MaxCpus)
+	}
+	if q.MaxMemory > 0 && usage.Memory > q.MaxMemory {
+		return fmt.Errorf("%w: %d of %d MB memory would be requested", ErrQuotaExceeded, usage.Memory, q.MaxMemory)
+	}
+	if q.MaxDisk > 0 && usage.Disk > q.MaxDisk {
+		return fmt.Errorf("%w: %d of %d GB disk would be requested", ErrQuotaExceeded, usage.Disk, q.MaxDisk)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	unlimited := &Quota{ApiKeyName: DefaultQuotaName}
+	require.Nil(t, unlimited.Check(Usage{Workspaces: 100, RunningProjects: 100, Cpus: 100}))
+
+	q := &Quota{ApiKeyName: "test", MaxWorkspaces: 2, MaxRunningProjects: 3, MaxCpus: 4, MaxMemory: 4096, MaxDisk: 50}
+	require.Nil(t, q.Check(Usage{Workspaces: 2, RunningProjects: 3, Cpus: 4, Memory: 4096, Disk: 50}))
+
+	for _, usage := range []Usage{
+		{Workspaces: 3},
+		{RunningProjects: 4},
+		{Cpus: 4.5},
+		{Memory: 8192},
+		{Disk: 51},
+	} {
+		err := q.Check(usage)
+		require.NotNil(t, err)
+		require.True(t, IsQuotaExceeded(err))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.Nil(t, (&Quota{ApiKeyName: "test", MaxWorkspaces: 1}).Validate())
+	require.Nil(t, (&Quota{UserId: "user1", MaxWorkspaces: 1}).Validate())
+	require.True(t, IsInvalidQuota((&Quota{}).Validate()))
+	require.True(t, IsInvalidQuota((&Quota{ApiKeyName: "test", UserId: "user1"}).Validate()))
+	require.True(t, IsInvalidQuota((&Quota{ApiKeyName: "test", MaxCpus: -1}).Validate()))
+}
+
+func TestSubjectMatches(t *testing.T) {
+	userSubject := Subject{ApiKeyName: "oidc-session", UserId: "user1"}
+	require.True(t, userSubject.Matches("other-session", "user1"))
+	require.False(t, userSubject.Matches("oidc-session", "user2"))
+
+	apiKeySubject := Subject{ApiKeyName: "ci"}
+	require.True(t, apiKeySubject.Matches("ci", ""))
+	require.False(t, apiKeySubject.Matches("ci", "user1"))
+	require.False(t, apiKeySubject.Matches("other", ""))
+}
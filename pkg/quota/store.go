@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"errors"
+)
+
+type Store interface {
+	List() ([]*Quota, error)
+	Find(apiKeyName string) (*Quota, error)
+	FindForUser(userId string) (*Quota, error)
+	Save(quota *Quota) error
+	Delete(quota *Quota) error
+}
+
+var (
+	ErrQuotaNotFound = errors.New("quota not found")
+	ErrInvalidQuota  = errors.New("invalid quota")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+func IsQuotaNotFound(err error) bool {
+	return err.Error() == ErrQuotaNotFound.Error()
+}
+
+func IsInvalidQuota(err error) bool {
+	return errors.Is(err, ErrInvalidQuota)
+}
+
+func IsQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Secrets are referenced in project config env vars as ${secret:NAME} and resolved when projects are started
+var referenceRegex = regexp.MustCompile(`\$\{secret:([A-Za-z0-9_.-]+)\}`)
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+type Secret struct {
+	Name string `json:"name" validate:"required"`
+	// The value is never returned by the API
+	Value string `json:"-"`
+	// Secrets without an owner are shared by all users
+	OwnerId   string    `json:"ownerId,omitempty" validate:"optional"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+} // @name Secret
+
+func IsValidName(name string) bool {
+	return nameRegex.MatchString(name)
+}
+
+// Reference returns the string that references the secret in env vars
+func Reference(name string) string {
+	return fmt.Sprintf("${secret:%s}", name)
+}
+
+// IsReference returns true if the value is a single secret reference
+func IsReference(value string) bool {
+	match := referenceRegex.FindStringIndex(value)
+	return match != nil && match[0] == 0 && match[1] == len(value)
+}
+
+// ResolveReferences returns a copy of the env vars with secret references replaced by the secret values.
+// The lookup returns ErrSecretNotFound for unknown secrets.
+func ResolveReferences(envVars map[string]string, lookup func(name string) (string, error)) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+
+	resolved := make(map[string]string, len(envVars))
+	for k, v := range envVars {
+		var lookupErr error
+		resolved[k] = referenceRegex.ReplaceAllStringFunc(v, func(reference string) string {
+			name := referenceRegex.FindStringSubmatch(reference)[1]
+			value, err := lookup(name)
+			if err != nil && lookupErr == nil {
+				lookupErr = fmt.Errorf("failed to resolve %s in env var %s: %w", reference, k, err)
+			}
+			return value
+		})
+
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+	}
+
+	return resolved, nil
+}
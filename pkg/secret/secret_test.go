@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveReferences(t *testing.T) {
+	secrets := map[string]string{
+		"NPM_TOKEN": "npm-token",
+		"db.pass":   "p4ss",
+	}
+	lookup := func(name string) (string, error) {
+		value, ok := secrets[name]
+		if !ok {
+			return "", ErrSecretNotFound
+		}
+		return value, nil
+	}
+
+	resolved, err := ResolveReferences(map[string]string{
+		"NPM_TOKEN":    "${secret:NPM_TOKEN}",
+		"DATABASE_URL": "postgres://user:${secret:db.pass}@db/app",
+		"PLAIN":        "value",
+		"NOT_A_REF":    "${NPM_TOKEN}",
+	}, lookup)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"NPM_TOKEN":    "npm-token",
+		"DATABASE_URL": "postgres://user:p4ss@db/app",
+		"PLAIN":        "value",
+		"NOT_A_REF":    "${NPM_TOKEN}",
+	}, resolved)
+
+	_, err = ResolveReferences(map[string]string{"TOKEN": "${secret:MISSING}"}, lookup)
+	require.True(t, IsSecretNotFound(err))
+
+	resolved, err = ResolveReferences(nil, lookup)
+	require.Nil(t, err)
+	require.Nil(t, resolved)
+}
+
+func TestIsReference(t *testing.T) {
+	require.True(t, IsReference("${secret:NPM_TOKEN}"))
+	require.False(t, IsReference("token ${secret:NPM_TOKEN}"))
+	require.False(t, IsReference("${secret:A}${secret:B}x"))
+	require.False(t, IsReference("plain"))
+	require.Equal(t, "${secret:NPM_TOKEN}", Reference("NPM_TOKEN"))
+}
+
+func TestIsValidName(t *testing.T) {
+	require.True(t, IsValidName("NPM_TOKEN"))
+	require.True(t, IsValidName("db.password-1"))
+	require.False(t, IsValidName(""))
+	require.False(t, IsValidName("NPM TOKEN"))
+	require.False(t, IsValidName("${secret:A}"))
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import "errors"
+
+type Store interface {
+	List() ([]*Secret, error)
+	Find(name string) (*Secret, error)
+	Save(secret *Secret) error
+	Delete(secret *Secret) error
+}
+
+var (
+	ErrSecretNotFound = errors.New("secret not found")
+)
+
+func IsSecretNotFound(err error) bool {
+	return errors.Is(err, ErrSecretNotFound)
+}
@@ -4,8 +4,11 @@
 package apikeys
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
 )
 
 func (s *ApiKeyService) ListClientKeys() ([]*apikey.ApiKey, error) {
@@ -35,14 +38,46 @@ func (s *ApiKeyService) Revoke(name string) error {
 }
 
 func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string, error) {
-	key := apikeys.GenerateRandomKey()
+	apiKey := &apikey.ApiKey{
+		Type: keyType,
+		Name: name,
+	}
+
+	if keyType == apikey.ApiKeyTypeClient {
+		apiKey.Role = apikey.ApiKeyRoleAdmin
+	}
+
+	return s.generate(apiKey)
+}
+
+func (s *ApiKeyService) GenerateClientKey(name string, req dto.GenerateApiKeyDTO) (string, error) {
+	if !apikey.IsValidApiKeyRole(req.Role) {
+		return "", ErrInvalidApiKeyRole
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return "", ErrInvalidExpiry
+	}
 
 	apiKey := &apikey.ApiKey{
-		KeyHash: apikeys.HashKey(key),
-		Type:    keyType,
-		Name:    name,
+		Type:      apikey.ApiKeyTypeClient,
+		Name:      name,
+		Role:      req.Role,
+		Scope:     req.Scope,
+		ExpiresAt: req.ExpiresAt,
+	}
+
+	if req.UserId != nil {
+		apiKey.UserId = *req.UserId
 	}
 
+	return s.generate(apiKey)
+}
+
+func (s *ApiKeyService) generate(apiKey *apikey.ApiKey) (string, error) {
+	key := apikeys.GenerateRandomKey()
+	apiKey.KeyHash = apikeys.HashKey(key)
+
 	err := s.apiKeyStore.Save(apiKey)
 	if err != nil {
 		return "", err
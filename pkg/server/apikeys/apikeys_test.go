@@ -3,7 +3,13 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+)
 
 func (s *ApiKeyServiceTestSuite) TestListClientKeys() {
 	expectedKeys := []*apikey.ApiKey{}
@@ -70,3 +76,69 @@ func (s *ApiKeyServiceTestSuite) TestGenerate() {
 	require.Nil(err)
 	require.ElementsMatch(expectedKeys, apiKeys)
 }
+
+func (s *ApiKeyServiceTestSuite) TestGenerateClientKey() {
+	keyName := "ci"
+
+	require := s.Require()
+
+	expiresAt := time.Now().Add(time.Hour)
+	scope := &apikey.ApiKeyScope{
+		WorkspaceIds: []string{"workspace1"},
+	}
+
+	_, err := s.apiKeyService.GenerateClientKey(keyName, dto.GenerateApiKeyDTO{
+		Role:      apikey.ApiKeyRoleCI,
+		Scope:     scope,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyTypeClient, apiKey.Type)
+	require.Equal(apikey.ApiKeyRoleCI, apiKey.Role)
+	require.Equal(scope, apiKey.Scope)
+	require.Equal(expiresAt, *apiKey.ExpiresAt)
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerateClientKey_InvalidExpiry() {
+	expiresAt := time.Now().Add(-time.Hour)
+
+	require := s.Require()
+
+	_, err := s.apiKeyService.GenerateClientKey("expired", dto.GenerateApiKeyDTO{
+		Role:      apikey.ApiKeyRoleAdmin,
+		ExpiresAt: &expiresAt,
+	})
+	require.True(apikeys.IsInvalidExpiry(err))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerateClientKey_InvalidRole() {
+	keyName := "invalid"
+
+	require := s.Require()
+
+	_, err := s.apiKeyService.GenerateClientKey(keyName, dto.GenerateApiKeyDTO{
+		Role: apikey.ApiKeyRole("owner"),
+	})
+	require.True(apikeys.IsInvalidApiKeyRole(err))
+
+	apiKeys, err := s.apiKeyStore.List()
+	require.Nil(err)
+	for _, apiKey := range apiKeys {
+		require.NotEqual(keyName, apiKey.Name)
+	}
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerate_DefaultRole() {
+	require := s.Require()
+
+	clientKey, err := s.apiKeyStore.FindByName(clientKeyNames[0])
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyRoleAdmin, clientKey.Role)
+
+	projectKey, err := s.apiKeyStore.FindByName(projectKeyNames[0])
+	require.Nil(err)
+	require.Empty(projectKey.Role)
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
+
+type GenerateApiKeyDTO struct {
+	Role      apikey.ApiKeyRole   `json:"role" validate:"optional"`
+	Scope     *apikey.ApiKeyScope `json:"scope" validate:"optional"`
+	ExpiresAt *time.Time          `json:"expiresAt" validate:"optional"`
+	// ID or name of the user that owns the API key
+	UserId *string `json:"userId,omitempty" validate:"optional"`
+} // @name GenerateApiKeyDTO
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikeys
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidApiKeyRole = errors.New("invalid API key role. Valid roles are: admin, developer, read-only, ci")
+	ErrInvalidExpiry     = errors.New("API key expiry must be in the future")
+)
+
+func IsInvalidApiKeyRole(err error) bool {
+	return err.Error() == ErrInvalidApiKeyRole.Error()
+}
+
+func IsInvalidExpiry(err error) bool {
+	return err.Error() == ErrInvalidExpiry.Error()
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikeys
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/pkg/build"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const expiredKeyPurgeInterval = "0 */5 * * * *" // every 5 minutes
+
+// Keys are used on every request so the last use is persisted at most once per interval
+const lastUsedRecordInterval = time.Minute
+
+func (s *ApiKeyService) UpdateLastUsed(apiKey string) error {
+	key, err := s.apiKeyStore.Find(apikeys.HashKey(apiKey))
+	if err != nil {
+		return err
+	}
+
+	if key.LastUsedAt != nil && time.Since(*key.LastUsedAt) < lastUsedRecordInterval {
+		return nil
+	}
+
+	now := time.Now()
+	key.LastUsedAt = &now
+
+	return s.apiKeyStore.Save(key)
+}
+
+func (s *ApiKeyService) PurgeExpiredKeys() error {
+	keys, err := s.apiKeyStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if !key.IsExpired() {
+			continue
+		}
+
+		log.Infof("Purging expired API key %s", key.Name)
+
+		err := s.apiKeyStore.Delete(key)
+		if err != nil {
+			log.Errorf("Failed to purge expired API key %s: %s", key.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *ApiKeyService) StartExpiredKeyPurger() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(expiredKeyPurgeInterval, func() {
+		err := s.PurgeExpiredKeys()
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apikeys_test
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+)
+
+func (s *ApiKeyServiceTestSuite) TestUpdateLastUsed() {
+	keyName := "lastUsed"
+
+	require := s.Require()
+
+	key, err := s.apiKeyService.GenerateClientKey(keyName, dto.GenerateApiKeyDTO{
+		Role: apikey.ApiKeyRoleAdmin,
+	})
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	require.Nil(apiKey.LastUsedAt)
+
+	err = s.apiKeyService.UpdateLastUsed(key)
+	require.Nil(err)
+
+	apiKey, err = s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	require.NotNil(apiKey.LastUsedAt)
+	lastUsedAt := *apiKey.LastUsedAt
+
+	// Consecutive uses within the record interval are not persisted
+	err = s.apiKeyService.UpdateLastUsed(key)
+	require.Nil(err)
+
+	apiKey, err = s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	require.Equal(lastUsedAt, *apiKey.LastUsedAt)
+}
+
+func (s *ApiKeyServiceTestSuite) TestPurgeExpiredKeys() {
+	require := s.Require()
+
+	expiresAt := time.Now().Add(time.Hour)
+	_, err := s.apiKeyService.GenerateClientKey("expired", dto.GenerateApiKeyDTO{
+		Role:      apikey.ApiKeyRoleAdmin,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(err)
+
+	_, err = s.apiKeyService.GenerateClientKey("valid", dto.GenerateApiKeyDTO{
+		Role:      apikey.ApiKeyRoleAdmin,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(err)
+
+	expiredKey, err := s.apiKeyStore.FindByName("expired")
+	require.Nil(err)
+	expiredAt := time.Now().Add(-time.Minute)
+	expiredKey.ExpiresAt = &expiredAt
+
+	err = s.apiKeyService.PurgeExpiredKeys()
+	require.Nil(err)
+
+	keys, err := s.apiKeyStore.List()
+	require.Nil(err)
+
+	names := []string{}
+	for _, k := range keys {
+		names = append(names, k.Name)
+	}
+
+	require.NotContains(names, "expired")
+	require.Contains(names, "valid")
+	require.Len(keys, len(clientKeyNames)+len(projectKeyNames)+1)
+}
@@ -3,15 +3,23 @@
 
 package apikeys
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+)
 
 type IApiKeyService interface {
 	Generate(keyType apikey.ApiKeyType, name string) (string, error)
+	GenerateClientKey(name string, req dto.GenerateApiKeyDTO) (string, error)
+	GetApiKey(apiKey string) (*apikey.ApiKey, error)
 	IsProjectApiKey(apiKey string) bool
 	IsWorkspaceApiKey(apiKey string) bool
 	IsValidApiKey(apiKey string) bool
 	ListClientKeys() ([]*apikey.ApiKey, error)
+	PurgeExpiredKeys() error
 	Revoke(name string) error
+	StartExpiredKeyPurger() error
+	UpdateLastUsed(apiKey string) error
 }
 
 type ApiKeyServiceConfig struct {
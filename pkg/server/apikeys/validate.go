@@ -11,8 +11,16 @@ import (
 func (s *ApiKeyService) IsValidApiKey(apiKey string) bool {
 	keyHash := apikeys.HashKey(apiKey)
 
-	_, err := s.apiKeyStore.Find(keyHash)
-	return err == nil
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return false
+	}
+
+	return !key.IsExpired()
+}
+
+func (s *ApiKeyService) GetApiKey(apiKey string) (*apikey.ApiKey, error) {
+	return s.apiKeyStore.Find(apikeys.HashKey(apiKey))
 }
 
 func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
@@ -3,7 +3,12 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+)
 
 func (s *ApiKeyServiceTestSuite) TestIsValidKey_True() {
 	keyName := "api-key"
@@ -26,6 +31,27 @@ func (s *ApiKeyServiceTestSuite) TestIsValidKey_False() {
 	require.False(res)
 }
 
+func (s *ApiKeyServiceTestSuite) TestIsValidKey_Expired() {
+	keyName := "expiredKey"
+
+	require := s.Require()
+
+	expiresAt := time.Now().Add(time.Hour)
+	apiKey, err := s.apiKeyService.GenerateClientKey(keyName, dto.GenerateApiKeyDTO{
+		Role:      apikey.ApiKeyRoleAdmin,
+		ExpiresAt: &expiresAt,
+	})
+	require.Nil(err)
+	require.True(s.apiKeyService.IsValidApiKey(apiKey))
+
+	key, err := s.apiKeyStore.FindByName(keyName)
+	require.Nil(err)
+	expiredAt := time.Now().Add(-time.Minute)
+	key.ExpiresAt = &expiredAt
+
+	require.False(s.apiKeyService.IsValidApiKey(apiKey))
+}
+
 func (s *ApiKeyServiceTestSuite) TestIsProjectApiKey_True() {
 	keyName := "projectKey"
 
@@ -73,3 +99,22 @@ func (s *ApiKeyServiceTestSuite) TestIsWorkspaceApiKey_False() {
 	res := s.apiKeyService.IsWorkspaceApiKey(apiKey)
 	require.False(res)
 }
+
+func (s *ApiKeyServiceTestSuite) TestGetApiKey() {
+	keyName := "developerKey"
+
+	require := s.Require()
+
+	key, err := s.apiKeyService.GenerateClientKey(keyName, dto.GenerateApiKeyDTO{
+		Role: apikey.ApiKeyRoleDeveloper,
+	})
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyService.GetApiKey(key)
+	require.Nil(err)
+	require.Equal(keyName, apiKey.Name)
+	require.Equal(apikey.ApiKeyRoleDeveloper, apiKey.Role)
+
+	_, err = s.apiKeyService.GetApiKey("unknown")
+	require.True(apikey.IsApiKeyNotFound(err))
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+type IAuditService interface {
+	List(filter *audit.Filter) ([]*audit.AuditLog, error)
+	Record(auditLog *audit.AuditLog) error
+}
+
+type AuditServiceConfig struct {
+	AuditStore audit.Store
+}
+
+func NewAuditService(config AuditServiceConfig) IAuditService {
+	return &AuditService{
+		auditStore: config.AuditStore,
+	}
+}
+
+type AuditService struct {
+	auditStore audit.Store
+}
+
+func (s *AuditService) List(filter *audit.Filter) ([]*audit.AuditLog, error) {
+	return s.auditStore.List(filter)
+}
+
+func (s *AuditService) Record(auditLog *audit.AuditLog) error {
+	if auditLog.Id == "" {
+		auditLog.Id = stringid.TruncateID(stringid.GenerateRandomID())
+	}
+
+	if auditLog.CreatedAt.IsZero() {
+		auditLog.CreatedAt = time.Now()
+	}
+
+	return s.auditStore.Save(auditLog)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	t_audit "github.com/daytonaio/daytona/internal/testing/server/audit"
+	"github.com/daytonaio/daytona/pkg/audit"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditService(t *testing.T) {
+	auditService := audit_service.NewAuditService(audit_service.AuditServiceConfig{
+		AuditStore: t_audit.NewInMemoryAuditStore(),
+	})
+
+	start := time.Now()
+
+	records := []*audit.AuditLog{
+		{ApiKeyName: "admin", Method: http.MethodPost, Route: "/workspace/", Outcome: audit.OutcomeSuccess, StatusCode: 200, CreatedAt: start.Add(-2 * time.Hour)},
+		{ApiKeyName: "ci", Method: http.MethodDelete, Route: "/workspace/:workspaceId", ResourceId: "ws1", Outcome: audit.OutcomeSuccess, StatusCode: 200},
+		{ApiKeyName: "ci", Method: http.MethodPost, Route: "/server/config", Outcome: audit.OutcomeFailure, StatusCode: 403},
+	}
+
+	t.Run("Record", func(t *testing.T) {
+		for _, r := range records {
+			err := auditService.Record(r)
+			require.Nil(t, err)
+			require.NotEmpty(t, r.Id)
+			require.False(t, r.CreatedAt.IsZero())
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		auditLogs, err := auditService.List(nil)
+		require.Nil(t, err)
+		require.Len(t, auditLogs, len(records))
+		require.Equal(t, records[0].Id, auditLogs[len(auditLogs)-1].Id)
+	})
+
+	t.Run("List with filters", func(t *testing.T) {
+		apiKeyName := "ci"
+		auditLogs, err := auditService.List(&audit.Filter{ApiKeyName: &apiKeyName})
+		require.Nil(t, err)
+		require.Len(t, auditLogs, 2)
+
+		outcome := audit.OutcomeFailure
+		auditLogs, err = auditService.List(&audit.Filter{Outcome: &outcome})
+		require.Nil(t, err)
+		require.Len(t, auditLogs, 1)
+		require.Equal(t, "/server/config", auditLogs[0].Route)
+
+		since := start.Add(-time.Hour)
+		auditLogs, err = auditService.List(&audit.Filter{Since: &since})
+		require.Nil(t, err)
+		require.Len(t, auditLogs, 2)
+
+		limit := 1
+		auditLogs, err = auditService.List(&audit.Filter{Limit: &limit})
+		require.Nil(t, err)
+		require.Len(t, auditLogs, 1)
+	})
+}
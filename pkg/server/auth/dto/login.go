@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
+
+type DeviceLoginDTO struct {
+	DeviceCode string `json:"deviceCode" validate:"required"`
+	// Code the user enters at the verification URI
+	UserCode                string    `json:"userCode" validate:"required"`
+	VerificationUri         string    `json:"verificationUri" validate:"required"`
+	VerificationUriComplete string    `json:"verificationUriComplete,omitempty" validate:"optional"`
+	ExpiresAt               time.Time `json:"expiresAt" validate:"required"`
+	// Seconds to wait between polls for the login to complete
+	Interval int `json:"interval" validate:"required"`
+} // @name DeviceLoginDTO
+
+type CompleteDeviceLoginDTO struct {
+	DeviceCode string `json:"deviceCode" validate:"required"`
+} // @name CompleteDeviceLoginDTO
+
+type RefreshLoginDTO struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+} // @name RefreshLoginDTO
+
+type LoginDTO struct {
+	// Short-lived client API key of the user
+	ApiKey    string            `json:"apiKey" validate:"required"`
+	ExpiresAt time.Time         `json:"expiresAt" validate:"required"`
+	Role      apikey.ApiKeyRole `json:"role" validate:"required"`
+	// Name of the user that logged in
+	User string `json:"user" validate:"required"`
+	// Refresh token of the OIDC provider used to get a new API key before it expires
+	RefreshToken string `json:"refreshToken,omitempty" validate:"optional"`
+} // @name LoginDTO
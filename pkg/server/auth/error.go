@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+)
+
+var (
+	ErrOidcNotConfigured    = errors.New("OIDC login is not configured")
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("polling too frequently, slow down")
+	ErrLoginDenied          = errors.New("login denied")
+	ErrNoRole               = errors.New("none of the groups of the user is mapped to an API key role")
+)
+
+func IsOidcNotConfigured(err error) bool {
+	return errors.Is(err, ErrOidcNotConfigured)
+}
+
+func IsAuthorizationPending(err error) bool {
+	return errors.Is(err, ErrAuthorizationPending)
+}
+
+func IsSlowDown(err error) bool {
+	return errors.Is(err, ErrSlowDown)
+}
+
+func IsLoginDenied(err error) bool {
+	return errors.Is(err, ErrLoginDenied)
+}
+
+func IsNoRole(err error) bool {
+	return errors.Is(err, ErrNoRole)
+}
@@ -0,0 +1,332 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	apikeys_dto "github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+	"github.com/daytonaio/daytona/pkg/server/auth/dto"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/docker/docker/pkg/stringid"
+	"golang.org/x/oauth2"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	defaultGroupsClaim  = "groups"
+	defaultApiKeyTtl    = time.Hour
+)
+
+type IAuthService interface {
+	StartDeviceLogin(ctx context.Context) (*dto.DeviceLoginDTO, error)
+	CompleteDeviceLogin(ctx context.Context, deviceCode string) (*dto.LoginDTO, error)
+	RefreshLogin(ctx context.Context, refreshToken string) (*dto.LoginDTO, error)
+}
+
+type AuthServiceConfig struct {
+	// OIDC login is disabled if the issuer is empty
+	Issuer        string
+	ClientId      string
+	ClientSecret  string
+	Scopes        []string
+	GroupsClaim   string
+	RoleMapping   map[string]string
+	DefaultRole   string
+	ApiKeyTtl     time.Duration
+	ApiKeyService apikeys.IApiKeyService
+	UserService   users.IUserService
+}
+
+func NewAuthService(config AuthServiceConfig) IAuthService {
+	groupsClaim := config.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+
+	apiKeyTtl := config.ApiKeyTtl
+	if apiKeyTtl <= 0 {
+		apiKeyTtl = defaultApiKeyTtl
+	}
+
+	return &AuthService{
+		issuer:        config.Issuer,
+		clientId:      config.ClientId,
+		clientSecret:  config.ClientSecret,
+		scopes:        config.Scopes,
+		groupsClaim:   groupsClaim,
+		roleMapping:   config.RoleMapping,
+		defaultRole:   config.DefaultRole,
+		apiKeyTtl:     apiKeyTtl,
+		apiKeyService: config.ApiKeyService,
+		userService:   config.UserService,
+	}
+}
+
+type AuthService struct {
+	issuer        string
+	clientId      string
+	clientSecret  string
+	scopes        []string
+	groupsClaim   string
+	roleMapping   map[string]string
+	defaultRole   string
+	apiKeyTtl     time.Duration
+	apiKeyService apikeys.IApiKeyService
+	userService   users.IUserService
+
+	provider *oidc.Provider
+	mutex    sync.Mutex
+	// Serializes the creation of users so that concurrent logins do not create the same user twice
+	userMutex sync.Mutex
+}
+
+func (s *AuthService) StartDeviceLogin(ctx context.Context) (*dto.DeviceLoginDTO, error) {
+	config, err := s.getOAuth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceAuth, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device login: %w", err)
+	}
+
+	interval := int(deviceAuth.Interval)
+	if interval <= 0 {
+		interval = 5
+	}
+
+	return &dto.DeviceLoginDTO{
+		DeviceCode:              deviceAuth.DeviceCode,
+		UserCode:                deviceAuth.UserCode,
+		VerificationUri:         deviceAuth.VerificationURI,
+		VerificationUriComplete: deviceAuth.VerificationURIComplete,
+		ExpiresAt:               deviceAuth.Expiry,
+		Interval:                interval,
+	}, nil
+}
+
+// CompleteDeviceLogin polls the OIDC provider once and returns ErrAuthorizationPending until the user has logged in
+func (s *AuthService) CompleteDeviceLogin(ctx context.Context, deviceCode string) (*dto.LoginDTO, error) {
+	config, err := s.getOAuth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.Exchange(ctx, "",
+		oauth2.SetAuthURLParam("grant_type", deviceCodeGrantType),
+		oauth2.SetAuthURLParam("device_code", deviceCode),
+	)
+	if err != nil {
+		return nil, toLoginError(err)
+	}
+
+	return s.login(ctx, token)
+}
+
+func (s *AuthService) RefreshLogin(ctx context.Context, refreshToken string) (*dto.LoginDTO, error) {
+	config, err := s.getOAuth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := config.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, toLoginError(err)
+	}
+
+	return s.login(ctx, token)
+}
+
+// Verifies the ID token, finds or creates the user and generates a short-lived API key with the role mapped from the user's groups
+func (s *AuthService) login(ctx context.Context, token *oauth2.Token) (*dto.LoginDTO, error) {
+	provider, err := s.getProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIdToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIdToken == "" {
+		return nil, fmt.Errorf("%w: the OIDC provider did not return an ID token", ErrLoginDenied)
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: s.clientId}).Verify(ctx, rawIdToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLoginDenied, err)
+	}
+
+	claims := map[string]interface{}{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.getRole(getGroups(claims[s.groupsClaim]))
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.findOrCreateUser(idToken.Issuer, idToken.Subject, getUserName(idToken.Subject, claims))
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.apiKeyTtl)
+	apiKeyName := fmt.Sprintf("%s-%s", u.Name, stringid.TruncateID(stringid.GenerateRandomID()))
+
+	apiKey, err := s.apiKeyService.GenerateClientKey(apiKeyName, apikeys_dto.GenerateApiKeyDTO{
+		Role:      role,
+		ExpiresAt: &expiresAt,
+		UserId:    &u.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LoginDTO{
+		ApiKey:       apiKey,
+		ExpiresAt:    expiresAt,
+		Role:         role,
+		User:         u.Name,
+		RefreshToken: token.RefreshToken,
+	}, nil
+}
+
+// Roles from most to least privileged
+var rolePrecedence = []apikey.ApiKeyRole{
+	apikey.ApiKeyRoleAdmin,
+	apikey.ApiKeyRoleDeveloper,
+	apikey.ApiKeyRoleCI,
+	apikey.ApiKeyRoleReadOnly,
+}
+
+// Returns the most privileged role that any of the groups maps to, or the default role
+func (s *AuthService) getRole(groups []string) (apikey.ApiKeyRole, error) {
+	mappedRoles := map[apikey.ApiKeyRole]bool{}
+	for _, group := range groups {
+		if role, ok := s.roleMapping[group]; ok {
+			mappedRoles[apikey.ApiKeyRole(role)] = true
+		}
+	}
+
+	for _, role := range rolePrecedence {
+		if mappedRoles[role] {
+			return role, nil
+		}
+	}
+
+	if s.defaultRole != "" {
+		return apikey.ApiKeyRole(s.defaultRole), nil
+	}
+
+	return "", ErrNoRole
+}
+
+// Users are identified by the issuer and subject of the ID token since the name claims can be changed by users.
+// The name is only used when the user is created.
+func (s *AuthService) findOrCreateUser(issuer, subject, name string) (*user.User, error) {
+	s.userMutex.Lock()
+	defer s.userMutex.Unlock()
+
+	u, err := s.userService.FindByIdentity(issuer, subject)
+	if err == nil {
+		return u, nil
+	}
+	if !user.IsUserNotFound(err) {
+		return nil, err
+	}
+
+	return s.userService.CreateForIdentity(name, issuer, subject)
+}
+
+func (s *AuthService) getOAuth2Config(ctx context.Context) (*oauth2.Config, error) {
+	provider, err := s.getProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     s.clientId,
+		ClientSecret: s.clientSecret,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID, "profile", "email"}, s.scopes...),
+	}, nil
+}
+
+// The provider is discovered on first use so that the server starts while the OIDC provider is unreachable
+func (s *AuthService) getProvider(ctx context.Context) (*oidc.Provider, error) {
+	if s.issuer == "" {
+		return nil, ErrOidcNotConfigured
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.provider != nil {
+		return s.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, s.issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider %s: %w", s.issuer, err)
+	}
+
+	s.provider = provider
+	return provider, nil
+}
+
+// Maps the RFC 8628 and RFC 6749 error codes of the OIDC provider to login errors
+func toLoginError(err error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		return err
+	}
+
+	switch retrieveErr.ErrorCode {
+	case "authorization_pending":
+		return ErrAuthorizationPending
+	case "slow_down":
+		return ErrSlowDown
+	case "access_denied", "expired_token", "invalid_grant":
+		return fmt.Errorf("%w: %s", ErrLoginDenied, retrieveErr.ErrorCode)
+	}
+
+	return err
+}
+
+func getGroups(claim interface{}) []string {
+	switch value := claim.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		groups := []string{}
+		for _, group := range value {
+			if g, ok := group.(string); ok {
+				groups = append(groups, g)
+			}
+		}
+		return groups
+	}
+
+	return nil
+}
+
+func getUserName(subject string, claims map[string]interface{}) string {
+	for _, claim := range []string{"preferred_username", "email"} {
+		if name, ok := claims[claim].(string); ok && name != "" {
+			return name
+		}
+	}
+
+	return subject
+}
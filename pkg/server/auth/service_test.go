@@ -0,0 +1,169 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	t_oidc "github.com/daytonaio/daytona/internal/testing/oidc"
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/auth"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/stretchr/testify/require"
+)
+
+const clientId = "daytona"
+
+func TestAuthService(t *testing.T) {
+	ctx := context.Background()
+
+	provider, err := t_oidc.NewMockOidcProvider(clientId, map[string]interface{}{
+		"preferred_username": "alice",
+		"groups":             []string{"engineering", "everyone"},
+	})
+	require.Nil(t, err)
+	defer provider.Close()
+
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+	userService := users.NewUserService(users.UserServiceConfig{
+		UserStore:     t_users.NewInMemoryUserStore(),
+		ApiKeyService: apiKeyService,
+	})
+
+	newService := func(roleMapping map[string]string, defaultRole string) auth.IAuthService {
+		return auth.NewAuthService(auth.AuthServiceConfig{
+			Issuer:        provider.Issuer(),
+			ClientId:      clientId,
+			RoleMapping:   roleMapping,
+			DefaultRole:   defaultRole,
+			ApiKeyTtl:     10 * time.Minute,
+			ApiKeyService: apiKeyService,
+			UserService:   userService,
+		})
+	}
+
+	service := newService(map[string]string{
+		"everyone":    string(apikey.ApiKeyRoleReadOnly),
+		"engineering": string(apikey.ApiKeyRoleDeveloper),
+	}, "")
+
+	var refreshToken string
+
+	t.Run("Device login", func(t *testing.T) {
+		deviceLogin, err := service.StartDeviceLogin(ctx)
+		require.Nil(t, err)
+		require.Equal(t, "ABCD-EFGH", deviceLogin.UserCode)
+
+		_, err = service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+		require.True(t, auth.IsAuthorizationPending(err))
+
+		provider.Approve(deviceLogin.DeviceCode)
+
+		login, err := service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+		require.Nil(t, err)
+		require.Equal(t, "alice", login.User)
+		require.Equal(t, apikey.ApiKeyRoleDeveloper, login.Role)
+		require.WithinDuration(t, time.Now().Add(10*time.Minute), login.ExpiresAt, time.Minute)
+		require.NotEmpty(t, login.RefreshToken)
+		refreshToken = login.RefreshToken
+
+		key, err := apiKeyService.GetApiKey(login.ApiKey)
+		require.Nil(t, err)
+		require.Equal(t, apikey.ApiKeyRoleDeveloper, key.Role)
+
+		u, err := userService.Find("alice")
+		require.Nil(t, err)
+		require.Equal(t, u.Id, key.UserId)
+	})
+
+	t.Run("Denied device login", func(t *testing.T) {
+		deviceLogin, err := service.StartDeviceLogin(ctx)
+		require.Nil(t, err)
+
+		provider.Deny(deviceLogin.DeviceCode)
+
+		_, err = service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+		require.True(t, auth.IsLoginDenied(err))
+	})
+
+	t.Run("Refresh login", func(t *testing.T) {
+		login, err := service.RefreshLogin(ctx, refreshToken)
+		require.Nil(t, err)
+		require.Equal(t, "alice", login.User)
+		require.NotEqual(t, refreshToken, login.RefreshToken)
+
+		_, err = service.RefreshLogin(ctx, refreshToken)
+		require.True(t, auth.IsLoginDenied(err))
+
+		users, err := userService.List()
+		require.Nil(t, err)
+		require.Len(t, users, 1)
+	})
+
+	t.Run("Users are identified by the subject", func(t *testing.T) {
+		login := func(subject, name string) string {
+			provider.Claims["sub"] = subject
+			provider.Claims["preferred_username"] = name
+
+			deviceLogin, err := service.StartDeviceLogin(ctx)
+			require.Nil(t, err)
+			provider.Approve(deviceLogin.DeviceCode)
+
+			login, err := service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+			require.Nil(t, err)
+			return login.User
+		}
+		defer func() {
+			delete(provider.Claims, "sub")
+			provider.Claims["preferred_username"] = "alice"
+		}()
+
+		// The name of an existing user does not change with the name claim
+		require.Equal(t, "alice", login("mock-subject", "renamed"))
+
+		// Other identities do not log in as the user with the same name
+		require.NotEqual(t, "alice", login("other-subject", "alice"))
+
+		users, err := userService.List()
+		require.Nil(t, err)
+		require.Len(t, users, 2)
+	})
+
+	t.Run("Groups without a role", func(t *testing.T) {
+		service := newService(map[string]string{"admins": string(apikey.ApiKeyRoleAdmin)}, "")
+
+		deviceLogin, err := service.StartDeviceLogin(ctx)
+		require.Nil(t, err)
+		provider.Approve(deviceLogin.DeviceCode)
+
+		_, err = service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+		require.True(t, auth.IsNoRole(err))
+	})
+
+	t.Run("Default role", func(t *testing.T) {
+		service := newService(nil, string(apikey.ApiKeyRoleCI))
+
+		deviceLogin, err := service.StartDeviceLogin(ctx)
+		require.Nil(t, err)
+		provider.Approve(deviceLogin.DeviceCode)
+
+		login, err := service.CompleteDeviceLogin(ctx, deviceLogin.DeviceCode)
+		require.Nil(t, err)
+		require.Equal(t, apikey.ApiKeyRoleCI, login.Role)
+	})
+
+	t.Run("Not configured", func(t *testing.T) {
+		service := auth.NewAuthService(auth.AuthServiceConfig{})
+
+		_, err := service.StartDeviceLogin(ctx)
+		require.True(t, auth.IsOidcNotConfigured(err))
+	})
+}
@@ -4,15 +4,35 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
 type BuildCreationData struct {
-	Image       string                     `json:"image" validate:"required"`
-	User        string                     `json:"user" validate:"required"`
-	BuildConfig *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
-	Repository  *gitprovider.GitRepository `json:"repository" validate:"optional"`
-	EnvVars     map[string]string          `json:"envVars" validate:"required"`
-	PrebuildId  string                     `json:"prebuildId" validate:"required"`
+	Image             string                     `json:"image" validate:"required"`
+	User              string                     `json:"user" validate:"required"`
+	BuildConfig       *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
+	Repository        *gitprovider.GitRepository `json:"repository" validate:"optional"`
+	EnvVars           map[string]string          `json:"envVars" validate:"required"`
+	PrebuildId        string                     `json:"prebuildId" validate:"required"`
+	ProjectConfigName string                     `json:"projectConfigName" validate:"optional"`
+	Priority          build.BuildPriority        `json:"priority" validate:"optional"`
+	Timeout           *int                       `json:"timeout,omitempty" validate:"optional"`
 } // @name BuildCreationData
+
+type BuildPruneResult struct {
+	DryRun bool          `json:"dryRun" validate:"required"`
+	Builds []PrunedBuild `json:"builds" validate:"required"`
+	// Compressed size in bytes of the pruned images that are not shared with remaining builds
+	ReclaimedSpace int64 `json:"reclaimedSpace" validate:"required" format:"int64"`
+} // @name BuildPruneResult
+
+type PrunedBuild struct {
+	Id                string  `json:"id" validate:"required"`
+	PrebuildId        string  `json:"prebuildId" validate:"required"`
+	ProjectConfigName string  `json:"projectConfigName" validate:"required"`
+	Image             *string `json:"image,omitempty" validate:"optional"`
+	// Compressed size in bytes of the image or 0 if the size was not recorded
+	Size int64 `json:"size" validate:"required" format:"int64"`
+} // @name PrunedBuild
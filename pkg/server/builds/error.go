@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"errors"
+)
+
+var (
+	ErrBuildNotCancellable = errors.New("only queued or running builds can be cancelled")
+	ErrInvalidBuildTimeout = errors.New("build timeout must not be negative")
+)
+
+func IsBuildNotCancellable(err error) bool {
+	return err.Error() == ErrBuildNotCancellable.Error()
+}
+
+func IsInvalidBuildTimeout(err error) bool {
+	return err.Error() == ErrInvalidBuildTimeout.Error()
+}
@@ -19,7 +19,9 @@ type IBuildService interface {
 	Create(dto.BuildCreationData) (string, error)
 	Find(filter *build.Filter) (*build.Build, error)
 	List(filter *build.Filter) ([]*build.Build, error)
+	FindSbom(buildId string) (*build.BuildSbom, error)
 	MarkForDeletion(filter *build.Filter, force bool) []error
+	Cancel(id string) error
 	Delete(id string) error
 	AwaitEmptyList(time.Duration) error
 	GetBuildLogReader(buildId string) (io.Reader, error)
@@ -45,6 +47,10 @@ func NewBuildService(config BuildServiceConfig) IBuildService {
 func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	var newBuild build.Build
 
+	if b.Timeout != nil && *b.Timeout < 0 {
+		return "", ErrInvalidBuildTimeout
+	}
+
 	id := stringid.GenerateRandomID()
 	id = stringid.TruncateID(id)
 
@@ -58,6 +64,19 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	newBuild.Repository = b.Repository
 	newBuild.EnvVars = b.EnvVars
 	newBuild.PrebuildId = b.PrebuildId
+	newBuild.ProjectConfigName = b.ProjectConfigName
+	newBuild.Priority = b.Priority
+	newBuild.Timeout = b.Timeout
+	if newBuild.Priority == "" {
+		newBuild.Priority = build.BuildPriorityNormal
+	}
+
+	if newBuild.PrebuildId != "" {
+		err := s.cancelSupersededBuilds(&newBuild)
+		if err != nil {
+			return "", err
+		}
+	}
 
 	err := s.buildStore.Save(&newBuild)
 	if err != nil {
@@ -68,11 +87,42 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 }
 
 func (s *BuildService) Find(filter *build.Filter) (*build.Build, error) {
-	return s.buildStore.Find(filter)
+	b, err := s.buildStore.Find(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions([]*build.Build{b})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
 }
 
 func (s *BuildService) List(filter *build.Filter) ([]*build.Build, error) {
-	return s.buildStore.List(filter)
+	builds, err := s.buildStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setQueuePositions(builds)
+	if err != nil {
+		return nil, err
+	}
+
+	return builds, nil
+}
+
+func (s *BuildService) FindSbom(buildId string) (*build.BuildSbom, error) {
+	_, err := s.buildStore.Find(&build.Filter{
+		Id: &buildId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildStore.FindSbom(buildId)
 }
 
 func (s *BuildService) MarkForDeletion(filter *build.Filter, force bool) []error {
@@ -99,6 +149,104 @@ func (s *BuildService) MarkForDeletion(filter *build.Filter, force bool) []error
 	return errors
 }
 
+// A newer commit supersedes the builds of the same prebuild and branch that are still waiting in the queue.
+// Builds that are already running are left to finish.
+func (s *BuildService) cancelSupersededBuilds(b *build.Build) error {
+	filter := &build.Filter{
+		States:      &[]build.BuildState{build.BuildStatePendingRun},
+		PrebuildIds: &[]string{b.PrebuildId},
+	}
+	if b.Repository != nil {
+		filter.Branch = &b.Repository.Branch
+	}
+
+	queuedBuilds, err := s.buildStore.List(filter)
+	if err != nil {
+		return err
+	}
+
+	for _, queuedBuild := range queuedBuilds {
+		queuedBuild.State = build.BuildStateCancelled
+		// The runner may have started the build since it was listed
+		err = s.buildStore.CompareAndSave(queuedBuild, build.BuildStatePendingRun)
+		if errors.Is(err, build.ErrBuildStateChanged) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Queue positions are not persisted and are calculated from all the builds waiting in the queue
+func (s *BuildService) setQueuePositions(builds []*build.Build) error {
+	hasPendingBuilds := false
+	for _, b := range builds {
+		if b.State == build.BuildStatePendingRun {
+			hasPendingBuilds = true
+			break
+		}
+	}
+
+	if !hasPendingBuilds {
+		return nil
+	}
+
+	pendingBuilds, err := s.buildStore.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePendingRun},
+	})
+	if err != nil {
+		return err
+	}
+
+	build.SetQueuePositions(pendingBuilds)
+
+	queuePositions := map[string]*int{}
+	for _, b := range pendingBuilds {
+		queuePositions[b.Id] = b.QueuePosition
+	}
+
+	for _, b := range builds {
+		b.QueuePosition = queuePositions[b.Id]
+	}
+
+	return nil
+}
+
+// Queued builds are cancelled right away. Running builds are marked for cancellation
+// and stopped by the build runner.
+func (s *BuildService) Cancel(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	currentState := b.State
+
+	switch currentState {
+	case build.BuildStatePendingRun:
+		b.State = build.BuildStateCancelled
+	case build.BuildStateRunning, build.BuildStateSuccess:
+		b.State = build.BuildStatePendingCancel
+	case build.BuildStatePendingCancel:
+		return nil
+	default:
+		return ErrBuildNotCancellable
+	}
+
+	// The build runner might have moved the build on in the meantime
+	err = s.buildStore.CompareAndSave(b, currentState)
+	if errors.Is(err, build.ErrBuildStateChanged) {
+		return s.Cancel(id)
+	}
+
+	return err
+}
+
 func (s *BuildService) Delete(id string) error {
 	return s.buildStore.Delete(id)
 }
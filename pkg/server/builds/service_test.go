@@ -190,3 +190,88 @@ func (s *BuildServiceTestSuite) TestDelete() {
 	require.Nil(err)
 	require.ElementsMatch(expectedBuilds, builds)
 }
+
+func (s *BuildServiceTestSuite) TestCreateCancelsSupersededBuilds() {
+	require := s.Require()
+
+	supersededBuild := &build.Build{
+		Id:         "superseded",
+		State:      build.BuildStatePendingRun,
+		PrebuildId: "prebuild1",
+		Repository: &gitprovider.GitRepository{
+			Branch: "main",
+			Sha:    "sha5",
+		},
+	}
+	otherBranchBuild := &build.Build{
+		Id:         "other-branch",
+		State:      build.BuildStatePendingRun,
+		PrebuildId: "prebuild1",
+		Repository: &gitprovider.GitRepository{
+			Branch: "feature",
+			Sha:    "sha6",
+		},
+	}
+
+	for _, b := range []*build.Build{supersededBuild, otherBranchBuild} {
+		err := s.buildStore.Save(b)
+		require.Nil(err)
+	}
+
+	id, err := s.buildService.Create(dto.BuildCreationData{
+		Image:      "image7",
+		User:       "user7",
+		PrebuildId: "prebuild1",
+		Repository: &gitprovider.GitRepository{
+			Branch: "main",
+			Sha:    "sha7",
+		},
+	})
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{Id: &supersededBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStateCancelled, b.State)
+	require.Nil(b.QueuePosition)
+
+	b, err = s.buildService.Find(&build.Filter{Id: &otherBranchBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+	require.NotNil(b.QueuePosition)
+
+	b, err = s.buildService.Find(&build.Filter{Id: &id})
+	require.Nil(err)
+	require.Equal(build.BuildStatePendingRun, b.State)
+	require.Equal(build.BuildPriorityNormal, b.Priority)
+	require.NotNil(b.QueuePosition)
+}
+
+func (s *BuildServiceTestSuite) TestCancel() {
+	require := s.Require()
+
+	queuedBuild := &build.Build{Id: "queued", State: build.BuildStatePendingRun}
+	runningBuild := &build.Build{Id: "running", State: build.BuildStateRunning}
+	publishedBuild := &build.Build{Id: "published", State: build.BuildStatePublished}
+
+	for _, b := range []*build.Build{queuedBuild, runningBuild, publishedBuild} {
+		err := s.buildStore.Save(b)
+		require.Nil(err)
+	}
+
+	err := s.buildService.Cancel(queuedBuild.Id)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{Id: &queuedBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStateCancelled, b.State)
+
+	err = s.buildService.Cancel(runningBuild.Id)
+	require.Nil(err)
+
+	b, err = s.buildService.Find(&build.Filter{Id: &runningBuild.Id})
+	require.Nil(err)
+	require.Equal(build.BuildStatePendingCancel, b.State)
+
+	err = s.buildService.Cancel(publishedBuild.Id)
+	require.True(builds.IsBuildNotCancellable(err))
+}
@@ -4,6 +4,8 @@
 package server
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -57,6 +59,17 @@ func GetConfig() (*Config, error) {
 		c.LogFile = getDefaultLogFileConfig()
 	}
 
+	if c.Database == nil {
+		c.Database = getDefaultDatabaseConfig()
+	}
+
+	if c.PrebuildWebhookSecret == "" {
+		c.PrebuildWebhookSecret, err = generatePrebuildWebhookSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate prebuild webhook secret: %w", err)
+		}
+	}
+
 	err = Save(c)
 	if err != nil {
 		return nil, err
@@ -65,6 +78,16 @@ func GetConfig() (*Config, error) {
 	return &c, nil
 }
 
+func generatePrebuildWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
 func configFilePath() (string, error) {
 	configDir, err := GetConfigDir()
 	if err != nil {
@@ -31,6 +31,18 @@ const defaultLocalBuilderRegistryImage = "registry:2.8.3"
 const defaultBuilderRegistryServer = "local"
 const defaultBuildImageNamespace = ""
 
+// Workspaces are not stopped automatically by default
+const defaultIdleTimeout = 0 // minutes
+
+// Limits for builds running at the same time, in total and per project config. 0 means no limit
+const defaultMaxConcurrentBuilds = 4
+const defaultMaxProjectConfigBuilds = 2
+
+// Running builds are stopped after the timeout. 0 means no timeout
+const defaultBuildTimeout = 60 // minutes
+
+const defaultDatabaseDriver = "sqlite"
+
 var defaultLogFileConfig = LogFileConfig{
 	MaxSize:    100, // megabytes
 	MaxBackups: 7,
@@ -191,6 +203,16 @@ func getDefaultConfig() (*Config, error) {
 		BuilderRegistryServer:     defaultBuilderRegistryServer,
 		BuildImageNamespace:       defaultBuildImageNamespace,
 		SamplesIndexUrl:           defaultSamplesIndexUrl,
+		IdleTimeout:               defaultIdleTimeout,
+		MaxConcurrentBuilds:       defaultMaxConcurrentBuilds,
+		MaxProjectConfigBuilds:    defaultMaxProjectConfigBuilds,
+		BuildTimeout:              defaultBuildTimeout,
+		Database:                  getDefaultDatabaseConfig(),
+	}
+
+	c.PrebuildWebhookSecret, err = generatePrebuildWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate prebuild webhook secret: %w", err)
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
@@ -221,10 +243,37 @@ func getDefaultConfig() (*Config, error) {
 			c.HeadscalePort = headscalePort
 		}
 	}
+	if os.Getenv("DEFAULT_IDLE_TIMEOUT") != "" {
+		idleTimeout, err := strconv.Atoi(os.Getenv("DEFAULT_IDLE_TIMEOUT"))
+		if err != nil || idleTimeout < 0 {
+			log.Error(fmt.Printf("invalid idle timeout. Using %d", defaultIdleTimeout))
+		} else {
+			c.IdleTimeout = idleTimeout
+		}
+	}
 
 	return &c, nil
 }
 
+func getDefaultDatabaseConfig() *DatabaseConfig {
+	databaseConfig := DatabaseConfig{
+		Driver: defaultDatabaseDriver,
+	}
+
+	dbPath, err := getDefaultDbPath()
+	if err != nil {
+		log.Error("failed to get default database path")
+	}
+	databaseConfig.Dsn = dbPath
+
+	if os.Getenv("DEFAULT_DB_DRIVER") != "" && os.Getenv("DEFAULT_DB_DSN") != "" {
+		databaseConfig.Driver = os.Getenv("DEFAULT_DB_DRIVER")
+		databaseConfig.Dsn = os.Getenv("DEFAULT_DB_DSN")
+	}
+
+	return &databaseConfig
+}
+
 func parsePort(port string) (uint32, error) {
 	p, err := strconv.Atoi(port)
 	if err != nil {
@@ -263,3 +312,12 @@ func getDefaultBinariesPath() (string, error) {
 
 	return filepath.Join(configDir, "binaries"), nil
 }
+
+func getDefaultDbPath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "db"), nil
+}
@@ -87,7 +87,18 @@ func (s *GitProviderService) GetGitProviderForHttpRequest(req *http.Request) (gi
 		return nil, errors.New("git provider for HTTP request not found")
 	}
 
-	return s.newGitProvider(provider)
+	gitProvider, err := s.newGitProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The webhook endpoint is public so events are only trusted once they are verified
+	err = gitProvider.VerifyEventSignature(req, s.prebuildWebhookSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitProvider, nil
 }
 
 func getHostnameFromUrl(urlToParse string) (string, error) {
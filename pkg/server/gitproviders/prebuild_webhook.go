@@ -29,7 +29,7 @@ func (s *GitProviderService) RegisterPrebuildWebhook(gitProviderId string, repo
 		return "", fmt.Errorf("failed to get git provider: %s", err.Error())
 	}
 
-	id, err := gitProvider.RegisterPrebuildWebhook(repo, endpointUrl)
+	id, err := gitProvider.RegisterPrebuildWebhook(repo, endpointUrl, s.prebuildWebhookSecret)
 	if err != nil {
 		return "", fmt.Errorf("failed to register webhook: %s", err.Error())
 	}
@@ -37,6 +37,20 @@ func (s *GitProviderService) RegisterPrebuildWebhook(gitProviderId string, repo
 	return id, nil
 }
 
+func (s *GitProviderService) UpdatePrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string, endpointUrl string) error {
+	gitProvider, err := s.GetGitProvider(gitProviderId)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	err = gitProvider.UpdatePrebuildWebhook(repo, id, endpointUrl, s.prebuildWebhookSecret)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %s", err.Error())
+	}
+
+	return nil
+}
+
 func (s *GitProviderService) UnregisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string) error {
 	gitProvider, err := s.GetGitProvider(gitProviderId)
 	if err != nil {
@@ -18,6 +18,7 @@ type IGitProviderService interface {
 	ListConfigsForUrl(url string) ([]*gitprovider.GitProviderConfig, error)
 	GetGitProvider(id string) (gitprovider.GitProvider, error)
 	GetGitProviderForUrl(url string) (gitprovider.GitProvider, string, error)
+	// Returns the git provider that sent the webhook request once the request is verified with the prebuild webhook secret
 	GetGitProviderForHttpRequest(req *http.Request) (gitprovider.GitProvider, error)
 	GetGitUser(gitProviderId string) (*gitprovider.GitUser, error)
 	GetNamespaces(gitProviderId string, options gitprovider.ListOptions) ([]*gitprovider.GitNamespace, error)
@@ -30,6 +31,7 @@ type IGitProviderService interface {
 	GetLastCommitSha(repo *gitprovider.GitRepository) (string, error)
 	RegisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (string, error)
 	GetPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, endpointUrl string) (*string, error)
+	UpdatePrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string, endpointUrl string) error
 	UnregisterPrebuildWebhook(gitProviderId string, repo *gitprovider.GitRepository, id string) error
 }
 
@@ -41,17 +43,21 @@ type ProjectConfigStore interface {
 type GitProviderServiceConfig struct {
 	ConfigStore        gitprovider.ConfigStore
 	ProjectConfigStore ProjectConfigStore
+	// Secret that prebuild webhooks are registered with and that their events are verified with
+	PrebuildWebhookSecret string
 }
 
 type GitProviderService struct {
-	configStore        gitprovider.ConfigStore
-	projectConfigStore ProjectConfigStore
+	configStore           gitprovider.ConfigStore
+	projectConfigStore    ProjectConfigStore
+	prebuildWebhookSecret string
 }
 
 func NewGitProviderService(config GitProviderServiceConfig) IGitProviderService {
 	return &GitProviderService{
-		configStore:        config.ConfigStore,
-		projectConfigStore: config.ProjectConfigStore,
+		configStore:           config.ConfigStore,
+		projectConfigStore:    config.ProjectConfigStore,
+		prebuildWebhookSecret: config.PrebuildWebhookSecret,
 	}
 }
 
@@ -5,6 +5,7 @@ package dto
 
 import (
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 )
 
 type CreateProjectConfigDTO struct {
@@ -15,6 +16,7 @@ type CreateProjectConfigDTO struct {
 	RepositoryUrl       string                   `json:"repositoryUrl" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Resources           *resources.Resources     `json:"resources,omitempty" validate:"optional"`
 } // @name CreateProjectConfigDTO
 
 type PrebuildDTO struct {
@@ -24,12 +26,20 @@ type PrebuildDTO struct {
 	CommitInterval    *int     `json:"commitInterval" validate:"optional"`
 	TriggerFiles      []string `json:"triggerFiles" validate:"optional"`
 	Retention         int      `json:"retention" validate:"required"`
+	PullRequests      bool     `json:"pullRequests" validate:"optional"`
+	ReviewWorkspace   bool     `json:"reviewWorkspace" validate:"optional"`
+	ForkPullRequests  bool     `json:"forkPullRequests" validate:"optional"`
+	BuildTimeout      *int     `json:"buildTimeout,omitempty" validate:"optional"`
 } // @name PrebuildDTO
 
 type CreatePrebuildDTO struct {
-	Id             *string  `json:"id" validate:"optional"`
-	Branch         string   `json:"branch" validate:"optional"`
-	CommitInterval *int     `json:"commitInterval" validate:"optional"`
-	TriggerFiles   []string `json:"triggerFiles" validate:"optional"`
-	Retention      int      `json:"retention" validate:"required"`
+	Id               *string  `json:"id" validate:"optional"`
+	Branch           string   `json:"branch" validate:"optional"`
+	CommitInterval   *int     `json:"commitInterval" validate:"optional"`
+	TriggerFiles     []string `json:"triggerFiles" validate:"optional"`
+	Retention        int      `json:"retention" validate:"required"`
+	PullRequests     bool     `json:"pullRequests" validate:"optional"`
+	ReviewWorkspace  bool     `json:"reviewWorkspace" validate:"optional"`
+	ForkPullRequests bool     `json:"forkPullRequests" validate:"optional"`
+	BuildTimeout     *int     `json:"buildTimeout,omitempty" validate:"optional"`
 } // @name CreatePrebuildDTO
@@ -6,7 +6,6 @@ package projectconfig
 import (
 	"errors"
 	"fmt"
-	"sort"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
@@ -27,17 +26,30 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 	}
 
 	existingPrebuild, _ := projectConfig.FindPrebuild(&config.PrebuildFilter{
-		Branch: &createPrebuildDto.Branch,
+		Branch:       &createPrebuildDto.Branch,
+		PullRequests: &createPrebuildDto.PullRequests,
 	})
 
 	if existingPrebuild != nil && createPrebuildDto.Id == nil {
 		return nil, errors.New("prebuild for the specified project config and branch already exists")
 	}
 
-	if createPrebuildDto.CommitInterval == nil && len(createPrebuildDto.TriggerFiles) == 0 {
+	if !createPrebuildDto.PullRequests && createPrebuildDto.CommitInterval == nil && len(createPrebuildDto.TriggerFiles) == 0 {
 		return nil, errors.New("either the commit interval or trigger files must be specified")
 	}
 
+	if createPrebuildDto.ReviewWorkspace && !createPrebuildDto.PullRequests {
+		return nil, errors.New("review workspaces can only be enabled for prebuilds that target pull requests")
+	}
+
+	if createPrebuildDto.ForkPullRequests && !createPrebuildDto.PullRequests {
+		return nil, errors.New("pull requests from forks can only be enabled for prebuilds that target pull requests")
+	}
+
+	if createPrebuildDto.BuildTimeout != nil && *createPrebuildDto.BuildTimeout < 0 {
+		return nil, errors.New("build timeout must not be negative")
+	}
+
 	gitProvider, gitProviderId, err := s.gitProviderService.GetGitProviderForUrl(projectConfig.RepositoryUrl)
 	if err != nil {
 		return nil, err
@@ -51,10 +63,14 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 	}
 
 	prebuild := &config.PrebuildConfig{
-		Branch:         createPrebuildDto.Branch,
-		CommitInterval: createPrebuildDto.CommitInterval,
-		TriggerFiles:   createPrebuildDto.TriggerFiles,
-		Retention:      createPrebuildDto.Retention,
+		Branch:           createPrebuildDto.Branch,
+		CommitInterval:   createPrebuildDto.CommitInterval,
+		TriggerFiles:     createPrebuildDto.TriggerFiles,
+		Retention:        createPrebuildDto.Retention,
+		PullRequests:     createPrebuildDto.PullRequests,
+		ReviewWorkspace:  createPrebuildDto.ReviewWorkspace,
+		ForkPullRequests: createPrebuildDto.ForkPullRequests,
+		BuildTimeout:     createPrebuildDto.BuildTimeout,
 	}
 
 	if createPrebuildDto.Id != nil {
@@ -84,6 +100,12 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		if err != nil {
 			return nil, err
 		}
+	} else {
+		// Webhooks registered by older versions may miss the pull request events or the secret
+		err = s.gitProviderService.UpdatePrebuildWebhook(gitProviderId, repository, *existingWebhookId, s.prebuildWebhookEndpoint)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = s.configStore.Save(projectConfig)
@@ -105,6 +127,10 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		PullRequests:      prebuild.PullRequests,
+		ReviewWorkspace:   prebuild.ReviewWorkspace,
+		ForkPullRequests:  prebuild.ForkPullRequests,
+		BuildTimeout:      prebuild.BuildTimeout,
 	}, nil
 }
 
@@ -126,6 +152,10 @@ func (s *ProjectConfigService) FindPrebuild(projectConfigFilter *config.ProjectC
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		PullRequests:      prebuild.PullRequests,
+		ReviewWorkspace:   prebuild.ReviewWorkspace,
+		ForkPullRequests:  prebuild.ForkPullRequests,
+		BuildTimeout:      prebuild.BuildTimeout,
 	}, nil
 }
 
@@ -145,6 +175,10 @@ func (s *ProjectConfigService) ListPrebuilds(projectConfigFilter *config.Project
 				CommitInterval:    prebuild.CommitInterval,
 				TriggerFiles:      prebuild.TriggerFiles,
 				Retention:         prebuild.Retention,
+				PullRequests:      prebuild.PullRequests,
+				ReviewWorkspace:   prebuild.ReviewWorkspace,
+				ForkPullRequests:  prebuild.ForkPullRequests,
+				BuildTimeout:      prebuild.BuildTimeout,
 			})
 		}
 	}
@@ -229,7 +263,62 @@ func (s *ProjectConfigService) DeletePrebuild(projectConfigName string, id strin
 	return nil
 }
 
+// UpdatePrebuildWebhooks sets the events and the secret of the webhooks of all repositories with prebuilds
+// so that webhooks registered by older versions are not rejected
+func (s *ProjectConfigService) UpdatePrebuildWebhooks() error {
+	projectConfigs, err := s.configStore.List(nil)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	updatedRepositoryUrls := map[string]bool{}
+
+	for _, projectConfig := range projectConfigs {
+		if len(projectConfig.Prebuilds) == 0 || updatedRepositoryUrls[projectConfig.RepositoryUrl] {
+			continue
+		}
+		updatedRepositoryUrls[projectConfig.RepositoryUrl] = true
+
+		err := s.updatePrebuildWebhook(projectConfig.RepositoryUrl)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update prebuild webhook for %s: %w", projectConfig.RepositoryUrl, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *ProjectConfigService) updatePrebuildWebhook(repositoryUrl string) error {
+	gitProvider, gitProviderId, err := s.gitProviderService.GetGitProviderForUrl(repositoryUrl)
+	if err != nil {
+		return err
+	}
+
+	repository, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
+		Url: repositoryUrl,
+	})
+	if err != nil {
+		return err
+	}
+
+	existingWebhookId, err := s.gitProviderService.GetPrebuildWebhook(gitProviderId, repository, s.prebuildWebhookEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if existingWebhookId == nil {
+		return nil
+	}
+
+	return s.gitProviderService.UpdatePrebuildWebhook(gitProviderId, repository, *existingWebhookId, s.prebuildWebhookEndpoint)
+}
+
 func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) error {
+	if data.IsPullRequest() {
+		return s.processPullRequestEvent(data)
+	}
+
 	var buildsToTrigger []build.Build
 
 	projectConfigs, err := s.List(&config.ProjectConfigFilter{
@@ -252,7 +341,8 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 
 	for _, projectConfig := range projectConfigs {
 		prebuild, err := projectConfig.FindPrebuild(&config.PrebuildFilter{
-			Branch: &data.Branch,
+			Branch:       &data.Branch,
+			PullRequests: util.Pointer(false),
 		})
 		if err != nil || prebuild == nil {
 			continue
@@ -266,10 +356,12 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 						Image: projectConfig.Image,
 						User:  projectConfig.User,
 					},
-					BuildConfig: projectConfig.BuildConfig,
-					Repository:  repo,
-					EnvVars:     projectConfig.EnvVars,
-					PrebuildId:  prebuild.Id,
+					BuildConfig:       projectConfig.BuildConfig,
+					Repository:        repo,
+					EnvVars:           projectConfig.EnvVars,
+					PrebuildId:        prebuild.Id,
+					ProjectConfigName: projectConfig.Name,
+					Timeout:           prebuild.BuildTimeout,
 				})
 				continue
 			}
@@ -285,10 +377,12 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 					Image: projectConfig.Image,
 					User:  projectConfig.User,
 				},
-				BuildConfig: projectConfig.BuildConfig,
-				Repository:  repo,
-				EnvVars:     projectConfig.EnvVars,
-				PrebuildId:  prebuild.Id,
+				BuildConfig:       projectConfig.BuildConfig,
+				Repository:        repo,
+				EnvVars:           projectConfig.EnvVars,
+				PrebuildId:        prebuild.Id,
+				ProjectConfigName: projectConfig.Name,
+				Timeout:           prebuild.BuildTimeout,
 			})
 			continue
 		}
@@ -305,22 +399,26 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 					Image: projectConfig.Image,
 					User:  projectConfig.User,
 				},
-				BuildConfig: projectConfig.BuildConfig,
-				Repository:  repo,
-				EnvVars:     projectConfig.EnvVars,
-				PrebuildId:  prebuild.Id,
+				BuildConfig:       projectConfig.BuildConfig,
+				Repository:        repo,
+				EnvVars:           projectConfig.EnvVars,
+				PrebuildId:        prebuild.Id,
+				ProjectConfigName: projectConfig.Name,
+				Timeout:           prebuild.BuildTimeout,
 			})
 		}
 	}
 
 	for _, build := range buildsToTrigger {
 		createBuildDto := build_dto.BuildCreationData{
-			Image:       build.ContainerConfig.Image,
-			User:        build.ContainerConfig.User,
-			BuildConfig: build.BuildConfig,
-			Repository:  build.Repository,
-			EnvVars:     build.EnvVars,
-			PrebuildId:  build.PrebuildId,
+			Image:             build.ContainerConfig.Image,
+			User:              build.ContainerConfig.User,
+			BuildConfig:       build.BuildConfig,
+			Repository:        build.Repository,
+			EnvVars:           build.EnvVars,
+			PrebuildId:        build.PrebuildId,
+			ProjectConfigName: build.ProjectConfigName,
+			Timeout:           build.Timeout,
 		}
 
 		_, err = s.buildService.Create(createBuildDto)
@@ -332,71 +430,82 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 	return nil
 }
 
-// Marks the [retention] oldest published builds for deletion for each prebuild
-func (s *ProjectConfigService) EnforceRetentionPolicy() error {
-	prebuilds, err := s.ListPrebuilds(nil, nil)
-	if err != nil {
-		return err
+// Pull request prebuilds run each time a pull request is opened or its head is updated.
+// The commit interval and trigger files only apply to branch prebuilds.
+func (s *ProjectConfigService) processPullRequestEvent(data gitprovider.GitEventData) error {
+	if data.Type == gitprovider.GitEventTypePullRequestClosed {
+		return nil
 	}
 
-	builds, err := s.buildService.List(&build.Filter{
-		States: &[]build.BuildState{build.BuildStatePublished},
+	projectConfigs, err := s.List(&config.ProjectConfigFilter{
+		Url: &data.Url,
 	})
 	if err != nil {
 		return err
 	}
 
-	buildMap := make(map[string][]build.Build)
-
-	// Group builds by their prebuildId
-	for _, b := range builds {
-		buildMap[b.PrebuildId] = append(buildMap[b.PrebuildId], *b)
-	}
+	var buildsToTrigger []build.Build
 
-	for _, prebuild := range prebuilds {
+	for _, projectConfig := range projectConfigs {
+		prebuilds, err := projectConfig.ListPrebuilds(&config.PrebuildFilter{
+			PullRequests: util.Pointer(true),
+		})
+		if err != nil {
+			return err
+		}
 
-		associatedBuilds := buildMap[prebuild.Id]
+		for _, prebuild := range prebuilds {
+			if !prebuild.MatchesPullRequest(data.BaseBranch, data.FromFork) {
+				continue
+			}
 
-		if len(associatedBuilds) > prebuild.Retention {
-			// Sort the builds by creation time in ascending order (oldest first)
-			sort.Slice(associatedBuilds, func(i, j int) bool {
-				return associatedBuilds[i].CreatedAt.Before(associatedBuilds[j].CreatedAt)
+			buildsToTrigger = append(buildsToTrigger, build.Build{
+				ContainerConfig: containerconfig.ContainerConfig{
+					Image: projectConfig.Image,
+					User:  projectConfig.User,
+				},
+				BuildConfig:       projectConfig.BuildConfig,
+				EnvVars:           projectConfig.EnvVars,
+				PrebuildId:        prebuild.Id,
+				ProjectConfigName: projectConfig.Name,
+				Timeout:           prebuild.BuildTimeout,
 			})
-
-			numToDelete := len(associatedBuilds) - prebuild.Retention
-
-			// Mark the oldest builds for deletion
-			for i := 0; i < numToDelete; i++ {
-				errs := s.buildService.MarkForDeletion(&build.Filter{
-					Id: &associatedBuilds[i].Id,
-				}, false)
-				if len(errs) > 0 {
-					for _, err := range errs {
-						log.Error(err)
-					}
-				}
-			}
 		}
+	}
 
+	if len(buildsToTrigger) == 0 {
+		return nil
 	}
 
-	return nil
-}
+	gitProvider, _, err := s.gitProviderService.GetGitProviderForUrl(data.Url)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider for URL: %s", err)
+	}
 
-func (s *ProjectConfigService) StartRetentionPoller() error {
-	scheduler := build.NewCronScheduler()
+	repo, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
+		Url:      data.Url,
+		PrNumber: data.PullRequestNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository context: %s", err)
+	}
 
-	err := scheduler.AddFunc(build.DEFAULT_POLL_INTERVAL, func() {
-		err := s.EnforceRetentionPolicy()
+	for _, build := range buildsToTrigger {
+		_, err = s.buildService.Create(build_dto.BuildCreationData{
+			Image:             build.ContainerConfig.Image,
+			User:              build.ContainerConfig.User,
+			BuildConfig:       build.BuildConfig,
+			Repository:        repo,
+			EnvVars:           build.EnvVars,
+			PrebuildId:        build.PrebuildId,
+			ProjectConfigName: build.ProjectConfigName,
+			Timeout:           build.Timeout,
+		})
 		if err != nil {
-			log.Error(err)
+			return fmt.Errorf("failed to create build: %s", err)
 		}
-	})
-	if err != nil {
-		return err
 	}
 
-	scheduler.Start()
 	return nil
 }
 
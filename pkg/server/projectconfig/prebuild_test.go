@@ -4,14 +4,22 @@
 package projectconfig_test
 
 import (
+	"fmt"
 	"time"
 
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
+	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/stretchr/testify/mock"
 )
 
 var prebuild1 *config.PrebuildConfig = &config.PrebuildConfig{
@@ -67,6 +75,7 @@ func (s *ProjectConfigServiceTestSuite) TestSetPrebuild() {
 		Url: repository1.Url,
 	}).Return(repository1, nil)
 	s.gitProviderService.On("GetPrebuildWebhook", "github", repository1, "").Return(util.Pointer("webhook-id"), nil)
+	s.gitProviderService.On("UpdatePrebuildWebhook", "github", repository1, "webhook-id", "").Return(nil)
 
 	newPrebuildDto, err := s.projectConfigService.SetPrebuild(projectConfig1.Name, dto.CreatePrebuildDTO{
 		Id:             &prebuild3.Id,
@@ -84,6 +93,44 @@ func (s *ProjectConfigServiceTestSuite) TestSetPrebuild() {
 	require.Contains(prebuildDtos, newPrebuildDto)
 }
 
+func (s *ProjectConfigServiceTestSuite) TestSetPrebuildUpdatesWebhookForPullRequests() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+	s.gitProviderService.On("GetPrebuildWebhook", "github", repository1, "").Return(util.Pointer("webhook-id"), nil)
+	s.gitProviderService.On("UpdatePrebuildWebhook", "github", repository1, "webhook-id", "").Return(nil)
+
+	_, err := s.projectConfigService.SetPrebuild(projectConfig1.Name, dto.CreatePrebuildDTO{
+		Branch:       "pr",
+		Retention:    3,
+		PullRequests: true,
+	})
+	require.Nil(err)
+
+	s.gitProviderService.AssertCalled(s.T(), "UpdatePrebuildWebhook", "github", repository1, "webhook-id", "")
+}
+
+func (s *ProjectConfigServiceTestSuite) TestUpdatePrebuildWebhooks() {
+	require := s.Require()
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+	s.gitProviderService.On("GetPrebuildWebhook", "github", repository1, "").Return(util.Pointer("webhook-id"), nil)
+	s.gitProviderService.On("UpdatePrebuildWebhook", "github", repository1, "webhook-id", "").Return(nil)
+
+	err := s.projectConfigService.UpdatePrebuildWebhooks()
+	require.Nil(err)
+
+	s.gitProviderService.AssertCalled(s.T(), "UpdatePrebuildWebhook", "github", repository1, "webhook-id", "")
+	// Only repositories with prebuilds have webhooks
+	s.gitProviderService.AssertNotCalled(s.T(), "GetGitProviderForUrl", projectConfig3.RepositoryUrl)
+}
+
 func (s *ProjectConfigServiceTestSuite) TestFindPrebuild() {
 	require := s.Require()
 
@@ -136,10 +183,11 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventCommitInterval() {
 	}).Return(repository1, nil)
 
 	s.buildService.On("Create", build_dto.BuildCreationData{
-		PrebuildId: prebuild1.Id,
-		Repository: repository1,
-		User:       projectConfig1.User,
-		Image:      projectConfig1.Image,
+		PrebuildId:        prebuild1.Id,
+		Repository:        repository1,
+		User:              projectConfig1.User,
+		Image:             projectConfig1.Image,
+		ProjectConfigName: projectConfig1.Name,
 	}).Return("", nil)
 
 	s.buildService.On("Find", &build.Filter{
@@ -174,10 +222,11 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 	}).Return(repository1, nil)
 
 	s.buildService.On("Create", build_dto.BuildCreationData{
-		PrebuildId: prebuild1.Id,
-		Repository: repository1,
-		User:       projectConfig1.User,
-		Image:      projectConfig1.Image,
+		PrebuildId:        prebuild1.Id,
+		Repository:        repository1,
+		User:              projectConfig1.User,
+		Image:             projectConfig1.Image,
+		ProjectConfigName: projectConfig1.Name,
 	}).Return("", nil)
 
 	data := gitprovider.GitEventData{
@@ -194,6 +243,96 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 	require.Nil(err)
 }
 
+func (s *ProjectConfigServiceTestSuite) TestProcessGitEventPullRequest() {
+	require := s.Require()
+
+	err := s.projectConfigStore.Save(&config.ProjectConfig{
+		Name:          "pc-pr",
+		Image:         projectConfig1Image,
+		User:          projectConfig1User,
+		RepositoryUrl: repository1.Url,
+		Prebuilds: []*config.PrebuildConfig{
+			{
+				Id:           "pr",
+				Branch:       "main",
+				Retention:    3,
+				PullRequests: true,
+			},
+		},
+	})
+	require.Nil(err)
+
+	prRepository := &gitprovider.GitRepository{
+		Url:      repository1.Url,
+		Branch:   "feature",
+		Sha:      "sha5",
+		PrNumber: util.Pointer(uint32(5)),
+	}
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url:      repository1.Url,
+		PrNumber: prRepository.PrNumber,
+	}).Return(prRepository, nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	s.buildService.On("Create", build_dto.BuildCreationData{
+		PrebuildId:        "pr",
+		Repository:        prRepository,
+		User:              projectConfig1User,
+		Image:             projectConfig1Image,
+		ProjectConfigName: "pc-pr",
+	}).Return("", nil)
+
+	data := gitprovider.GitEventData{
+		Type:              gitprovider.GitEventTypePullRequestOpened,
+		Url:               repository1.Url,
+		Branch:            prRepository.Branch,
+		Sha:               prRepository.Sha,
+		PullRequestNumber: prRepository.PrNumber,
+		BaseBranch:        "main",
+	}
+
+	createCalls := func() int {
+		count := 0
+		for _, call := range s.buildService.Calls {
+			if call.Method == "Create" {
+				count++
+			}
+		}
+		return count
+	}
+	initialCreateCalls := createCalls()
+
+	err = s.projectConfigService.ProcessGitEvent(data)
+	require.Nil(err)
+	require.Equal(initialCreateCalls+1, createCalls())
+
+	// Pull requests from forks only match prebuilds that opt in to them
+	data.FromFork = true
+	err = s.projectConfigService.ProcessGitEvent(data)
+	require.Nil(err)
+	require.Equal(initialCreateCalls+1, createCalls())
+	data.FromFork = false
+
+	// Pull requests into other branches and pushes to the base branch do not match the prebuild
+	data.BaseBranch = "dev"
+	err = s.projectConfigService.ProcessGitEvent(data)
+	require.Nil(err)
+
+	err = s.projectConfigService.ProcessGitEvent(gitprovider.GitEventData{
+		Type:   gitprovider.GitEventTypePush,
+		Url:    repository1.Url,
+		Branch: "main",
+		Sha:    "sha6",
+	})
+	require.Nil(err)
+
+	require.Equal(initialCreateCalls+1, createCalls())
+}
+
 func (s *ProjectConfigServiceTestSuite) TestEnforceRetentionPolicy() {
 	require := s.Require()
 
@@ -233,3 +372,86 @@ func (s *ProjectConfigServiceTestSuite) TestEnforceRetentionPolicy() {
 	err := s.projectConfigService.EnforceRetentionPolicy()
 	require.Nil(err)
 }
+
+func (s *ProjectConfigServiceTestSuite) TestPruneBuilds() {
+	require := s.Require()
+
+	buildService := mocks.MockBuildService{}
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	projectConfigService := projectconfig.NewProjectConfigService(projectconfig.ProjectConfigServiceConfig{
+		ConfigStore:        s.projectConfigStore,
+		GitProviderService: &s.gitProviderService,
+		BuildService:       &buildService,
+		WorkspaceStore:     workspaceStore,
+	})
+
+	err := workspaceStore.Save(&workspace.Workspace{
+		Id: "1",
+		Projects: []*project.Project{
+			{
+				Name: "project1",
+				BuildConfig: &buildconfig.BuildConfig{
+					CachedBuild: &buildconfig.CachedBuild{
+						Image: "image-1",
+					},
+				},
+			},
+		},
+	})
+	require.Nil(err)
+
+	builds := []*build.Build{}
+	for i := 1; i <= 6; i++ {
+		builds = append(builds, &build.Build{
+			Id:         fmt.Sprint(i),
+			PrebuildId: prebuild1.Id,
+			State:      build.BuildStatePublished,
+			Image:      util.Pointer(fmt.Sprintf("image-%d", i)),
+			Artifact: &build.BuildArtifact{
+				CompressedSize: 100,
+			},
+			CreatedAt: time.Now().Add(time.Hour * time.Duration(i-10)),
+		})
+	}
+
+	// Builds 2 and 5 share the image so only the image of build 3 is deleted
+	builds[1].Image = util.Pointer("image-5")
+
+	buildService.On("List", &build.Filter{
+		States: &[]build.BuildState{build.BuildStatePublished},
+	}).Return(builds, nil)
+
+	result, err := projectConfigService.PruneBuilds(true, nil)
+	require.Nil(err)
+
+	require.True(result.DryRun)
+	require.Len(result.Builds, 2)
+	require.Equal("3", result.Builds[0].Id)
+	require.Equal("2", result.Builds[1].Id)
+	require.Equal(int64(100), result.ReclaimedSpace)
+	buildService.AssertNotCalled(s.T(), "MarkForDeletion", mock.Anything, mock.Anything)
+
+	// Builds of project configs of other users are not pruned
+	ownedProjectConfig := *projectConfig1
+	ownedProjectConfig.OwnerId = "user1"
+	err = s.projectConfigStore.Save(&ownedProjectConfig)
+	require.Nil(err)
+
+	result, err = projectConfigService.PruneBuilds(true, util.Pointer("user2"))
+	require.Nil(err)
+	require.Empty(result.Builds)
+
+	result, err = projectConfigService.PruneBuilds(true, util.Pointer("user1"))
+	require.Nil(err)
+	require.Len(result.Builds, 2)
+
+	buildService.On("MarkForDeletion", mock.Anything, false).Return([]error{})
+
+	result, err = projectConfigService.PruneBuilds(false, nil)
+	require.Nil(err)
+
+	require.False(result.DryRun)
+	buildService.AssertCalled(s.T(), "MarkForDeletion", &build.Filter{Id: util.Pointer("2")}, false)
+	buildService.AssertCalled(s.T(), "MarkForDeletion", &build.Filter{Id: util.Pointer("3")}, false)
+	buildService.AssertNumberOfCalls(s.T(), "MarkForDeletion", 2)
+}
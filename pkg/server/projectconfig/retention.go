@@ -0,0 +1,176 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectconfig
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
+	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	log "github.com/sirupsen/logrus"
+)
+
+// Marks the published builds that exceed the retention of their prebuild for deletion
+func (s *ProjectConfigService) EnforceRetentionPolicy() error {
+	_, err := s.PruneBuilds(false, nil)
+	return err
+}
+
+// Keeps the [retention] newest published builds of each prebuild and the builds whose image
+// is used by a workspace project. The remaining published builds of the prebuild are marked
+// for deletion unless dryRun is set. If ownerId is set, only the prebuilds of the project configs
+// that the user can access are pruned.
+func (s *ProjectConfigService) PruneBuilds(dryRun bool, ownerId *string) (*build_dto.BuildPruneResult, error) {
+	prebuilds, err := s.ListPrebuilds(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownerId != nil {
+		projectConfigs, err := s.configStore.List(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		accessible := map[string]bool{}
+		for _, pc := range projectConfigs {
+			accessible[pc.Name] = user.CanAccess(pc.OwnerId, *ownerId)
+		}
+
+		prebuilds = slices.DeleteFunc(prebuilds, func(prebuild *dto.PrebuildDTO) bool {
+			return !accessible[prebuild.ProjectConfigName]
+		})
+	}
+
+	builds, err := s.buildService.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePublished},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceImages, err := s.getWorkspaceBuildImages()
+	if err != nil {
+		return nil, err
+	}
+
+	buildMap := make(map[string][]*build.Build)
+
+	// Group builds by their prebuildId
+	for _, b := range builds {
+		buildMap[b.PrebuildId] = append(buildMap[b.PrebuildId], b)
+	}
+
+	prunedBuilds := []*build.Build{}
+	pruned := map[string]bool{}
+
+	for _, prebuild := range prebuilds {
+		associatedBuilds := buildMap[prebuild.Id]
+
+		if len(associatedBuilds) <= prebuild.Retention {
+			continue
+		}
+
+		// Sort the builds by creation time in descending order (newest first)
+		sort.Slice(associatedBuilds, func(i, j int) bool {
+			return associatedBuilds[i].CreatedAt.After(associatedBuilds[j].CreatedAt)
+		})
+
+		for _, b := range associatedBuilds[prebuild.Retention:] {
+			if b.Image != nil && workspaceImages[*b.Image] {
+				continue
+			}
+
+			prunedBuilds = append(prunedBuilds, b)
+			pruned[b.Id] = true
+		}
+	}
+
+	// Images shared with remaining builds are not deleted so they do not count towards the reclaimed space
+	remainingImages := map[string]bool{}
+	for _, b := range builds {
+		if !pruned[b.Id] && b.Image != nil {
+			remainingImages[*b.Image] = true
+		}
+	}
+
+	result := &build_dto.BuildPruneResult{
+		DryRun: dryRun,
+		Builds: []build_dto.PrunedBuild{},
+	}
+
+	countedImages := map[string]bool{}
+
+	for _, b := range prunedBuilds {
+		prunedBuild := build_dto.PrunedBuild{
+			Id:                b.Id,
+			PrebuildId:        b.PrebuildId,
+			ProjectConfigName: b.ProjectConfigName,
+			Image:             b.Image,
+		}
+
+		if b.Artifact != nil {
+			prunedBuild.Size = b.Artifact.CompressedSize
+		}
+
+		if b.Image != nil && !remainingImages[*b.Image] && !countedImages[*b.Image] {
+			result.ReclaimedSpace += prunedBuild.Size
+			countedImages[*b.Image] = true
+		}
+
+		result.Builds = append(result.Builds, prunedBuild)
+
+		if dryRun {
+			continue
+		}
+
+		errs := s.buildService.MarkForDeletion(&build.Filter{
+			Id: &b.Id,
+		}, false)
+		for _, err := range errs {
+			log.Error(err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ProjectConfigService) StartRetentionPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(build.DEFAULT_POLL_INTERVAL, func() {
+		err := s.EnforceRetentionPolicy()
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+// Returns the build images that workspace projects were created from
+func (s *ProjectConfigService) getWorkspaceBuildImages() (map[string]bool, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]bool{}
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			if p.BuildConfig != nil && p.BuildConfig.CachedBuild != nil {
+				images[p.BuildConfig.CachedBuild.Image] = true
+			}
+		}
+	}
+
+	return images, nil
+}
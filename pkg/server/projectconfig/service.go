@@ -9,8 +9,10 @@ import (
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server/builds"
+	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 )
 
@@ -25,9 +27,11 @@ type IProjectConfigService interface {
 	FindPrebuild(projectConfigFilter *config.ProjectConfigFilter, prebuildFilter *config.PrebuildFilter) (*dto.PrebuildDTO, error)
 	ListPrebuilds(projectConfigFilter *config.ProjectConfigFilter, prebuildFilter *config.PrebuildFilter) ([]*dto.PrebuildDTO, error)
 	DeletePrebuild(projectConfigName string, id string, force bool) []error
+	UpdatePrebuildWebhooks() error
 
 	StartRetentionPoller() error
 	EnforceRetentionPolicy() error
+	PruneBuilds(dryRun bool, ownerId *string) (*build_dto.BuildPruneResult, error)
 	ProcessGitEvent(gitprovider.GitEventData) error
 }
 
@@ -36,6 +40,7 @@ type ProjectConfigServiceConfig struct {
 	ConfigStore             config.Store
 	BuildService            builds.IBuildService
 	GitProviderService      gitproviders.IGitProviderService
+	WorkspaceStore          workspace.Store
 }
 
 type ProjectConfigService struct {
@@ -43,6 +48,7 @@ type ProjectConfigService struct {
 	configStore             config.Store
 	buildService            builds.IBuildService
 	gitProviderService      gitproviders.IGitProviderService
+	workspaceStore          workspace.Store
 }
 
 func NewProjectConfigService(config ProjectConfigServiceConfig) IProjectConfigService {
@@ -52,6 +58,7 @@ func NewProjectConfigService(config ProjectConfigServiceConfig) IProjectConfigSe
 		configStore:             config.ConfigStore,
 		buildService:            config.BuildService,
 		gitProviderService:      config.GitProviderService,
+		workspaceStore:          config.WorkspaceStore,
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 
 	git_provider_mock "github.com/daytonaio/daytona/internal/testing/gitprovider/mocks"
 	projectconfig_internal "github.com/daytonaio/daytona/internal/testing/server/projectconfig"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
@@ -116,6 +117,7 @@ func (s *ProjectConfigServiceTestSuite) SetupTest() {
 		ConfigStore:        s.projectConfigStore,
 		GitProviderService: &s.gitProviderService,
 		BuildService:       &s.buildService,
+		WorkspaceStore:     t_workspaces.NewInMemoryWorkspaceStore(),
 	})
 
 	for _, pc := range expectedProjectConfigs {
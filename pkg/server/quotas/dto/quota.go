@@ -0,0 +1,12 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/quota"
+
+// Usage is not set for the default quota
+type QuotaDTO struct {
+	quota.Quota
+	Usage *quota.Usage `json:"usage,omitempty" validate:"optional"`
+} //	@name	QuotaDTO
@@ -0,0 +1,16 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quotas
+
+import (
+	"errors"
+)
+
+var (
+	ErrApiKeyNotFound = errors.New("client API key not found")
+)
+
+func IsApiKeyNotFound(err error) bool {
+	return err.Error() == ErrApiKeyNotFound.Error()
+}
@@ -0,0 +1,290 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quotas
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/quotas/dto"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
+)
+
+type IQuotaService interface {
+	List() ([]dto.QuotaDTO, error)
+	Find(apiKeyName string) (*quota.Quota, error)
+	Set(q quota.Quota) (*quota.Quota, error)
+	Delete(apiKeyName string) error
+	DeleteForUser(userId string) error
+	GetUsage(subject quota.Subject) (*quota.Usage, error)
+	CheckCreateWorkspace(subject quota.Subject, projects []*project.Project) error
+	CheckStartProjects(subject quota.Subject, projects []*project.Project) error
+	// Lock serializes the operations of a subject that are checked against its quota.
+	// The usage of an operation is only known once it completes so the lock is held
+	// from the check until the operation completes. The returned function unlocks it.
+	Lock(subject quota.Subject) func()
+}
+
+type QuotaServiceConfig struct {
+	QuotaStore     quota.Store
+	WorkspaceStore workspace.Store
+	ApiKeyService  apikeys.IApiKeyService
+	UserService    users.IUserService
+}
+
+func NewQuotaService(config QuotaServiceConfig) IQuotaService {
+	return &QuotaService{
+		quotaStore:     config.QuotaStore,
+		workspaceStore: config.WorkspaceStore,
+		apiKeyService:  config.ApiKeyService,
+		userService:    config.UserService,
+	}
+}
+
+type QuotaService struct {
+	quotaStore     quota.Store
+	workspaceStore workspace.Store
+	apiKeyService  apikeys.IApiKeyService
+	userService    users.IUserService
+	subjectMutex   util.KeyedMutex
+}
+
+func (s *QuotaService) List() ([]dto.QuotaDTO, error) {
+	quotas, err := s.quotaStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.apiKeyService.ListClientKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyUsers := map[string]string{}
+	for _, apiKey := range apiKeys {
+		apiKeyUsers[apiKey.Name] = apiKey.UserId
+	}
+
+	result := []dto.QuotaDTO{}
+	for _, q := range quotas {
+		quotaDTO := dto.QuotaDTO{Quota: *q}
+		if !q.IsDefault() {
+			subject := quota.Subject{UserId: q.UserId}
+			if q.ApiKeyName != "" {
+				subject = quota.Subject{ApiKeyName: q.ApiKeyName, UserId: apiKeyUsers[q.ApiKeyName]}
+			}
+
+			quotaDTO.Usage, err = s.GetUsage(subject)
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, quotaDTO)
+	}
+
+	return result, nil
+}
+
+func (s *QuotaService) Find(apiKeyName string) (*quota.Quota, error) {
+	return s.quotaStore.Find(apiKeyName)
+}
+
+func (s *QuotaService) Set(q quota.Quota) (*quota.Quota, error) {
+	err := q.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if q.UserId != "" {
+		u, err := s.userService.Find(q.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		q.UserId = u.Id
+	} else if !q.IsDefault() {
+		apiKeys, err := s.apiKeyService.ListClientKeys()
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, apiKey := range apiKeys {
+			if apiKey.Name == q.ApiKeyName {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, ErrApiKeyNotFound
+		}
+	}
+
+	return &q, s.quotaStore.Save(&q)
+}
+
+func (s *QuotaService) Delete(apiKeyName string) error {
+	q, err := s.quotaStore.Find(apiKeyName)
+	if err != nil {
+		return err
+	}
+
+	return s.quotaStore.Delete(q)
+}
+
+func (s *QuotaService) DeleteForUser(userId string) error {
+	q, err := s.quotaStore.FindForUser(userId)
+	if err != nil {
+		return err
+	}
+
+	return s.quotaStore.Delete(q)
+}
+
+// GetUsage sums the workspaces and resource requests of the workspaces of the subject
+func (s *QuotaService) GetUsage(subject quota.Subject) (*quota.Usage, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	usage := quota.Usage{}
+	for _, w := range workspaces {
+		if !subject.Matches(w.CreatedBy, w.OwnerId) {
+			continue
+		}
+
+		usage.Workspaces++
+		for _, p := range w.Projects {
+			if p.Resources != nil {
+				usage.Disk += p.Resources.Disk
+			}
+
+			if p.State == nil || p.State.Uptime == 0 {
+				continue
+			}
+
+			usage.RunningProjects++
+			if p.Resources != nil {
+				usage.Cpus += p.Resources.Cpus
+				usage.Memory += p.Resources.Memory
+			}
+		}
+	}
+
+	return &usage, nil
+}
+
+func (s *QuotaService) CheckCreateWorkspace(subject quota.Subject, projects []*project.Project) error {
+	requested := getRequestedUsage(projects, true)
+	requested.Workspaces = 1
+
+	return s.check(subject, projects, requested)
+}
+
+// CheckStartProjects checks the quota before starting the projects of an existing workspace.
+// The projects are expected to be stopped, their disk is already part of the usage.
+func (s *QuotaService) CheckStartProjects(subject quota.Subject, projects []*project.Project) error {
+	return s.check(subject, projects, getRequestedUsage(projects, false))
+}
+
+func (s *QuotaService) Lock(subject quota.Subject) func() {
+	return s.subjectMutex.Lock(subject.Key())
+}
+
+func (s *QuotaService) check(subject quota.Subject, projects []*project.Project, requested quota.Usage) error {
+	q, err := s.getEffectiveQuota(subject)
+	if err != nil || q == nil {
+		return err
+	}
+
+	err = checkResourceRequests(q, projects)
+	if err != nil {
+		return err
+	}
+
+	usage, err := s.GetUsage(subject)
+	if err != nil {
+		return err
+	}
+
+	return q.Check(usage.Add(requested))
+}
+
+// Returns the quota of the API key, the quota of its user, the default quota if neither has one
+// or nil if no quota applies. Requests that were not made with a client API key are not limited.
+func (s *QuotaService) getEffectiveQuota(subject quota.Subject) (*quota.Quota, error) {
+	if subject.IsEmpty() {
+		return nil, nil
+	}
+
+	finders := []func() (*quota.Quota, error){}
+	if subject.ApiKeyName != "" {
+		finders = append(finders, func() (*quota.Quota, error) { return s.quotaStore.Find(subject.ApiKeyName) })
+	}
+	if subject.UserId != "" {
+		finders = append(finders, func() (*quota.Quota, error) { return s.quotaStore.FindForUser(subject.UserId) })
+	}
+	finders = append(finders, func() (*quota.Quota, error) { return s.quotaStore.Find(quota.DefaultQuotaName) })
+
+	for _, find := range finders {
+		q, err := find()
+		if err == nil {
+			return q, nil
+		}
+		if !quota.IsQuotaNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func getRequestedUsage(projects []*project.Project, includeDisk bool) quota.Usage {
+	requested := quota.Usage{
+		RunningProjects: len(projects),
+	}
+
+	for _, p := range projects {
+		if p.Resources == nil {
+			continue
+		}
+
+		requested.Cpus += p.Resources.Cpus
+		requested.Memory += p.Resources.Memory
+		if includeDisk {
+			requested.Disk += p.Resources.Disk
+		}
+	}
+
+	return requested
+}
+
+// Projects that do not request a resource are not limited so they would exceed any quota of that resource
+func checkResourceRequests(q *quota.Quota, projects []*project.Project) error {
+	for _, p := range projects {
+		r := p.Resources
+		if r == nil {
+			r = &resources.Resources{}
+		}
+
+		if q.MaxCpus > 0 && r.Cpus == 0 {
+			return fmt.Errorf("%w: project %s must request CPUs", quota.ErrQuotaExceeded, p.Name)
+		}
+		if q.MaxMemory > 0 && r.Memory == 0 {
+			return fmt.Errorf("%w: project %s must request memory", quota.ErrQuotaExceeded, p.Name)
+		}
+		if q.MaxDisk > 0 && r.Disk == 0 {
+			return fmt.Errorf("%w: project %s must request disk", quota.ErrQuotaExceeded, p.Name)
+		}
+	}
+
+	return nil
+}
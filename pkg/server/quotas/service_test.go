@@ -0,0 +1,202 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package quotas_test
+
+import (
+	"testing"
+	"time"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_quotas "github.com/daytonaio/daytona/internal/testing/server/quotas"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	apikeys_dto "github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	users_dto "github.com/daytonaio/daytona/pkg/server/users/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKeyName = "developer"
+
+var subject = quota.Subject{ApiKeyName: apiKeyName}
+
+func TestQuotaService(t *testing.T) {
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+	_, err := apiKeyService.GenerateClientKey(apiKeyName, apikeys_dto.GenerateApiKeyDTO{Role: apikey.ApiKeyRoleDeveloper})
+	require.Nil(t, err)
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	err = workspaceStore.Save(&workspace.Workspace{
+		Id:        "ws1",
+		Name:      "ws1",
+		CreatedBy: apiKeyName,
+		Projects: []*project.Project{
+			{
+				Name:      "running",
+				Resources: &resources.Resources{Cpus: 2, Memory: 2048, Disk: 10},
+				State:     &project.ProjectState{Uptime: 100},
+			},
+			{
+				Name:      "stopped",
+				Resources: &resources.Resources{Cpus: 2, Memory: 2048, Disk: 10},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	userService := users.NewUserService(users.UserServiceConfig{
+		UserStore:     t_users.NewInMemoryUserStore(),
+		ApiKeyService: apiKeyService,
+	})
+
+	service := quotas.NewQuotaService(quotas.QuotaServiceConfig{
+		QuotaStore:     t_quotas.NewInMemoryQuotaStore(),
+		WorkspaceStore: workspaceStore,
+		ApiKeyService:  apiKeyService,
+		UserService:    userService,
+	})
+
+	newProject := func(cpus float64) *project.Project {
+		return &project.Project{Name: "new", Resources: &resources.Resources{Cpus: cpus, Memory: 1024, Disk: 10}}
+	}
+
+	t.Run("GetUsage", func(t *testing.T) {
+		usage, err := service.GetUsage(subject)
+		require.Nil(t, err)
+		require.Equal(t, &quota.Usage{Workspaces: 1, RunningProjects: 1, Cpus: 2, Memory: 2048, Disk: 20}, usage)
+	})
+
+	t.Run("Checks pass without quota", func(t *testing.T) {
+		require.Nil(t, service.CheckCreateWorkspace(subject, []*project.Project{newProject(16)}))
+	})
+
+	t.Run("Set fails validation", func(t *testing.T) {
+		_, err := service.Set(quota.Quota{ApiKeyName: "unknown", MaxWorkspaces: 1})
+		require.True(t, quotas.IsApiKeyNotFound(err))
+
+		_, err = service.Set(quota.Quota{ApiKeyName: apiKeyName, MaxWorkspaces: -1})
+		require.True(t, quota.IsInvalidQuota(err))
+	})
+
+	t.Run("Default quota", func(t *testing.T) {
+		_, err := service.Set(quota.Quota{ApiKeyName: quota.DefaultQuotaName, MaxWorkspaces: 1})
+		require.Nil(t, err)
+
+		err = service.CheckCreateWorkspace(subject, []*project.Project{newProject(1)})
+		require.True(t, quota.IsQuotaExceeded(err))
+
+		require.Nil(t, service.CheckCreateWorkspace(quota.Subject{}, []*project.Project{newProject(1)}))
+	})
+
+	t.Run("API key quota overrides the default quota", func(t *testing.T) {
+		_, err := service.Set(quota.Quota{ApiKeyName: apiKeyName, MaxWorkspaces: 2, MaxCpus: 4})
+		require.Nil(t, err)
+
+		require.Nil(t, service.CheckCreateWorkspace(subject, []*project.Project{newProject(2)}))
+
+		err = service.CheckCreateWorkspace(subject, []*project.Project{newProject(3)})
+		require.True(t, quota.IsQuotaExceeded(err))
+
+		err = service.CheckCreateWorkspace(subject, []*project.Project{{Name: "unlimited"}})
+		require.True(t, quota.IsQuotaExceeded(err))
+	})
+
+	t.Run("CheckStartProjects", func(t *testing.T) {
+		_, err := service.Set(quota.Quota{ApiKeyName: apiKeyName, MaxRunningProjects: 1})
+		require.Nil(t, err)
+
+		err = service.CheckStartProjects(subject, []*project.Project{newProject(1)})
+		require.True(t, quota.IsQuotaExceeded(err))
+	})
+
+	t.Run("Lock serializes the operations of a subject", func(t *testing.T) {
+		unlock := service.Lock(subject)
+
+		// Other API keys are not blocked
+		service.Lock(quota.Subject{ApiKeyName: "other"})()
+
+		locked := make(chan struct{})
+		go func() {
+			service.Lock(subject)()
+			close(locked)
+		}()
+
+		select {
+		case <-locked:
+			t.Fatal("Subject was locked twice")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		unlock()
+		<-locked
+	})
+
+	t.Run("List", func(t *testing.T) {
+		quotas, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, quotas, 2)
+		require.Nil(t, quotas[0].Usage)
+		require.Equal(t, 1, quotas[1].Usage.Workspaces)
+	})
+
+	t.Run("Usage of a user is counted across their API keys", func(t *testing.T) {
+		u, err := userService.Create(users_dto.CreateUserDTO{Name: "alice"})
+		require.Nil(t, err)
+
+		_, err = apiKeyService.GenerateClientKey("alice-laptop", apikeys_dto.GenerateApiKeyDTO{Role: apikey.ApiKeyRoleDeveloper, UserId: &u.Id})
+		require.Nil(t, err)
+
+		err = workspaceStore.Save(&workspace.Workspace{Id: "ws2", Name: "ws2", CreatedBy: "alice-session", OwnerId: u.Id})
+		require.Nil(t, err)
+
+		userSubject := quota.Subject{ApiKeyName: "alice-laptop", UserId: u.Id}
+		usage, err := service.GetUsage(userSubject)
+		require.Nil(t, err)
+		require.Equal(t, 1, usage.Workspaces)
+
+		_, err = service.Set(quota.Quota{UserId: "unknown", MaxWorkspaces: 1})
+		require.True(t, user.IsUserNotFound(err))
+
+		q, err := service.Set(quota.Quota{UserId: u.Name, MaxWorkspaces: 1})
+		require.Nil(t, err)
+		require.Equal(t, u.Id, q.UserId)
+
+		err = service.CheckCreateWorkspace(userSubject, []*project.Project{newProject(1)})
+		require.True(t, quota.IsQuotaExceeded(err))
+
+		// The quota of an API key overrides the quota of its user
+		_, err = service.Set(quota.Quota{ApiKeyName: "alice-laptop", MaxWorkspaces: 2})
+		require.Nil(t, err)
+
+		require.Nil(t, service.CheckCreateWorkspace(userSubject, []*project.Project{newProject(1)}))
+
+		quotas, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, quotas, 4)
+		for _, q := range quotas {
+			if q.ApiKeyName == "alice-laptop" || q.UserId == u.Id {
+				require.Equal(t, 1, q.Usage.Workspaces)
+			}
+		}
+
+		require.Nil(t, service.Delete("alice-laptop"))
+		require.Nil(t, service.DeleteForUser(u.Id))
+		require.True(t, quota.IsQuotaNotFound(service.DeleteForUser(u.Id)))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.Nil(t, service.Delete(quota.DefaultQuotaName))
+		require.True(t, quota.IsQuotaNotFound(service.Delete(quota.DefaultQuotaName)))
+	})
+}
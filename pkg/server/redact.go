@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/url"
+	"regexp"
+)
+
+const redactedValue = "xxxxx"
+
+var dsnPasswordRegex = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// Redacted returns a copy of the config without the credentials it contains so it can be returned by the API
+func (c Config) Redacted() Config {
+	if c.Database != nil {
+		database := *c.Database
+		database.Dsn = redactDsn(database.Dsn)
+		c.Database = &database
+	}
+
+	if c.Oidc != nil && c.Oidc.ClientSecret != "" {
+		oidc := *c.Oidc
+		oidc.ClientSecret = redactedValue
+		c.Oidc = &oidc
+	}
+
+	if c.PrebuildWebhookSecret != "" {
+		c.PrebuildWebhookSecret = redactedValue
+	}
+
+	return c
+}
+
+// RestoreRedacted replaces the redacted values that are sent back by API clients with the values of the current config
+func (c *Config) RestoreRedacted(current *Config) {
+	if c.Database != nil && current.Database != nil && c.Database.Dsn == redactDsn(current.Database.Dsn) {
+		c.Database.Dsn = current.Database.Dsn
+	}
+
+	if c.Oidc != nil && current.Oidc != nil && c.Oidc.ClientSecret == redactedValue {
+		c.Oidc.ClientSecret = current.Oidc.ClientSecret
+	}
+
+	if c.PrebuildWebhookSecret == redactedValue {
+		c.PrebuildWebhookSecret = current.PrebuildWebhookSecret
+	}
+}
+
+// redactDsn hides the password of postgres connection strings in both the URL and the keyword/value format
+func redactDsn(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err == nil && u.User != nil {
+		return u.Redacted()
+	}
+
+	return dsnPasswordRegex.ReplaceAllString(dsn, "${1}"+redactedValue)
+}
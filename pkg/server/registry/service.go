@@ -105,6 +105,8 @@ func (s *LocalContainerRegistry) Start() error {
 		Image: s.image,
 		Env: []string{
 			fmt.Sprintf("REGISTRY_HTTP_ADDR=0.0.0.0:%d", s.port),
+			// Build images removed by the retention policy are deleted from the registry
+			"REGISTRY_STORAGE_DELETE_ENABLED=true",
 		},
 		ExposedPorts: nat.PortSet{
 			nat.Port(fmt.Sprintf("%d/tcp", s.port)): {},
@@ -0,0 +1,16 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidSecretName = errors.New("secret name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+)
+
+func IsInvalidSecretName(err error) bool {
+	return err.Error() == ErrInvalidSecretName.Error()
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server/secrets/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type ISecretService interface {
+	List() ([]*secret.Secret, error)
+	Find(name string) (*secret.Secret, error)
+	// Set creates or updates a secret. New secrets are owned by the user of the context.
+	Set(ctx context.Context, name string, req dto.SetSecretDTO) (*secret.Secret, error)
+	Delete(name string) error
+	// ResolveEnvVars returns a copy of the env vars with ${secret:NAME} references replaced by the secret values.
+	// Only the secrets that the owner can access are resolved.
+	ResolveEnvVars(ownerId string, envVars map[string]string) (map[string]string, error)
+}
+
+type SecretServiceConfig struct {
+	SecretStore secret.Store
+}
+
+func NewSecretService(config SecretServiceConfig) ISecretService {
+	return &SecretService{
+		secretStore: config.SecretStore,
+	}
+}
+
+type SecretService struct {
+	secretStore secret.Store
+}
+
+func (s *SecretService) List() ([]*secret.Secret, error) {
+	return s.secretStore.List()
+}
+
+func (s *SecretService) Find(name string) (*secret.Secret, error) {
+	return s.secretStore.Find(name)
+}
+
+func (s *SecretService) Set(ctx context.Context, name string, req dto.SetSecretDTO) (*secret.Secret, error) {
+	if !secret.IsValidName(name) {
+		return nil, ErrInvalidSecretName
+	}
+
+	now := time.Now()
+
+	sec, err := s.secretStore.Find(name)
+	if err != nil {
+		if !secret.IsSecretNotFound(err) {
+			return nil, err
+		}
+
+		sec = &secret.Secret{
+			Name:      name,
+			OwnerId:   user.UserId(ctx),
+			CreatedAt: now,
+		}
+	}
+
+	sec.Value = req.Value
+	sec.UpdatedAt = now
+
+	return sec, s.secretStore.Save(sec)
+}
+
+func (s *SecretService) Delete(name string) error {
+	sec, err := s.secretStore.Find(name)
+	if err != nil {
+		return err
+	}
+
+	return s.secretStore.Delete(sec)
+}
+
+func (s *SecretService) ResolveEnvVars(ownerId string, envVars map[string]string) (map[string]string, error) {
+	return secret.ResolveReferences(envVars, func(name string) (string, error) {
+		sec, err := s.secretStore.Find(name)
+		if err != nil {
+			return "", err
+		}
+
+		// Secrets of other users are reported as not found so that their names are not revealed
+		if ownerId != "" && !user.CanAccess(sec.OwnerId, ownerId) {
+			return "", secret.ErrSecretNotFound
+		}
+
+		return sec.Value, nil
+	})
+}
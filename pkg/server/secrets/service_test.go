@@ -0,0 +1,83 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"context"
+	"testing"
+
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/secrets/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretService(t *testing.T) {
+	ctx := context.Background()
+
+	service := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: t_secrets.NewInMemorySecretStore(),
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		created, err := service.Set(ctx, "NPM_TOKEN", dto.SetSecretDTO{Value: "first"})
+		require.Nil(t, err)
+
+		updated, err := service.Set(ctx, "NPM_TOKEN", dto.SetSecretDTO{Value: "second"})
+		require.Nil(t, err)
+		require.Equal(t, "second", updated.Value)
+		require.Equal(t, created.CreatedAt, updated.CreatedAt)
+
+		_, err = service.Set(ctx, "NPM TOKEN", dto.SetSecretDTO{Value: "value"})
+		require.True(t, secrets.IsInvalidSecretName(err))
+
+		list, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, list, 1)
+	})
+
+	t.Run("ResolveEnvVars", func(t *testing.T) {
+		envVars := map[string]string{"NPM_TOKEN": "${secret:NPM_TOKEN}", "NODE_ENV": "development"}
+
+		resolved, err := service.ResolveEnvVars("", envVars)
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"NPM_TOKEN": "second", "NODE_ENV": "development"}, resolved)
+		require.Equal(t, "${secret:NPM_TOKEN}", envVars["NPM_TOKEN"])
+
+		_, err = service.ResolveEnvVars("", map[string]string{"TOKEN": "${secret:MISSING}"})
+		require.True(t, secret.IsSecretNotFound(err))
+	})
+
+	t.Run("ResolveEnvVars only resolves secrets the owner can access", func(t *testing.T) {
+		aliceCtx := context.WithValue(ctx, user.USER_ID_CONTEXT_KEY, "alice")
+		sec, err := service.Set(aliceCtx, "ALICE_TOKEN", dto.SetSecretDTO{Value: "alice-token"})
+		require.Nil(t, err)
+		require.Equal(t, "alice", sec.OwnerId)
+
+		envVars := map[string]string{"TOKEN": "${secret:ALICE_TOKEN}"}
+
+		resolved, err := service.ResolveEnvVars("alice", envVars)
+		require.Nil(t, err)
+		require.Equal(t, "alice-token", resolved["TOKEN"])
+
+		_, err = service.ResolveEnvVars("bob", envVars)
+		require.True(t, secret.IsSecretNotFound(err))
+
+		// Shared secrets are resolved for all users
+		_, err = service.ResolveEnvVars("bob", map[string]string{"NPM_TOKEN": "${secret:NPM_TOKEN}"})
+		require.Nil(t, err)
+
+		require.Nil(t, service.Delete("ALICE_TOKEN"))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := service.Delete("NPM_TOKEN")
+		require.Nil(t, err)
+
+		err = service.Delete("NPM_TOKEN")
+		require.True(t, secret.IsSecretNotFound(err))
+	})
+}
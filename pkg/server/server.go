@@ -7,14 +7,21 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/daytonaio/daytona/pkg/server/auth"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/hashicorp/go-plugin"
@@ -36,6 +43,13 @@ type ServerInstanceConfig struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	AuditService             audit.IAuditService
+	EventBus                 events.IEventBus
+	WebhookService           webhooks.IWebhookService
+	QuotaService             quotas.IQuotaService
+	UserService              users.IUserService
+	AuthService              auth.IAuthService
+	SecretService            secrets.ISecretService
 	TelemetryService         telemetry.TelemetryService
 }
 
@@ -65,6 +79,13 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
+			AuditService:             serverConfig.AuditService,
+			EventBus:                 serverConfig.EventBus,
+			WebhookService:           serverConfig.WebhookService,
+			QuotaService:             serverConfig.QuotaService,
+			UserService:              serverConfig.UserService,
+			AuthService:              serverConfig.AuthService,
+			SecretService:            serverConfig.SecretService,
 			TelemetryService:         serverConfig.TelemetryService,
 		}
 	}
@@ -87,6 +108,13 @@ type Server struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	AuditService             audit.IAuditService
+	EventBus                 events.IEventBus
+	WebhookService           webhooks.IWebhookService
+	QuotaService             quotas.IQuotaService
+	UserService              users.IUserService
+	AuthService              auth.IAuthService
+	SecretService            secrets.ISecretService
 	TelemetryService         telemetry.TelemetryService
 }
 
@@ -34,25 +34,69 @@ type NetworkKey struct {
 } // @name NetworkKey
 
 type Config struct {
-	ProvidersDir              string         `json:"providersDir" validate:"required"`
-	RegistryUrl               string         `json:"registryUrl" validate:"required"`
-	Id                        string         `json:"id" validate:"required"`
-	ServerDownloadUrl         string         `json:"serverDownloadUrl" validate:"required"`
-	Frps                      *FRPSConfig    `json:"frps,omitempty" validate:"optional"`
-	ApiPort                   uint32         `json:"apiPort" validate:"required"`
-	HeadscalePort             uint32         `json:"headscalePort" validate:"required"`
-	BinariesPath              string         `json:"binariesPath" validate:"required"`
-	LogFile                   *LogFileConfig `json:"logFile" validate:"required"`
-	DefaultProjectImage       string         `json:"defaultProjectImage" validate:"required"`
-	DefaultProjectUser        string         `json:"defaultProjectUser" validate:"required"`
-	BuilderImage              string         `json:"builderImage" validate:"required"`
-	LocalBuilderRegistryPort  uint32         `json:"localBuilderRegistryPort" validate:"required"`
-	LocalBuilderRegistryImage string         `json:"localBuilderRegistryImage" validate:"required"`
-	BuilderRegistryServer     string         `json:"builderRegistryServer" validate:"required"`
-	BuildImageNamespace       string         `json:"buildImageNamespace" validate:"optional"`
-	SamplesIndexUrl           string         `json:"samplesIndexUrl" validate:"optional"`
+	ProvidersDir              string            `json:"providersDir" validate:"required"`
+	RegistryUrl               string            `json:"registryUrl" validate:"required"`
+	Id                        string            `json:"id" validate:"required"`
+	ServerDownloadUrl         string            `json:"serverDownloadUrl" validate:"required"`
+	Frps                      *FRPSConfig       `json:"frps,omitempty" validate:"optional"`
+	ApiPort                   uint32            `json:"apiPort" validate:"required"`
+	HeadscalePort             uint32            `json:"headscalePort" validate:"required"`
+	BinariesPath              string            `json:"binariesPath" validate:"required"`
+	LogFile                   *LogFileConfig    `json:"logFile" validate:"required"`
+	DefaultProjectImage       string            `json:"defaultProjectImage" validate:"required"`
+	DefaultProjectUser        string            `json:"defaultProjectUser" validate:"required"`
+	BuilderImage              string            `json:"builderImage" validate:"required"`
+	LocalBuilderRegistryPort  uint32            `json:"localBuilderRegistryPort" validate:"required"`
+	LocalBuilderRegistryImage string            `json:"localBuilderRegistryImage" validate:"required"`
+	BuilderRegistryServer     string            `json:"builderRegistryServer" validate:"required"`
+	BuildImageNamespace       string            `json:"buildImageNamespace" validate:"optional"`
+	SamplesIndexUrl           string            `json:"samplesIndexUrl" validate:"optional"`
+	IdleTimeout               int               `json:"idleTimeout" validate:"optional"`
+	MaxConcurrentBuilds       int               `json:"maxConcurrentBuilds" validate:"optional"`
+	MaxProjectConfigBuilds    int               `json:"maxProjectConfigBuilds" validate:"optional"`
+	BuildTimeout              int               `json:"buildTimeout" validate:"optional"`
+	Database                  *DatabaseConfig   `json:"database,omitempty" validate:"optional"`
+	Oidc                      *OidcConfig       `json:"oidc,omitempty" validate:"optional"`
+	Encryption                *EncryptionConfig `json:"encryption,omitempty" validate:"optional"`
+	// Secret that git providers sign prebuild webhook events with. Generated on first start
+	PrebuildWebhookSecret string `json:"prebuildWebhookSecret,omitempty" validate:"optional"`
 } // @name ServerConfig
 
+// Key used to encrypt secrets and credentials stored in the database
+type EncryptionConfig struct {
+	// Path of the key file. Defaults to encryption.key in the server config directory, which is generated
+	// on first start if the database has no encrypted credentials yet
+	KeyFile string `json:"keyFile,omitempty" validate:"optional"`
+	// Command used instead of the key file, e.g. a wrapper around a KMS. It is called with an additional encrypt
+	// or decrypt argument, reads a base64 encoded key from stdin and writes the base64 encoded result to stdout
+	KeyCommand string `json:"keyCommand,omitempty" validate:"optional"`
+} // @name EncryptionConfig
+
+// OIDC provider that users log in with to get short-lived client API keys
+type OidcConfig struct {
+	// URL of the OpenID Connect issuer
+	Issuer       string `json:"issuer" validate:"required"`
+	ClientId     string `json:"clientId" validate:"required"`
+	ClientSecret string `json:"clientSecret,omitempty" validate:"optional"`
+	// Additional scopes to request next to openid, e.g. offline_access for refresh tokens
+	Scopes []string `json:"scopes,omitempty" validate:"optional"`
+	// ID token claim that lists the groups of the user. Defaults to groups
+	GroupsClaim string `json:"groupsClaim,omitempty" validate:"optional"`
+	// Maps groups to API key roles. Users in several mapped groups get the most privileged role (admin, developer, ci, read-only)
+	RoleMapping map[string]string `json:"roleMapping,omitempty" validate:"optional"`
+	// Role of users without a mapped group. Users without a mapped group are denied if empty
+	DefaultRole string `json:"defaultRole,omitempty" validate:"optional"`
+	// Lifetime of the API keys minted on login in minutes. Defaults to 60
+	ApiKeyTtl int `json:"apiKeyTtl,omitempty" validate:"optional"`
+} // @name OidcConfig
+
+type DatabaseConfig struct {
+	// sqlite or postgres
+	Driver string `json:"driver" validate:"required"`
+	// Database file path for sqlite or connection string for postgres. The password is redacted in API responses
+	Dsn string `json:"dsn" validate:"required"`
+} // @name DatabaseConfig
+
 type LogFileConfig struct {
 	Path       string `json:"path" validate:"required"`
 	MaxSize    int    `json:"maxSize" validate:"required"`
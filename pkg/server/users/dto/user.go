@@ -0,0 +1,8 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type CreateUserDTO struct {
+	Name string `json:"name" validate:"required"`
+} // @name CreateUserDTO
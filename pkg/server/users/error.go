@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"errors"
+)
+
+var (
+	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrInvalidUserName   = errors.New("user name is not valid. Only [a-zA-Z0-9-_.@] are allowed")
+)
+
+func IsUserAlreadyExists(err error) bool {
+	return err.Error() == ErrUserAlreadyExists.Error()
+}
+
+func IsInvalidUserName(err error) bool {
+	return err.Error() == ErrInvalidUserName.Error()
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/users/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+var validUserName = regexp.MustCompile(`^[a-zA-Z0-9-_.@]+$`)
+var invalidUserNameChars = regexp.MustCompile(`[^a-zA-Z0-9-_.@]+`)
+
+type IUserService interface {
+	List() ([]*user.User, error)
+	Find(idOrName string) (*user.User, error)
+	Create(req dto.CreateUserDTO) (*user.User, error)
+	FindByIdentity(issuer, subject string) (*user.User, error)
+	CreateForIdentity(name, issuer, subject string) (*user.User, error)
+	Delete(idOrName string) error
+}
+
+type UserServiceConfig struct {
+	UserStore     user.Store
+	ApiKeyService apikeys.IApiKeyService
+}
+
+func NewUserService(config UserServiceConfig) IUserService {
+	return &UserService{
+		userStore:     config.UserStore,
+		apiKeyService: config.ApiKeyService,
+	}
+}
+
+type UserService struct {
+	userStore     user.Store
+	apiKeyService apikeys.IApiKeyService
+}
+
+func (s *UserService) List() ([]*user.User, error) {
+	return s.userStore.List()
+}
+
+func (s *UserService) Find(idOrName string) (*user.User, error) {
+	return s.userStore.Find(idOrName)
+}
+
+func (s *UserService) Create(req dto.CreateUserDTO) (*user.User, error) {
+	if !validUserName.MatchString(req.Name) {
+		return nil, ErrInvalidUserName
+	}
+
+	_, err := s.userStore.Find(req.Name)
+	if err == nil {
+		return nil, ErrUserAlreadyExists
+	}
+	if !user.IsUserNotFound(err) {
+		return nil, err
+	}
+
+	u := &user.User{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		Name:      req.Name,
+		CreatedAt: time.Now(),
+	}
+
+	return u, s.userStore.Save(u)
+}
+
+func (s *UserService) FindByIdentity(issuer, subject string) (*user.User, error) {
+	return s.userStore.FindByIdentity(issuer, subject)
+}
+
+// CreateForIdentity creates the user of an OIDC identity. The name is only used to identify the
+// user in Daytona so a suffix is added if the name is taken by another user or not valid.
+func (s *UserService) CreateForIdentity(name, issuer, subject string) (*user.User, error) {
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+
+	name = invalidUserNameChars.ReplaceAllString(name, "-")
+	if name == "" {
+		name = id
+	} else {
+		_, err := s.userStore.Find(name)
+		if err == nil {
+			name = fmt.Sprintf("%s-%s", name, id[:6])
+		} else if !user.IsUserNotFound(err) {
+			return nil, err
+		}
+	}
+
+	u := &user.User{
+		Id:        id,
+		Name:      name,
+		Issuer:    issuer,
+		Subject:   subject,
+		CreatedAt: time.Now(),
+	}
+
+	return u, s.userStore.Save(u)
+}
+
+// Delete revokes the API keys of the user before removing it.
+// Resources owned by the user are kept and remain accessible to admins.
+func (s *UserService) Delete(idOrName string) error {
+	u, err := s.userStore.Find(idOrName)
+	if err != nil {
+		return err
+	}
+
+	apiKeys, err := s.apiKeyService.ListClientKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, apiKey := range apiKeys {
+		if apiKey.UserId != u.Id {
+			continue
+		}
+
+		err = s.apiKeyService.Revoke(apiKey.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.userStore.Delete(u)
+}
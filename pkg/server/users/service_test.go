@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users_test
+
+import (
+	"testing"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	apikeys_dto "github.com/daytonaio/daytona/pkg/server/apikeys/dto"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/server/users/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService(t *testing.T) {
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	service := users.NewUserService(users.UserServiceConfig{
+		UserStore:     t_users.NewInMemoryUserStore(),
+		ApiKeyService: apiKeyService,
+	})
+
+	var alice *user.User
+
+	t.Run("Create", func(t *testing.T) {
+		var err error
+		alice, err = service.Create(dto.CreateUserDTO{Name: "alice@example.com"})
+		require.Nil(t, err)
+		require.NotEmpty(t, alice.Id)
+
+		_, err = service.Create(dto.CreateUserDTO{Name: "alice@example.com"})
+		require.True(t, users.IsUserAlreadyExists(err))
+
+		_, err = service.Create(dto.CreateUserDTO{Name: "not valid"})
+		require.True(t, users.IsInvalidUserName(err))
+	})
+
+	t.Run("Find by ID or name", func(t *testing.T) {
+		u, err := service.Find(alice.Id)
+		require.Nil(t, err)
+		require.Equal(t, alice, u)
+
+		u, err = service.Find(alice.Name)
+		require.Nil(t, err)
+		require.Equal(t, alice, u)
+	})
+
+	t.Run("CreateForIdentity", func(t *testing.T) {
+		u, err := service.CreateForIdentity("alice@example.com", "https://issuer", "subject")
+		require.Nil(t, err)
+		require.NotEqual(t, alice.Name, u.Name)
+		require.Regexp(t, `^alice@example.com-`, u.Name)
+
+		found, err := service.FindByIdentity("https://issuer", "subject")
+		require.Nil(t, err)
+		require.Equal(t, u, found)
+
+		_, err = service.FindByIdentity("https://other-issuer", "subject")
+		require.True(t, user.IsUserNotFound(err))
+
+		u, err = service.CreateForIdentity("Alice Smith", "https://issuer", "other-subject")
+		require.Nil(t, err)
+		require.Equal(t, "Alice-Smith", u.Name)
+	})
+
+	t.Run("Delete revokes API keys of the user", func(t *testing.T) {
+		_, err := apiKeyService.GenerateClientKey("alice", apikeys_dto.GenerateApiKeyDTO{Role: apikey.ApiKeyRoleDeveloper, UserId: &alice.Id})
+		require.Nil(t, err)
+		_, err = apiKeyService.GenerateClientKey("admin", apikeys_dto.GenerateApiKeyDTO{Role: apikey.ApiKeyRoleAdmin})
+		require.Nil(t, err)
+
+		err = service.Delete(alice.Name)
+		require.Nil(t, err)
+
+		_, err = service.Find(alice.Id)
+		require.True(t, user.IsUserNotFound(err))
+
+		apiKeys, err := apiKeyService.ListClientKeys()
+		require.Nil(t, err)
+		require.Len(t, apiKeys, 1)
+		require.Equal(t, "admin", apiKeys[0].Name)
+	})
+
+	t.Run("Delete unknown user", func(t *testing.T) {
+		err := service.Delete("unknown")
+		require.True(t, user.IsUserNotFound(err))
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Start delivers every event published on the event bus to the subscribed webhooks
+func (s *WebhookService) Start() {
+	eventCh, _ := s.eventBus.Subscribe()
+
+	go func() {
+		for event := range eventCh {
+			go s.Dispatch(event)
+		}
+	}()
+}
+
+// Dispatch delivers the event to all webhooks subscribed to its type and returns once every delivery has either succeeded or exhausted its retries
+func (s *WebhookService) Dispatch(event events.Event) {
+	webhooks, err := s.webhookStore.List()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, w := range webhooks {
+		if !w.Matches(event.Type) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(w *webhook.Webhook) {
+			defer wg.Done()
+			s.deliver(w, event, payload)
+		}(w)
+	}
+
+	wg.Wait()
+}
+
+func (s *WebhookService) deliver(w *webhook.Webhook, event events.Event, payload []byte) {
+	retryInterval := s.retryInterval
+
+	for attempt := 1; attempt <= s.maxAttempts; attempt++ {
+		delivery := s.send(w, event, payload)
+		delivery.Attempt = attempt
+
+		err := s.deliveryStore.Save(delivery)
+		if err != nil {
+			log.Error(err)
+		}
+
+		if delivery.Success {
+			return
+		}
+
+		if attempt < s.maxAttempts {
+			time.Sleep(retryInterval)
+			retryInterval *= 2
+		}
+	}
+
+	log.Errorf("Failed to deliver event %s to webhook %s after %d attempts", event.Id, w.Id, s.maxAttempts)
+}
+
+func (s *WebhookService) send(w *webhook.Webhook, event events.Event, payload []byte) *webhook.Delivery {
+	delivery := &webhook.Delivery{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		WebhookId: w.Id,
+		EventId:   event.Id,
+		EventType: event.Type,
+		CreatedAt: time.Now(),
+	}
+
+	defer func() {
+		delivery.Duration = time.Since(delivery.CreatedAt).Milliseconds()
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, w.Url, bytes.NewReader(payload))
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.EventHeader, string(event.Type))
+	req.Header.Set(webhook.DeliveryHeader, delivery.Id)
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(w.Secret, payload))
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	defer res.Body.Close()
+
+	delivery.StatusCode = res.StatusCode
+	delivery.Success = res.StatusCode >= 200 && res.StatusCode < 300
+	if !delivery.Success {
+		delivery.Error = fmt.Sprintf("unexpected status code %d", res.StatusCode)
+	}
+
+	return delivery
+}
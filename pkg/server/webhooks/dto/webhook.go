@@ -0,0 +1,12 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/events"
+
+type CreateWebhookDTO struct {
+	Url        string             `json:"url" validate:"required"`
+	Secret     string             `json:"secret" validate:"required"`
+	EventTypes []events.EventType `json:"eventTypes" validate:"optional"`
+} // @name CreateWebhookDTO
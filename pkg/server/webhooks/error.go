@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidWebhookUrl    = errors.New("webhook URL must be an absolute http or https URL")
+	ErrInvalidWebhookSecret = errors.New("webhook secret must not be empty")
+	ErrInvalidEventType     = errors.New("invalid event type")
+)
+
+func IsInvalidWebhookUrl(err error) bool {
+	return err.Error() == ErrInvalidWebhookUrl.Error()
+}
+
+func IsInvalidWebhookSecret(err error) bool {
+	return err.Error() == ErrInvalidWebhookSecret.Error()
+}
+
+func IsInvalidEventType(err error) bool {
+	return err.Error() == ErrInvalidEventType.Error()
+}
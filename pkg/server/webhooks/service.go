@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server/webhooks/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+const defaultMaxAttempts = 5
+const defaultRetryInterval = 5 * time.Second
+const deliveryTimeout = 10 * time.Second
+
+type IWebhookService interface {
+	List() ([]*webhook.Webhook, error)
+	Find(id string) (*webhook.Webhook, error)
+	Create(req dto.CreateWebhookDTO) (*webhook.Webhook, error)
+	Delete(id string) error
+	ListDeliveries(webhookId string, limit *int) ([]*webhook.Delivery, error)
+	Dispatch(event events.Event)
+	Start()
+}
+
+type WebhookServiceConfig struct {
+	WebhookStore  webhook.Store
+	DeliveryStore webhook.DeliveryStore
+	EventBus      events.IEventBus
+	// Maximum number of delivery attempts per event, defaults to 5
+	MaxAttempts int
+	// Interval before the first retry, doubled after every failed attempt. Defaults to 5 seconds
+	RetryInterval time.Duration
+}
+
+func NewWebhookService(config WebhookServiceConfig) IWebhookService {
+	maxAttempts := config.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	retryInterval := config.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = defaultRetryInterval
+	}
+
+	return &WebhookService{
+		webhookStore:  config.WebhookStore,
+		deliveryStore: config.DeliveryStore,
+		eventBus:      config.EventBus,
+		maxAttempts:   maxAttempts,
+		retryInterval: retryInterval,
+		httpClient:    &http.Client{Timeout: deliveryTimeout},
+	}
+}
+
+type WebhookService struct {
+	webhookStore  webhook.Store
+	deliveryStore webhook.DeliveryStore
+	eventBus      events.IEventBus
+	maxAttempts   int
+	retryInterval time.Duration
+	httpClient    *http.Client
+}
+
+func (s *WebhookService) List() ([]*webhook.Webhook, error) {
+	return s.webhookStore.List()
+}
+
+func (s *WebhookService) Find(id string) (*webhook.Webhook, error) {
+	return s.webhookStore.Find(id)
+}
+
+func (s *WebhookService) Create(req dto.CreateWebhookDTO) (*webhook.Webhook, error) {
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+
+	if req.Secret == "" {
+		return nil, ErrInvalidWebhookSecret
+	}
+
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(events.EventTypes, eventType) {
+			return nil, ErrInvalidEventType
+		}
+	}
+
+	w := &webhook.Webhook{
+		Id:         stringid.TruncateID(stringid.GenerateRandomID()),
+		Url:        req.Url,
+		Secret:     req.Secret,
+		EventTypes: req.EventTypes,
+		CreatedAt:  time.Now(),
+	}
+
+	if w.EventTypes == nil {
+		w.EventTypes = []events.EventType{}
+	}
+
+	return w, s.webhookStore.Save(w)
+}
+
+func (s *WebhookService) Delete(id string) error {
+	w, err := s.webhookStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.deliveryStore.DeleteForWebhook(w.Id)
+	if err != nil {
+		return err
+	}
+
+	return s.webhookStore.Delete(w)
+}
+
+func (s *WebhookService) ListDeliveries(webhookId string, limit *int) ([]*webhook.Delivery, error) {
+	_, err := s.webhookStore.Find(webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliveryStore.List(&webhook.DeliveryFilter{
+		WebhookId: &webhookId,
+		Limit:     limit,
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	t_webhooks "github.com/daytonaio/daytona/internal/testing/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/server/webhooks"
+	"github.com/daytonaio/daytona/pkg/server/webhooks/dto"
+	"github.com/daytonaio/daytona/pkg/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+const secret = "webhook-secret"
+
+func TestWebhookService(t *testing.T) {
+	var failures atomic.Int32
+	var received atomic.Int32
+	var signatureValid atomic.Bool
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		signatureValid.Store(r.Header.Get(webhook.SignatureHeader) == webhook.Sign(secret, body))
+		received.Add(1)
+	}))
+	defer receiver.Close()
+
+	deliveryStore := t_webhooks.NewInMemoryWebhookDeliveryStore()
+
+	service := webhooks.NewWebhookService(webhooks.WebhookServiceConfig{
+		WebhookStore:  t_webhooks.NewInMemoryWebhookStore(),
+		DeliveryStore: deliveryStore,
+		EventBus:      events.NewEventBus(),
+		MaxAttempts:   3,
+		RetryInterval: time.Millisecond,
+	})
+
+	var webhookId string
+
+	t.Run("Create", func(t *testing.T) {
+		w, err := service.Create(dto.CreateWebhookDTO{
+			Url:        receiver.URL,
+			Secret:     secret,
+			EventTypes: []events.EventType{events.EventTypeWorkspaceCreated},
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, w.Id)
+
+		webhookId = w.Id
+
+		webhooks, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, webhooks, 1)
+	})
+
+	t.Run("Create fails validation", func(t *testing.T) {
+		_, err := service.Create(dto.CreateWebhookDTO{Url: "ftp://example.com", Secret: secret})
+		require.True(t, webhooks.IsInvalidWebhookUrl(err))
+
+		_, err = service.Create(dto.CreateWebhookDTO{Url: receiver.URL})
+		require.True(t, webhooks.IsInvalidWebhookSecret(err))
+
+		_, err = service.Create(dto.CreateWebhookDTO{Url: receiver.URL, Secret: secret, EventTypes: []events.EventType{"workspace.unknown"}})
+		require.True(t, webhooks.IsInvalidEventType(err))
+	})
+
+	t.Run("Dispatch", func(t *testing.T) {
+		service.Dispatch(events.Event{Id: "event1", Type: events.EventTypeWorkspaceCreated})
+
+		require.EqualValues(t, 1, received.Load())
+		require.True(t, signatureValid.Load())
+
+		deliveries, err := service.ListDeliveries(webhookId, nil)
+		require.Nil(t, err)
+		require.Len(t, deliveries, 1)
+		require.True(t, deliveries[0].Success)
+		require.Equal(t, http.StatusOK, deliveries[0].StatusCode)
+	})
+
+	t.Run("Dispatch skips unsubscribed event types", func(t *testing.T) {
+		service.Dispatch(events.Event{Id: "event2", Type: events.EventTypeWorkspaceStopped})
+
+		require.EqualValues(t, 1, received.Load())
+	})
+
+	t.Run("Dispatch retries failed deliveries", func(t *testing.T) {
+		failures.Store(2)
+
+		service.Dispatch(events.Event{Id: "event3", Type: events.EventTypeWorkspaceCreated})
+
+		require.EqualValues(t, 2, received.Load())
+
+		deliveries, err := deliveryStore.List(&webhook.DeliveryFilter{WebhookId: &webhookId})
+		require.Nil(t, err)
+
+		attempts := 0
+		for _, d := range deliveries {
+			if d.EventId == "event3" {
+				attempts++
+				require.Equal(t, d.Attempt == 3, d.Success)
+			}
+		}
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("Dispatch gives up after max attempts", func(t *testing.T) {
+		failures.Store(5)
+
+		service.Dispatch(events.Event{Id: "event4", Type: events.EventTypeWorkspaceCreated})
+
+		require.EqualValues(t, 2, received.Load())
+		require.EqualValues(t, 2, failures.Load())
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := service.Delete(webhookId)
+		require.Nil(t, err)
+
+		_, err = service.Find(webhookId)
+		require.True(t, webhook.IsWebhookNotFound(err))
+
+		deliveries, err := deliveryStore.List(&webhook.DeliveryFilter{WebhookId: &webhookId})
+		require.Nil(t, err)
+		require.Empty(t, deliveries)
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/snapshot"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (s *WorkspaceService) CloneWorkspace(ctx context.Context, workspaceId string, req dto.CloneWorkspaceDTO) (*workspace.Workspace, error) {
+	source, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = s.workspaceStore.Find(req.Name)
+	if err == nil {
+		return nil, ErrWorkspaceAlreadyExists
+	}
+
+	if !isValidWorkspaceName(req.Name) {
+		return nil, ErrInvalidWorkspaceName
+	}
+
+	idleTimeout := source.IdleTimeout
+	if req.IdleTimeout != nil {
+		if *req.IdleTimeout < 0 {
+			return nil, ErrInvalidIdleTimeout
+		}
+		idleTimeout = req.IdleTimeout
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &source.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	// The source projects are snapshotted before the clone is created so it reflects their state at the time of the request.
+	// The snapshots are only needed while the clone is created.
+	snapshotName := fmt.Sprintf("clone-%s", req.Id)
+	defer s.removeSnapshots(source, target, &snapshotName)
+
+	for _, p := range source.Projects {
+		err = s.provisioner.SnapshotProject(p, target, snapshotName)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.snapshotStore.Save(&snapshot.Snapshot{
+			Name:        snapshotName,
+			WorkspaceId: source.Id,
+			ProjectName: p.Name,
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	createReq := dto.CreateWorkspaceDTO{
+		Id:          req.Id,
+		Name:        req.Name,
+		Target:      source.Target,
+		IdleTimeout: idleTimeout,
+		Projects:    []dto.CreateProjectDTO{},
+	}
+
+	for _, p := range source.Projects {
+		createReq.Projects = append(createReq.Projects, dto.CreateProjectDTO{
+			Name:        p.Name,
+			Image:       &p.Image,
+			User:        &p.User,
+			BuildConfig: p.BuildConfig,
+			Source: dto.CreateProjectSourceDTO{
+				Repository: p.Repository,
+			},
+			EnvVars:             getCloneEnvVars(p),
+			GitProviderConfigId: p.GitProviderConfigId,
+			Resources:           p.Resources,
+		})
+	}
+
+	w, err := s.CreateWorkspace(ctx, createReq)
+	if err != nil {
+		return w, err
+	}
+
+	for _, p := range w.Projects {
+		sourceProject, err := source.GetProject(p.Name)
+		if err != nil {
+			return w, err
+		}
+
+		err = s.cloneProject(ctx, sourceProject, p, target, snapshotName)
+		if err != nil {
+			return w, err
+		}
+	}
+
+	return w, nil
+}
+
+func (s *WorkspaceService) cloneProject(ctx context.Context, source *project.Project, p *project.Project, target *provider.ProviderTarget, snapshotName string) error {
+	projectLogger := s.loggerFactory.CreateProjectLogger(p.WorkspaceId, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Restoring project %s from workspace %s\n", p.Name, source.WorkspaceId)))
+
+	err := s.StopProject(ctx, p.WorkspaceId, p.Name)
+	if err != nil {
+		return err
+	}
+
+	err = s.provisioner.CloneProject(source, p, target, snapshotName)
+	if err != nil {
+		return err
+	}
+
+	err = s.StartProject(ctx, p.WorkspaceId, p.Name)
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Project %s restored\n", p.Name)))
+
+	return nil
+}
+
+// Server managed env vars are generated again for the clone so only the user defined ones are copied
+func getCloneEnvVars(p *project.Project) map[string]string {
+	serverEnvVars := project.GetProjectEnvVars(p, project.ProjectEnvVarParams{}, true)
+
+	envVars := map[string]string{}
+	for k, v := range p.EnvVars {
+		if _, ok := serverEnvVars[k]; !ok {
+			envVars[k] = v
+		}
+	}
+
+	return envVars
+}
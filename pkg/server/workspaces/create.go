@@ -15,12 +15,14 @@ import (
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
@@ -56,10 +58,24 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		return nil, ErrInvalidWorkspaceName
 	}
 
+	if req.IdleTimeout != nil && *req.IdleTimeout < 0 {
+		return nil, ErrInvalidIdleTimeout
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLimits := provider.GetTargetResourceLimits(target.Options)
+
 	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+		Id:          req.Id,
+		Name:        req.Name,
+		Target:      req.Target,
+		IdleTimeout: req.IdleTimeout,
+		CreatedBy:   apikey.ClientApiKeyName(ctx),
+		OwnerId:     user.UserId(ctx),
 	}
 
 	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
@@ -80,7 +96,7 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 
 		p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
 		if p.GitProviderConfigId == nil || *p.GitProviderConfigId == "" {
-			configs, err := s.gitProviderService.ListConfigsForUrl(p.Repository.Url)
+			configs, err := s.listOwnedGitProviderConfigsForUrl(w.OwnerId, p.Repository.Url)
 			if err != nil {
 				return nil, err
 			}
@@ -92,6 +108,11 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 			if len(configs) == 1 {
 				p.GitProviderConfigId = &configs[0].Id
 			}
+		} else {
+			err = s.checkGitProviderConfigAccess(w.OwnerId, *p.GitProviderConfigId)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if p.Repository.Sha == "" {
@@ -117,6 +138,14 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 			p.User = s.defaultProjectUser
 		}
 
+		// Resources that are not set by the project are limited by the target defaults or maxima
+		p.Resources = p.Resources.WithDefaults(&resourceLimits.Defaults).WithDefaults(&resourceLimits.Maxima)
+
+		err = p.Resources.Validate(&resourceLimits.Maxima)
+		if err != nil {
+			return nil, fmt.Errorf("%w: project %s: %s", ErrInvalidProjectResources, p.Name, err)
+		}
+
 		apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
 		if err != nil {
 			return nil, err
@@ -128,18 +157,26 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		w.Projects = append(w.Projects, p)
 	}
 
-	err = s.workspaceStore.Save(w)
+	unlockQuota := s.quotaService.Lock(getQuotaSubject(w))
+	defer unlockQuota()
+
+	err = s.quotaService.CheckCreateWorkspace(getQuotaSubject(w), w.Projects)
 	if err != nil {
 		return nil, err
 	}
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	err = s.workspaceStore.Save(w)
 	if err != nil {
-		return w, err
+		return nil, err
 	}
 
+	workspaceId := w.Id
+	ownerId := w.OwnerId
+
 	w, err = s.createWorkspace(ctx, w, target)
 
+	s.publishEvent(ownerId, events.NewWorkspaceEvent(events.EventTypeWorkspaceCreated, workspaceId, err))
+
 	if !telemetry.TelemetryEnabled(ctx) {
 		return w, err
 	}
@@ -160,6 +197,38 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	return w, err
 }
 
+// Git provider configs of other users can not be used for projects of a workspace owned by a user.
+// Configs of other users are reported as not found so that their IDs are not revealed.
+func (s *WorkspaceService) checkGitProviderConfigAccess(ownerId, configId string) error {
+	c, err := s.gitProviderService.GetConfig(configId)
+	if err != nil {
+		return err
+	}
+
+	if ownerId != "" && !user.CanAccess(c.OwnerId, ownerId) {
+		return gitprovider.ErrGitProviderConfigNotFound
+	}
+
+	return nil
+}
+
+// Git provider configs of other users are not used for projects of a workspace owned by a user
+func (s *WorkspaceService) listOwnedGitProviderConfigsForUrl(ownerId, url string) ([]*gitprovider.GitProviderConfig, error) {
+	configs, err := s.gitProviderService.ListConfigsForUrl(url)
+	if err != nil || ownerId == "" {
+		return configs, err
+	}
+
+	ownedConfigs := []*gitprovider.GitProviderConfig{}
+	for _, c := range configs {
+		if user.CanAccess(c.OwnerId, ownerId) {
+			ownedConfigs = append(ownedConfigs, c)
+		}
+	}
+
+	return ownedConfigs, nil
+}
+
 func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", p.Name)))
 
@@ -243,7 +312,14 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 			return nil, err
 		}
 
-		err = s.createProject(p, target, projectLogger)
+		// Secret references are resolved only for the provisioner so that secret values are not returned or stored
+		projectToCreate := *p
+		projectToCreate.EnvVars, err = s.secretService.ResolveEnvVars(ws.OwnerId, p.EnvVars)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.createProject(&projectToCreate, target, projectLogger)
 		if err != nil {
 			return nil, err
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 )
 
 type WorkspaceDTO struct {
@@ -21,12 +22,19 @@ type ProjectDTO struct {
 } //	@name	ProjectDTO
 
 type CreateWorkspaceDTO struct {
-	Id       string             `json:"id" validate:"required"`
-	Name     string             `json:"name" validate:"required"`
-	Target   string             `json:"target" validate:"required"`
-	Projects []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
+	Id          string             `json:"id" validate:"required"`
+	Name        string             `json:"name" validate:"required"`
+	Target      string             `json:"target" validate:"required"`
+	IdleTimeout *int               `json:"idleTimeout,omitempty" validate:"optional"`
+	Projects    []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
 } //	@name	CreateWorkspaceDTO
 
+type CloneWorkspaceDTO struct {
+	Id          string `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+	IdleTimeout *int   `json:"idleTimeout,omitempty" validate:"optional"`
+} //	@name	CloneWorkspaceDTO
+
 type CreateProjectDTO struct {
 	Name                string                   `json:"name" validate:"required"`
 	Image               *string                  `json:"image,omitempty" validate:"optional"`
@@ -35,6 +43,7 @@ type CreateProjectDTO struct {
 	Source              CreateProjectSourceDTO   `json:"source" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Resources           *resources.Resources     `json:"resources,omitempty" validate:"optional"`
 } //	@name	CreateProjectDTO
 
 type CreateProjectSourceDTO struct {
@@ -8,12 +8,16 @@ import (
 )
 
 var (
-	ErrWorkspaceAlreadyExists = errors.New("workspace already exists")
-	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
-	ErrWorkspaceNotFound      = errors.New("workspace not found")
-	ErrProjectNotFound        = errors.New("project not found")
-	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
-	ErrInvalidProjectConfig   = errors.New("project config is invalid")
+	ErrWorkspaceAlreadyExists  = errors.New("workspace already exists")
+	ErrInvalidWorkspaceName    = errors.New("name is not a valid alphanumeric string")
+	ErrWorkspaceNotFound       = errors.New("workspace not found")
+	ErrProjectNotFound         = errors.New("project not found")
+	ErrInvalidProjectName      = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidProjectConfig    = errors.New("project config is invalid")
+	ErrInvalidIdleTimeout      = errors.New("idle timeout must not be negative")
+	ErrInvalidProjectResources = errors.New("project resources are invalid")
+	ErrInvalidSnapshotName     = errors.New("snapshot name is not valid. Only [a-zA-Z0-9-_.] are allowed and it must not start with - or .")
+	ErrSnapshotAlreadyExists   = errors.New("snapshot already exists")
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -31,3 +35,19 @@ func IsProjectNotFound(err error) bool {
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsInvalidIdleTimeout(err error) bool {
+	return err.Error() == ErrInvalidIdleTimeout.Error()
+}
+
+func IsInvalidProjectResources(err error) bool {
+	return errors.Is(err, ErrInvalidProjectResources)
+}
+
+func IsInvalidSnapshotName(err error) bool {
+	return err.Error() == ErrInvalidSnapshotName.Error()
+}
+
+func IsSnapshotAlreadyExists(err error) bool {
+	return err.Error() == ErrSnapshotAlreadyExists.Error()
+}
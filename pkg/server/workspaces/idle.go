@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const idleReaperInterval = "0 * * * * *" // every minute
+
+// Project states that have not been reported for longer than this are considered stale
+const staleStateThreshold = time.Minute
+
+// Toolbox requests come in bursts so activity is persisted at most once per interval
+const activityRecordInterval = 30 * time.Second
+
+func (s *WorkspaceService) RecordProjectActivity(workspaceId, projectName string) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	project, err := ws.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if project.State == nil {
+		return nil
+	}
+
+	lastActivityAt, err := time.Parse(time.RFC1123, project.State.LastActivityAt)
+	if err == nil && time.Since(lastActivityAt) < activityRecordInterval {
+		return nil
+	}
+
+	project.State.LastActivityAt = time.Now().Format(time.RFC1123)
+
+	return s.workspaceStore.Save(ws)
+}
+
+func (s *WorkspaceService) StopIdleWorkspaces(ctx context.Context) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		idleTimeout := s.idleTimeout
+		if w.IdleTimeout != nil {
+			idleTimeout = *w.IdleTimeout
+		}
+
+		if idleTimeout <= 0 || !isWorkspaceIdle(w, time.Duration(idleTimeout)*time.Minute) {
+			continue
+		}
+
+		log.Infof("Stopping workspace %s after %d minutes of inactivity", w.Name, idleTimeout)
+
+		err := s.StopWorkspace(ctx, w.Id)
+		if err != nil {
+			log.Errorf("Failed to stop idle workspace %s: %s", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) StartIdleReaper() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(idleReaperInterval, func() {
+		err := s.StopIdleWorkspaces(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+// isWorkspaceIdle returns true if at least one project in the workspace is running
+// and none of the running projects have seen any activity within the idle timeout
+func isWorkspaceIdle(w *workspace.Workspace, idleTimeout time.Duration) bool {
+	running := false
+
+	for _, p := range w.Projects {
+		if p.State == nil || p.State.Uptime == 0 {
+			continue
+		}
+
+		updatedAt, err := time.Parse(time.RFC1123, p.State.UpdatedAt)
+		if err != nil || time.Since(updatedAt) > staleStateThreshold {
+			continue
+		}
+
+		running = true
+
+		lastActivityAt, err := time.Parse(time.RFC1123, p.State.LastActivityAt)
+		if err != nil || time.Since(lastActivityAt) < idleTimeout {
+			return false
+		}
+	}
+
+	return running
+}
+
+// getLastActivityAt determines the last activity of a project based on the newly reported state.
+// Open SSH sessions and changes to the git status count as activity.
+func getLastActivityAt(prevState, state *project.ProjectState) string {
+	now := time.Now().Format(time.RFC1123)
+
+	if prevState == nil || prevState.LastActivityAt == "" || prevState.Uptime == 0 || state.Uptime < prevState.Uptime {
+		return now
+	}
+
+	if state.ActiveSessions > 0 || !reflect.DeepEqual(prevState.GitStatus, state.GitStatus) {
+		return now
+	}
+
+	return prevState.LastActivityAt
+}
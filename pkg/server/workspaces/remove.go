@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/snapshot"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -66,8 +69,13 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 		log.Error(err)
 	}
 
+	// Should not fail the whole operation if the snapshots cannot be removed
+	s.removeSnapshots(workspace, target, nil)
+
 	err = s.workspaceStore.Delete(workspace)
 
+	s.publishEvent(workspace.OwnerId, events.NewWorkspaceEvent(events.EventTypeWorkspaceRemoved, workspace.Id, err))
+
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
 	}
@@ -130,8 +138,12 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 		}
 	}
 
+	s.removeSnapshots(workspace, target, nil)
+
 	err = s.workspaceStore.Delete(workspace)
 
+	s.publishEvent(workspace.OwnerId, events.NewWorkspaceEvent(events.EventTypeWorkspaceRemoved, workspace.Id, err))
+
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
 	}
@@ -151,3 +163,28 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 
 	return err
 }
+
+// removeSnapshots deletes the snapshots of the workspace, or only the ones with the given name, from the provider and from storage.
+// Errors are logged so that removing a workspace never fails because of its snapshots.
+func (s *WorkspaceService) removeSnapshots(ws *workspace.Workspace, target *provider.ProviderTarget, name *string) {
+	snapshots, err := s.snapshotStore.List(&snapshot.Filter{WorkspaceId: &ws.Id, Name: name})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, snap := range snapshots {
+		p, err := ws.GetProject(snap.ProjectName)
+		if err == nil && target != nil {
+			err = s.provisioner.DeleteSnapshot(p, target, snap.Name)
+		}
+		if err != nil {
+			log.Error(err)
+		}
+
+		err = s.snapshotStore.Delete(snap)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/docker/docker/pkg/stringid"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var invalidNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Review workspaces are named after the project config and the pull request number
+// so that the workspace can be found again once the pull request is closed
+func GetReviewWorkspaceName(projectConfigName string, pullRequestNumber uint32) string {
+	return fmt.Sprintf("%s-pr-%d", invalidNameCharacters.ReplaceAllString(projectConfigName, "-"), pullRequestNumber)
+}
+
+// Creates a review workspace for each project config with a matching pull request prebuild
+// when the pull request is opened or updated and removes it once the pull request is closed
+func (s *WorkspaceService) ProcessPullRequestEvent(ctx context.Context, data gitprovider.GitEventData) error {
+	if !data.IsPullRequest() || data.PullRequestNumber == nil {
+		return nil
+	}
+
+	projectConfigs, err := s.projectConfigService.List(&config.ProjectConfigFilter{
+		Url: &data.Url,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, projectConfig := range projectConfigs {
+		if !hasReviewWorkspacePrebuild(projectConfig, data) {
+			continue
+		}
+
+		workspaceName := GetReviewWorkspaceName(projectConfig.Name, *data.PullRequestNumber)
+
+		err = s.processReviewWorkspaceEvent(ctx, projectConfig, workspaceName, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Events of the same pull request are processed one at a time so that e.g. a close event
+// does not race with the creation of the workspace for the open event
+func (s *WorkspaceService) processReviewWorkspaceEvent(ctx context.Context, projectConfig *config.ProjectConfig, workspaceName string, data gitprovider.GitEventData) error {
+	unlock := s.reviewWorkspaceMutex.Lock(workspaceName)
+	defer unlock()
+
+	w, err := s.workspaceStore.Find(workspaceName)
+	if err != nil && !workspace.IsWorkspaceNotFound(err) {
+		return fmt.Errorf("failed to find review workspace: %w", err)
+	}
+
+	if data.Type == gitprovider.GitEventTypePullRequestClosed {
+		if w == nil {
+			return nil
+		}
+
+		log.Infof("Removing review workspace %s", workspaceName)
+
+		return s.RemoveWorkspace(ctx, w.Id)
+	}
+
+	if w != nil {
+		if data.Type != gitprovider.GitEventTypePullRequestSynchronized {
+			return nil
+		}
+
+		// The workspace is recreated so that it contains the new commits of the pull request
+		log.Infof("Recreating review workspace %s", workspaceName)
+
+		err = s.RemoveWorkspace(ctx, w.Id)
+		if err != nil {
+			return fmt.Errorf("failed to remove outdated review workspace: %w", err)
+		}
+	}
+
+	return s.createReviewWorkspace(ctx, projectConfig, workspaceName, data)
+}
+
+func (s *WorkspaceService) createReviewWorkspace(ctx context.Context, projectConfig *config.ProjectConfig, workspaceName string, data gitprovider.GitEventData) error {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Default: util.Pointer(true)})
+	if err != nil {
+		return fmt.Errorf("failed to find the default target: %w", err)
+	}
+
+	gitProvider, _, err := s.gitProviderService.GetGitProviderForUrl(data.Url)
+	if err != nil {
+		return fmt.Errorf("failed to get git provider for URL: %w", err)
+	}
+
+	repo, err := gitProvider.GetRepositoryContext(gitprovider.GetRepositoryContext{
+		Url:      data.Url,
+		PrNumber: data.PullRequestNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository context: %w", err)
+	}
+
+	log.Infof("Creating review workspace %s", workspaceName)
+
+	// Review workspaces are owned by the owner of the project config and count towards the quota of the owner.
+	// They are not created with a client API key so review workspaces of shared project configs are not limited.
+	ctx = context.WithValue(ctx, user.USER_ID_CONTEXT_KEY, projectConfig.OwnerId)
+
+	_, err = s.CreateWorkspace(ctx, dto.CreateWorkspaceDTO{
+		Id:     stringid.TruncateID(stringid.GenerateRandomID()),
+		Name:   workspaceName,
+		Target: target.Name,
+		Projects: []dto.CreateProjectDTO{
+			{
+				Name:        repo.Name,
+				Image:       &projectConfig.Image,
+				User:        &projectConfig.User,
+				BuildConfig: projectConfig.BuildConfig,
+				Source: dto.CreateProjectSourceDTO{
+					Repository: repo,
+				},
+				EnvVars:             projectConfig.EnvVars,
+				GitProviderConfigId: projectConfig.GitProviderConfigId,
+				Resources:           projectConfig.Resources,
+			},
+		},
+	})
+
+	return err
+}
+
+func hasReviewWorkspacePrebuild(projectConfig *config.ProjectConfig, data gitprovider.GitEventData) bool {
+	for _, prebuild := range projectConfig.Prebuilds {
+		if prebuild.ReviewWorkspace && prebuild.MatchesPullRequest(data.BaseBranch, data.FromFork) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,7 +7,11 @@ import (
 	"context"
 	"errors"
 	"io"
+	"reflect"
 
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
@@ -16,7 +20,10 @@ import (
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/snapshot"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -31,10 +38,18 @@ type IWorkspaceService interface {
 	RemoveWorkspace(ctx context.Context, workspaceId string) error
 	ForceRemoveWorkspace(ctx context.Context, workspaceId string) error
 	SetProjectState(workspaceId string, projectName string, state *project.ProjectState) (*workspace.Workspace, error)
+	RecordProjectActivity(workspaceId string, projectName string) error
+	StopIdleWorkspaces(ctx context.Context) error
+	StartIdleReaper() error
 	StartProject(ctx context.Context, workspaceId string, projectName string) error
 	StartWorkspace(ctx context.Context, workspaceId string) error
 	StopProject(ctx context.Context, workspaceId string, projectName string) error
 	StopWorkspace(ctx context.Context, workspaceId string) error
+	CloneWorkspace(ctx context.Context, workspaceId string, req dto.CloneWorkspaceDTO) (*workspace.Workspace, error)
+	SnapshotProject(ctx context.Context, workspaceId, projectName, snapshotName string) (*snapshot.Snapshot, error)
+	RestoreProject(ctx context.Context, workspaceId, projectName, snapshotName string) error
+	ListSnapshots(ctx context.Context, workspaceId, projectName string) ([]*snapshot.Snapshot, error)
+	ProcessPullRequestEvent(ctx context.Context, data gitprovider.GitEventData) error
 }
 
 type targetStore interface {
@@ -44,6 +59,7 @@ type targetStore interface {
 type WorkspaceServiceConfig struct {
 	WorkspaceStore           workspace.Store
 	TargetStore              targetStore
+	SnapshotStore            snapshot.Store
 	ContainerRegistryService containerregistries.IContainerRegistryService
 	BuildService             builds.IBuildService
 	ProjectConfigService     projectconfig.IProjectConfigService
@@ -57,13 +73,18 @@ type WorkspaceServiceConfig struct {
 	ApiKeyService            apikeys.IApiKeyService
 	LoggerFactory            logs.LoggerFactory
 	GitProviderService       gitproviders.IGitProviderService
+	QuotaService             quotas.IQuotaService
+	SecretService            secrets.ISecretService
 	TelemetryService         telemetry.TelemetryService
+	EventBus                 events.IEventBus
+	IdleTimeout              int
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 	return &WorkspaceService{
 		workspaceStore:           config.WorkspaceStore,
 		targetStore:              config.TargetStore,
+		snapshotStore:            config.SnapshotStore,
 		containerRegistryService: config.ContainerRegistryService,
 		buildService:             config.BuildService,
 		projectConfigService:     config.ProjectConfigService,
@@ -76,14 +97,19 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		loggerFactory:            config.LoggerFactory,
 		apiKeyService:            config.ApiKeyService,
 		gitProviderService:       config.GitProviderService,
+		quotaService:             config.QuotaService,
+		secretService:            config.SecretService,
 		telemetryService:         config.TelemetryService,
+		eventBus:                 config.EventBus,
 		builderImage:             config.BuilderImage,
+		idleTimeout:              config.IdleTimeout,
 	}
 }
 
 type WorkspaceService struct {
 	workspaceStore           workspace.Store
 	targetStore              targetStore
+	snapshotStore            snapshot.Store
 	containerRegistryService containerregistries.IContainerRegistryService
 	buildService             builds.IBuildService
 	projectConfigService     projectconfig.IProjectConfigService
@@ -97,7 +123,12 @@ type WorkspaceService struct {
 	builderImage             string
 	loggerFactory            logs.LoggerFactory
 	gitProviderService       gitproviders.IGitProviderService
+	quotaService             quotas.IQuotaService
+	secretService            secrets.ISecretService
 	telemetryService         telemetry.TelemetryService
+	eventBus                 events.IEventBus
+	idleTimeout              int
+	reviewWorkspaceMutex     util.KeyedMutex
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *project.ProjectState) (*workspace.Workspace, error) {
@@ -108,14 +139,50 @@ func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, stat
 
 	for _, project := range ws.Projects {
 		if project.Name == projectName {
+			state.LastActivityAt = getLastActivityAt(project.State, state)
+			changed := projectStateChanged(project.State, state)
 			project.State = state
-			return ws, s.workspaceStore.Save(ws)
+
+			err = s.workspaceStore.Save(ws)
+			if err == nil && changed {
+				s.publishEvent(ws.OwnerId, events.NewProjectEvent(events.EventTypeProjectStateUpdated, ws.Id, projectName, getProjectStatus(state), nil))
+			}
+
+			return ws, err
 		}
 	}
 
 	return nil, errors.New("project not found")
 }
 
+func (s *WorkspaceService) publishEvent(ownerId string, event events.Event) {
+	event.OwnerId = ownerId
+	if s.eventBus != nil {
+		s.eventBus.Publish(event)
+	}
+}
+
+// projectStateChanged ignores the uptime and timestamps that are reported on every agent heartbeat
+func projectStateChanged(previous, current *project.ProjectState) bool {
+	if previous == nil || current == nil {
+		return previous != current
+	}
+
+	if getProjectStatus(previous) != getProjectStatus(current) || previous.ActiveSessions != current.ActiveSessions {
+		return true
+	}
+
+	return !reflect.DeepEqual(previous.GitStatus, current.GitStatus)
+}
+
+func getProjectStatus(state *project.ProjectState) string {
+	if state != nil && state.Uptime > 0 {
+		return "running"
+	}
+
+	return "stopped"
+}
+
 func (s *WorkspaceService) GetWorkspaceLogReader(workspaceId string) (io.Reader, error) {
 	return s.loggerFactory.CreateWorkspaceLogReader(workspaceId)
 }
@@ -9,21 +9,33 @@ import (
 	"testing"
 	"time"
 
+	gitprovider_mocks "github.com/daytonaio/daytona/internal/testing/gitprovider/mocks"
 	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_quotas "github.com/daytonaio/daytona/internal/testing/server/quotas"
+	t_secrets "github.com/daytonaio/daytona/internal/testing/server/secrets"
 	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	t_snapshot "github.com/daytonaio/daytona/internal/testing/snapshot"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/server/quotas"
+	"github.com/daytonaio/daytona/pkg/server/secrets"
+	secrets_dto "github.com/daytonaio/daytona/pkg/server/secrets/dto"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/snapshot"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -106,9 +118,23 @@ func TestWorkspaceService(t *testing.T) {
 	err := targetStore.Save(&target)
 	require.Nil(t, err)
 
+	snapshotStore := t_snapshot.NewInMemorySnapshotStore()
+
 	apiKeyService := mocks.NewMockApiKeyService()
 	gitProviderService := mocks.NewMockGitProviderService()
 	mockProvisioner := mocks.NewMockProvisioner()
+	eventBus := events.NewEventBus()
+
+	quotaStore := t_quotas.NewInMemoryQuotaStore()
+	quotaService := quotas.NewQuotaService(quotas.QuotaServiceConfig{
+		QuotaStore:     quotaStore,
+		WorkspaceStore: workspaceStore,
+		ApiKeyService:  apiKeyService,
+	})
+
+	secretService := secrets.NewSecretService(secrets.SecretServiceConfig{
+		SecretStore: t_secrets.NewInMemorySecretStore(),
+	})
 
 	wsLogsDir := t.TempDir()
 	buildLogsDir := t.TempDir()
@@ -116,6 +142,7 @@ func TestWorkspaceService(t *testing.T) {
 	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              targetStore,
+		SnapshotStore:            snapshotStore,
 		ServerApiUrl:             serverApiUrl,
 		ServerUrl:                serverUrl,
 		ServerVersion:            serverVersion,
@@ -128,8 +155,17 @@ func TestWorkspaceService(t *testing.T) {
 		Provisioner:              mockProvisioner,
 		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
 		GitProviderService:       gitProviderService,
+		QuotaService:             quotaService,
+		SecretService:            secretService,
+		EventBus:                 eventBus,
 	})
 
+	lastEvent := func(t *testing.T) events.Event {
+		recent := eventBus.Recent()
+		require.NotEmpty(t, recent)
+		return recent[len(recent)-1]
+	}
+
 	t.Run("CreateWorkspace", func(t *testing.T) {
 		var containerRegistry *containerregistry.ContainerRegistry
 
@@ -206,6 +242,46 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails with a git provider config of another user", func(t *testing.T) {
+		otherUserConfig := gitProviderConfig
+		otherUserConfig.Id = "other-user-github"
+		otherUserConfig.OwnerId = "other-user"
+		gitProviderService.On("GetConfig", otherUserConfig.Id).Return(&otherUserConfig, nil)
+
+		foreignConfigRequest := createWorkspaceDto
+		foreignConfigRequest.Name = "foreign-config"
+		foreignConfigRequest.Projects = []dto.CreateProjectDTO{createWorkspaceDto.Projects[0]}
+		foreignConfigRequest.Projects[0].GitProviderConfigId = &otherUserConfig.Id
+
+		userCtx := context.WithValue(ctx, user.USER_ID_CONTEXT_KEY, "user")
+
+		_, err := service.CreateWorkspace(userCtx, foreignConfigRequest)
+		require.True(t, gitprovider.IsGitProviderNotFound(err))
+
+		_, err = workspaceStore.Find(foreignConfigRequest.Name)
+		require.NotNil(t, err)
+	})
+
+	t.Run("CreateWorkspace fails when quota is exceeded", func(t *testing.T) {
+		q := &quota.Quota{ApiKeyName: quota.DefaultQuotaName, MaxCpus: 1}
+		err := quotaStore.Save(q)
+		require.Nil(t, err)
+
+		quotaWorkspaceRequest := createWorkspaceDto
+		quotaWorkspaceRequest.Name = "quota"
+
+		quotaCtx := context.WithValue(ctx, apikey.CLIENT_API_KEY_NAME_CONTEXT_KEY, "developer")
+
+		_, err = service.CreateWorkspace(quotaCtx, quotaWorkspaceRequest)
+		require.True(t, quota.IsQuotaExceeded(err))
+
+		_, err = workspaceStore.Find(quotaWorkspaceRequest.Name)
+		require.NotNil(t, err)
+
+		err = quotaStore.Delete(q)
+		require.Nil(t, err)
+	})
+
 	t.Run("GetWorkspace", func(t *testing.T) {
 		mockProvisioner.On("GetWorkspaceInfo", mock.Anything, mock.Anything, &target).Return(&workspaceInfo, nil)
 
@@ -258,6 +334,7 @@ func TestWorkspaceService(t *testing.T) {
 		err := service.StartWorkspace(ctx, createWorkspaceDto.Id)
 
 		require.Nil(t, err)
+		require.Equal(t, events.EventTypeWorkspaceStarted, lastEvent(t).Type)
 	})
 
 	t.Run("StartProject", func(t *testing.T) {
@@ -269,6 +346,26 @@ func TestWorkspaceService(t *testing.T) {
 		require.Nil(t, err)
 	})
 
+	t.Run("StartProject resolves secret references", func(t *testing.T) {
+		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+
+		_, err := secretService.Set(ctx, "TOKEN", secrets_dto.SetSecretDTO{Value: "rotated-token"})
+		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		w.Projects[0].EnvVars["TOKEN"] = "${secret:TOKEN}"
+		defer delete(w.Projects[0].EnvVars, "TOKEN")
+
+		err = service.StartProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
+		require.Nil(t, err)
+
+		calls := mockProvisioner.Calls
+		params := calls[len(calls)-1].Arguments.Get(0).(provisioner.ProjectParams)
+		require.Equal(t, "rotated-token", params.Project.EnvVars["TOKEN"])
+		require.Equal(t, "${secret:TOKEN}", w.Projects[0].EnvVars["TOKEN"])
+	})
+
 	t.Run("StopWorkspace", func(t *testing.T) {
 		mockProvisioner.On("StopWorkspace", mock.Anything, &target).Return(nil)
 		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
@@ -285,6 +382,10 @@ func TestWorkspaceService(t *testing.T) {
 		err := service.StopProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
 
 		require.Nil(t, err)
+
+		event := lastEvent(t)
+		require.Equal(t, events.EventTypeProjectStopped, event.Type)
+		require.Equal(t, createWorkspaceDto.Id+"/"+createWorkspaceDto.Projects[0].Name, event.ResourceId)
 	})
 
 	t.Run("RemoveWorkspace", func(t *testing.T) {
@@ -301,11 +402,19 @@ func TestWorkspaceService(t *testing.T) {
 	})
 
 	t.Run("ForceRemoveWorkspace", func(t *testing.T) {
-		err := workspaceStore.Save(&workspace.Workspace{Id: createWorkspaceDto.Id, Target: target.Name})
+		err := workspaceStore.Save(&workspace.Workspace{
+			Id:       createWorkspaceDto.Id,
+			Target:   target.Name,
+			Projects: []*project.Project{{Name: "project1", WorkspaceId: createWorkspaceDto.Id}},
+		})
+		require.Nil(t, err)
+
+		err = snapshotStore.Save(&snapshot.Snapshot{Name: "snap-0", WorkspaceId: createWorkspaceDto.Id, ProjectName: "project1"})
 		require.Nil(t, err)
 
 		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
 		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("DeleteSnapshot", mock.Anything, &target, "snap-0").Return(nil)
 		apiKeyService.On("Revoke", mock.Anything).Return(nil)
 
 		err = service.ForceRemoveWorkspace(ctx, createWorkspaceDto.Id)
@@ -314,6 +423,126 @@ func TestWorkspaceService(t *testing.T) {
 
 		_, err = service.GetWorkspace(ctx, createWorkspaceDto.Id, true)
 		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
+
+		snapshots, err := snapshotStore.List(&snapshot.Filter{WorkspaceId: &createWorkspaceDto.Id})
+		require.Nil(t, err)
+		require.Empty(t, snapshots)
+	})
+
+	t.Run("ProcessPullRequestEventClosed", func(t *testing.T) {
+		reviewWorkspaceName := workspaces.GetReviewWorkspaceName("review", 7)
+		require.Equal(t, "review-pr-7", reviewWorkspaceName)
+
+		err := workspaceStore.Save(&workspace.Workspace{Id: "review-id", Name: reviewWorkspaceName, Target: target.Name})
+		require.Nil(t, err)
+
+		repositoryUrl := "https://github.com/daytonaio/review.git"
+		projectConfigService.On("List", &config.ProjectConfigFilter{Url: &repositoryUrl}).Return([]*config.ProjectConfig{
+			{
+				Name:          "review",
+				RepositoryUrl: repositoryUrl,
+				Prebuilds: []*config.PrebuildConfig{
+					{Id: "pr", Branch: "main", PullRequests: true, ReviewWorkspace: true},
+				},
+			},
+		}, nil)
+
+		err = service.ProcessPullRequestEvent(ctx, gitprovider.GitEventData{
+			Type:              gitprovider.GitEventTypePullRequestClosed,
+			Url:               repositoryUrl,
+			Branch:            "feature",
+			BaseBranch:        "main",
+			PullRequestNumber: util.Pointer(uint32(7)),
+		})
+		require.Nil(t, err)
+
+		_, err = workspaceStore.Find(reviewWorkspaceName)
+		require.True(t, workspace.IsWorkspaceNotFound(err))
+	})
+
+	t.Run("ProcessPullRequestEventOpenedKeepsExistingWorkspace", func(t *testing.T) {
+		reviewWorkspaceName := workspaces.GetReviewWorkspaceName("review", 8)
+
+		err := workspaceStore.Save(&workspace.Workspace{Id: "review-id-8", Name: reviewWorkspaceName, Target: target.Name})
+		require.Nil(t, err)
+
+		err = service.ProcessPullRequestEvent(ctx, gitprovider.GitEventData{
+			Type:              gitprovider.GitEventTypePullRequestOpened,
+			Url:               "https://github.com/daytonaio/review.git",
+			Branch:            "feature",
+			BaseBranch:        "main",
+			PullRequestNumber: util.Pointer(uint32(8)),
+		})
+		require.Nil(t, err)
+
+		w, err := workspaceStore.Find(reviewWorkspaceName)
+		require.Nil(t, err)
+		require.Equal(t, "review-id-8", w.Id)
+
+		err = workspaceStore.Delete(w)
+		require.Nil(t, err)
+	})
+
+	t.Run("ProcessPullRequestEventSkipsForks", func(t *testing.T) {
+		err := service.ProcessPullRequestEvent(ctx, gitprovider.GitEventData{
+			Type:              gitprovider.GitEventTypePullRequestOpened,
+			Url:               "https://github.com/daytonaio/review.git",
+			Branch:            "main",
+			BaseBranch:        "main",
+			PullRequestNumber: util.Pointer(uint32(10)),
+			FromFork:          true,
+		})
+		require.Nil(t, err)
+
+		_, err = workspaceStore.Find(workspaces.GetReviewWorkspaceName("review", 10))
+		require.True(t, workspace.IsWorkspaceNotFound(err))
+	})
+
+	t.Run("ProcessPullRequestEventAppliesQuotaOfProjectConfigOwner", func(t *testing.T) {
+		err := targetStore.Save(&provider.ProviderTarget{Name: "default-target", ProviderInfo: target.ProviderInfo, IsDefault: true})
+		require.Nil(t, err)
+
+		repositoryUrl := "https://github.com/daytonaio/owned-review.git"
+		projectConfigService.On("List", &config.ProjectConfigFilter{Url: &repositoryUrl}).Return([]*config.ProjectConfig{
+			{
+				Name:          "owned-review",
+				RepositoryUrl: repositoryUrl,
+				OwnerId:       "user1",
+				Prebuilds: []*config.PrebuildConfig{
+					{Id: "pr", Branch: "main", PullRequests: true, ReviewWorkspace: true},
+				},
+			},
+		}, nil)
+
+		mockGitProvider := &gitprovider_mocks.MockGitProvider{}
+		mockGitProvider.On("GetRepositoryContext", mock.Anything).Return(&gitprovider.GitRepository{Name: "owned-review", Url: repositoryUrl, Branch: "feature", Sha: "sha1"}, nil)
+		gitProviderService.On("GetGitProviderForUrl", repositoryUrl).Return(mockGitProvider, "github", nil)
+		gitProviderService.On("ListConfigsForUrl", repositoryUrl).Return([]*gitprovider.GitProviderConfig{}, nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, mock.Anything).Return("review-key", nil).Once()
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, mock.Anything).Return("review-project-key", nil).Once()
+
+		// The owner already has as many workspaces as their quota allows
+		err = workspaceStore.Save(&workspace.Workspace{Id: "owned", Name: "owned", Target: target.Name, OwnerId: "user1"})
+		require.Nil(t, err)
+
+		q := &quota.Quota{UserId: "user1", MaxWorkspaces: 1}
+		err = quotaStore.Save(q)
+		require.Nil(t, err)
+
+		err = service.ProcessPullRequestEvent(context.Background(), gitprovider.GitEventData{
+			Type:              gitprovider.GitEventTypePullRequestOpened,
+			Url:               repositoryUrl,
+			Branch:            "feature",
+			BaseBranch:        "main",
+			PullRequestNumber: util.Pointer(uint32(9)),
+		})
+		require.True(t, quota.IsQuotaExceeded(err))
+
+		_, err = workspaceStore.Find(workspaces.GetReviewWorkspaceName("owned-review", 9))
+		require.True(t, workspace.IsWorkspaceNotFound(err))
+
+		require.Nil(t, quotaStore.Delete(q))
+		require.Nil(t, workspaceStore.Delete(&workspace.Workspace{Id: "owned", Name: "owned"}))
 	})
 
 	t.Run("SetProjectState", func(t *testing.T) {
@@ -331,9 +560,171 @@ func TestWorkspaceService(t *testing.T) {
 		})
 		require.Nil(t, err)
 
-		project, err := res.GetProject(projectName)
+		p, err := res.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, "main", p.State.GitStatus.CurrentBranch)
+
+		event := lastEvent(t)
+		require.Equal(t, events.EventTypeProjectStateUpdated, event.Type)
+		require.Equal(t, "running", event.State)
+
+		// Heartbeats that only update the uptime are not published
+		eventCount := len(eventBus.Recent())
+		_, err = service.SetProjectState(ws.Id, projectName, &project.ProjectState{
+			UpdatedAt: time.Now().Format(time.RFC1123),
+			Uptime:    20,
+			GitStatus: &project.GitStatus{
+				CurrentBranch: "main",
+			},
+		})
+		require.Nil(t, err)
+		require.Len(t, eventBus.Recent(), eventCount)
+	})
+
+	t.Run("SetProjectStateActivity", func(t *testing.T) {
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := w.Projects[0].Name
+		lastActivityAt := time.Now().Add(-time.Hour).Format(time.RFC1123)
+		w.Projects[0].State = &project.ProjectState{
+			UpdatedAt:      time.Now().Format(time.RFC1123),
+			Uptime:         10,
+			LastActivityAt: lastActivityAt,
+		}
+		err = workspaceStore.Save(w)
+		require.Nil(t, err)
+
+		res, err := service.SetProjectState(w.Id, projectName, &project.ProjectState{
+			UpdatedAt: time.Now().Format(time.RFC1123),
+			Uptime:    12,
+		})
+		require.Nil(t, err)
+
+		p, err := res.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, lastActivityAt, p.State.LastActivityAt)
+
+		res, err = service.SetProjectState(w.Id, projectName, &project.ProjectState{
+			UpdatedAt:      time.Now().Format(time.RFC1123),
+			Uptime:         14,
+			ActiveSessions: 1,
+		})
+		require.Nil(t, err)
+
+		p, err = res.GetProject(projectName)
+		require.Nil(t, err)
+		require.NotEqual(t, lastActivityAt, p.State.LastActivityAt)
+	})
+
+	t.Run("StopIdleWorkspaces", func(t *testing.T) {
+		w, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		idleTimeout := 1
+		w.IdleTimeout = &idleTimeout
+		w.Projects[0].State = &project.ProjectState{
+			UpdatedAt:      time.Now().Format(time.RFC1123),
+			Uptime:         120,
+			LastActivityAt: time.Now().Add(-2 * time.Minute).Format(time.RFC1123),
+		}
+		err = workspaceStore.Save(w)
+		require.Nil(t, err)
+
+		err = service.StopIdleWorkspaces(ctx)
+		require.Nil(t, err)
+
+		w, err = workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), w.Projects[0].State.Uptime)
+	})
+
+	t.Run("SnapshotProject", func(t *testing.T) {
+		mockProvisioner.On("SnapshotProject", mock.Anything, &target, "snap-1").Return(nil)
+
+		snap, err := service.SnapshotProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "snap-1")
+		require.Nil(t, err)
+		require.Equal(t, "snap-1", snap.Name)
+		require.Equal(t, createWorkspaceDto.Id, snap.WorkspaceId)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, snap.ProjectName)
+	})
+
+	t.Run("SnapshotProject fails when snapshot already exists", func(t *testing.T) {
+		_, err := service.SnapshotProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "snap-1")
+		require.Equal(t, workspaces.ErrSnapshotAlreadyExists, err)
+	})
+
+	t.Run("SnapshotProject fails name validation", func(t *testing.T) {
+		_, err := service.SnapshotProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "-invalid name")
+		require.Equal(t, workspaces.ErrInvalidSnapshotName, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		err := snapshotStore.Save(&snapshot.Snapshot{
+			Name:        "snap-removed",
+			WorkspaceId: createWorkspaceDto.Id,
+			ProjectName: createWorkspaceDto.Projects[0].Name,
+			CreatedAt:   time.Now(),
+		})
+		require.Nil(t, err)
+
+		mockProvisioner.On("ListSnapshots", mock.Anything, &target).Return([]string{"snap-1"}, nil)
+
+		snapshots, err := service.ListSnapshots(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
 		require.Nil(t, err)
-		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
+		require.Len(t, snapshots, 1)
+		require.Equal(t, "snap-1", snapshots[0].Name)
+	})
+
+	t.Run("RestoreProject", func(t *testing.T) {
+		mockProvisioner.On("RestoreProject", mock.Anything, &target, "snap-1").Return(nil)
+
+		err := service.RestoreProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "snap-1")
+		require.Nil(t, err)
+	})
+
+	t.Run("RestoreProject fails when snapshot not found", func(t *testing.T) {
+		err := service.RestoreProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "missing")
+		require.Equal(t, snapshot.ErrSnapshotNotFound, err)
+	})
+
+	t.Run("CloneWorkspace", func(t *testing.T) {
+		cloneWorkspaceDto := dto.CloneWorkspaceDTO{
+			Id:   "test-clone",
+			Name: "test-clone",
+		}
+		snapshotName := fmt.Sprintf("clone-%s", cloneWorkspaceDto.Id)
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, cloneWorkspaceDto.Id).Return(cloneWorkspaceDto.Id, nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", cloneWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)).Return(createWorkspaceDto.Projects[0].Name, nil)
+
+		mockProvisioner.On("SnapshotProject", mock.Anything, &target, snapshotName).Return(nil)
+		mockProvisioner.On("CreateProject", mock.Anything).Return(nil)
+		mockProvisioner.On("CloneProject", mock.Anything, mock.Anything, &target, snapshotName).Return(nil)
+		mockProvisioner.On("DeleteSnapshot", mock.Anything, &target, snapshotName).Return(nil)
+
+		w, err := service.CloneWorkspace(ctx, createWorkspaceDto.Id, cloneWorkspaceDto)
+		require.Nil(t, err)
+
+		require.Equal(t, cloneWorkspaceDto.Id, w.Id)
+		require.Equal(t, cloneWorkspaceDto.Name, w.Name)
+		require.Equal(t, createWorkspaceDto.Target, w.Target)
+		require.Len(t, w.Projects, 1)
+		require.Equal(t, createWorkspaceDto.Projects[0].Name, w.Projects[0].Name)
+		require.Equal(t, createWorkspaceDto.Projects[0].Source.Repository.Url, w.Projects[0].Repository.Url)
+		require.Equal(t, cloneWorkspaceDto.Id, w.Projects[0].EnvVars["DAYTONA_WS_ID"])
+
+		mockProvisioner.AssertCalled(t, "CloneProject", mock.Anything, mock.Anything, &target, snapshotName)
+		mockProvisioner.AssertCalled(t, "DeleteSnapshot", mock.Anything, &target, snapshotName)
+
+		snapshots, err := snapshotStore.List(&snapshot.Filter{Name: &snapshotName})
+		require.Nil(t, err)
+		require.Empty(t, snapshots)
+	})
+
+	t.Run("CloneWorkspace fails when workspace not found", func(t *testing.T) {
+		_, err := service.CloneWorkspace(ctx, "missing", dto.CloneWorkspaceDTO{Id: "missing-clone", Name: "missing-clone"})
+		require.Equal(t, workspaces.ErrWorkspaceNotFound, err)
 	})
 
 	t.Cleanup(func() {
@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/snapshot"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) SnapshotProject(ctx context.Context, workspaceId, projectName, snapshotName string) (*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if snapshotName == "" {
+		snapshotName = time.Now().Format("20060102-150405")
+	}
+
+	if !snapshot.IsValidSnapshotName(snapshotName) {
+		return nil, ErrInvalidSnapshotName
+	}
+
+	_, err = s.snapshotStore.Find(&snapshot.Filter{
+		WorkspaceId: &w.Id,
+		ProjectName: &project.Name,
+		Name:        &snapshotName,
+	})
+	if err == nil {
+		return nil, ErrSnapshotAlreadyExists
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.provisioner.SnapshotProject(project, target, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &snapshot.Snapshot{
+		Name:        snapshotName,
+		WorkspaceId: w.Id,
+		ProjectName: project.Name,
+		CreatedAt:   time.Now(),
+	}
+
+	return snap, s.snapshotStore.Save(snap)
+}
+
+func (s *WorkspaceService) RestoreProject(ctx context.Context, workspaceId, projectName, snapshotName string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	snap, err := s.snapshotStore.Find(&snapshot.Filter{
+		WorkspaceId: &w.Id,
+		ProjectName: &project.Name,
+		Name:        &snapshotName,
+	})
+	if err != nil {
+		return err
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	err = s.StopProject(ctx, w.Id, project.Name)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Restoring project %s from snapshot %s", project.Name, snap.Name)
+
+	err = s.provisioner.RestoreProject(project, target, snap.Name)
+	if err != nil {
+		return err
+	}
+
+	return s.StartProject(ctx, w.Id, project.Name)
+}
+
+func (s *WorkspaceService) ListSnapshots(ctx context.Context, workspaceId, projectName string) ([]*snapshot.Snapshot, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	snapshots, err := s.snapshotStore.List(&snapshot.Filter{
+		WorkspaceId: &w.Id,
+		ProjectName: &project.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshots removed on the provider side can no longer be restored so they are omitted
+	providerSnapshots, err := s.provisioner.ListSnapshots(project, target)
+	if err != nil {
+		log.Warnf("Failed to list snapshots of project %s from the provider: %s", project.Name, err)
+		return snapshots, nil
+	}
+
+	available := []*snapshot.Snapshot{}
+	for _, snap := range snapshots {
+		if slices.Contains(providerSnapshots, snap.Name) {
+			available = append(available, snap)
+		}
+	}
+
+	return available, nil
+}
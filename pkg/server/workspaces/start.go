@@ -9,10 +9,12 @@ import (
 	"io"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/quota"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -32,6 +34,14 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 		return err
 	}
 
+	unlockQuota := s.quotaService.Lock(getQuotaSubject(w))
+	defer unlockQuota()
+
+	err = s.checkStartQuota(w, w.Projects...)
+	if err != nil {
+		return err
+	}
+
 	workspaceLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
 	defer workspaceLogger.Close()
 
@@ -39,6 +49,8 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 
 	err = s.startWorkspace(ctx, w, target, wsLogWriter)
 
+	s.publishEvent(w.OwnerId, events.NewWorkspaceEvent(events.EventTypeWorkspaceStarted, w.Id, err))
+
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
 	}
@@ -75,10 +87,43 @@ func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projec
 		return err
 	}
 
+	unlockQuota := s.quotaService.Lock(getQuotaSubject(w))
+	defer unlockQuota()
+
+	err = s.checkStartQuota(w, project)
+	if err != nil {
+		return err
+	}
+
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
-	return s.startProject(ctx, project, target, projectLogger)
+	err = s.startProject(ctx, w.OwnerId, project, target, projectLogger)
+
+	s.publishEvent(w.OwnerId, events.NewProjectEvent(events.EventTypeProjectStarted, w.Id, project.Name, "running", err))
+
+	return err
+}
+
+// Checks the quota of the owner of the workspace before starting the projects that are not running
+func (s *WorkspaceService) checkStartQuota(w *workspace.Workspace, projects ...*project.Project) error {
+	stoppedProjects := []*project.Project{}
+	for _, p := range projects {
+		if p.State == nil || p.State.Uptime == 0 {
+			stoppedProjects = append(stoppedProjects, p)
+		}
+	}
+
+	if len(stoppedProjects) == 0 {
+		return nil
+	}
+
+	return s.quotaService.CheckStartProjects(getQuotaSubject(w), stoppedProjects)
+}
+
+// Workspaces count towards the quota of their owner or of the API key that created them if it has no user
+func getQuotaSubject(w *workspace.Workspace) quota.Subject {
+	return quota.Subject{ApiKeyName: w.CreatedBy, UserId: w.OwnerId}
 }
 
 func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
@@ -100,7 +145,7 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		err = s.startProject(ctx, project, target, projectLogger)
+		err = s.startProject(ctx, ws.OwnerId, project, target, projectLogger)
 		if err != nil {
 			return err
 		}
@@ -111,16 +156,31 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 	return nil
 }
 
-func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+func (s *WorkspaceService) startProject(ctx context.Context, ownerId string, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", p.Name)))
 
-	projectToStart := *p
-	projectToStart.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+	envVars := map[string]string{}
+	for k, v := range p.EnvVars {
+		envVars[k] = v
+	}
+
+	for k, v := range project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
 		ServerUrl:     s.serverUrl,
 		ServerVersion: s.serverVersion,
 		ClientId:      telemetry.ClientId(ctx),
-	}, telemetry.TelemetryEnabled(ctx))
+	}, telemetry.TelemetryEnabled(ctx)) {
+		envVars[k] = v
+	}
+
+	// Secret references are resolved on every start so that projects get the current secret values
+	resolvedEnvVars, err := s.secretService.ResolveEnvVars(ownerId, envVars)
+	if err != nil {
+		return err
+	}
+
+	projectToStart := *p
+	projectToStart.EnvVars = resolvedEnvVars
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
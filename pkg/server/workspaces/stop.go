@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/events"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	log "github.com/sirupsen/logrus"
@@ -40,6 +41,8 @@ func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string
 		err = s.workspaceStore.Save(workspace)
 	}
 
+	s.publishEvent(workspace.OwnerId, events.NewWorkspaceEvent(events.EventTypeWorkspaceStopped, workspace.Id, err))
+
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
 	}
@@ -77,14 +80,16 @@ func (s *WorkspaceService) StopProject(ctx context.Context, workspaceId, project
 	}
 
 	err = s.provisioner.StopProject(project, target)
-	if err != nil {
-		return err
-	}
+	if err == nil {
+		if project.State != nil {
+			project.State.Uptime = 0
+			project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+		}
 
-	if project.State != nil {
-		project.State.Uptime = 0
-		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+		err = s.workspaceStore.Save(w)
 	}
 
-	return s.workspaceStore.Save(w)
+	s.publishEvent(w.OwnerId, events.NewProjectEvent(events.EventTypeProjectStopped, w.Id, project.Name, "stopped", err))
+
+	return err
 }
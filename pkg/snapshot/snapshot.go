@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"regexp"
+	"time"
+)
+
+// Snapshot represents a point-in-time copy of a project's environment
+type Snapshot struct {
+	Name        string    `json:"name" validate:"required"`
+	WorkspaceId string    `json:"workspaceId" validate:"required"`
+	ProjectName string    `json:"projectName" validate:"required"`
+	CreatedAt   time.Time `json:"createdAt" validate:"required"`
+} // @name Snapshot
+
+// Snapshot names are used as image tags by some providers so they follow the same rules
+var validSnapshotName = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+
+func IsValidSnapshotName(name string) bool {
+	return validSnapshotName.MatchString(name)
+}
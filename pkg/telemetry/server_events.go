@@ -60,6 +60,10 @@ func NewWorkspaceEventProps(ctx context.Context, workspace *workspace.Workspace,
 				builders["none"]++
 			} else if project.BuildConfig.Devcontainer != nil {
 				builders["devcontainer"]++
+			} else if project.BuildConfig.Dockerfile != nil {
+				builders["dockerfile"]++
+			} else if project.BuildConfig.Nix != nil {
+				builders["nix"]++
 			} else {
 				builders["automatic"]++
 			}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import "context"
+
+type UserContextKey string
+
+var USER_ID_CONTEXT_KEY UserContextKey = "user-id"
+
+// Returns the ID of the user that owns the client API key of the request or an empty string
+// if the API key is not tied to a user
+func UserId(ctx context.Context) string {
+	id, ok := ctx.Value(USER_ID_CONTEXT_KEY).(string)
+	if !ok {
+		return ""
+	}
+
+	return id
+}
@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import "errors"
+
+type Store interface {
+	List() ([]*User, error)
+	Find(idOrName string) (*User, error)
+	FindByIdentity(issuer, subject string) (*User, error)
+	Save(user *User) error
+	Delete(user *User) error
+}
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
+
+func IsUserNotFound(err error) bool {
+	return err.Error() == ErrUserNotFound.Error()
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"time"
+)
+
+type User struct {
+	Id   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+	// Issuer and subject of the OIDC identity of users that log in with OIDC
+	Issuer    string    `json:"issuer,omitempty" validate:"optional"`
+	Subject   string    `json:"subject,omitempty" validate:"optional"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name User
+
+// CanAccess returns true if the user can access a resource with the given owner.
+// Resources without an owner were created before users were introduced or by the server itself and are shared by all users.
+func CanAccess(ownerId, userId string) bool {
+	return ownerId == "" || ownerId == userId
+}
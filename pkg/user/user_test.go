@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanAccess(t *testing.T) {
+	require.True(t, CanAccess("", ""))
+	require.True(t, CanAccess("", "alice"))
+	require.True(t, CanAccess("alice", "alice"))
+	require.False(t, CanAccess("alice", "bob"))
+	require.False(t, CanAccess("alice", ""))
+}
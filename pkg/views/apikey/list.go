@@ -5,15 +5,22 @@ package apikey
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
-	"github.com/daytonaio/daytona/pkg/views/util"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
 )
 
 type RowData struct {
-	Name string
-	Type string
+	Name     string
+	Type     string
+	Role     string
+	Scope    string
+	Expires  string
+	LastUsed string
 }
 
 func ListApiKeys(apiKeyList []apiclient.ApiKey) {
@@ -23,8 +30,8 @@ func ListApiKeys(apiKeyList []apiclient.ApiKey) {
 		data = append(data, getRowFromRowData(apiKey))
 	}
 
-	table := util.GetTableView(data, []string{
-		"Name", "Type",
+	table := views_util.GetTableView(data, []string{
+		"Name", "Type", "Role", "Scope", "Expires", "Last Used",
 	}, nil, func() {
 		renderUnstyledList(apiKeyList)
 	})
@@ -33,14 +40,22 @@ func ListApiKeys(apiKeyList []apiclient.ApiKey) {
 }
 
 func getRowFromRowData(apiKey apiclient.ApiKey) []string {
-	rowData := RowData{"", ""}
+	rowData := RowData{"", "", "", "", "", ""}
 
 	rowData.Name = apiKey.Name
 	rowData.Type = string(apiKey.Type)
+	rowData.Role = string(apiKey.GetRole())
+	rowData.Scope = getScope(apiKey.Scope)
+	rowData.Expires = getExpiry(apiKey.ExpiresAt)
+	rowData.LastUsed = getLastUsed(apiKey.LastUsedAt)
 
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Type),
+		views.DefaultRowDataStyle.Render(rowData.Role),
+		views.DefaultRowDataStyle.Render(rowData.Scope),
+		views.DefaultRowDataStyle.Render(rowData.Expires),
+		views.DefaultRowDataStyle.Render(rowData.LastUsed),
 	}
 
 	return row
@@ -54,6 +69,14 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Type: "), apiKey.Type) + "\n\n"
 
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Role: "), apiKey.GetRole()) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Scope: "), getScope(apiKey.Scope)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Expires: "), getExpiry(apiKey.ExpiresAt)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Last Used: "), getLastUsed(apiKey.LastUsedAt)) + "\n\n"
+
 		if apiKey.Name != apiKeyList[len(apiKeyList)-1].Name {
 			output += views.SeparatorString + "\n\n"
 		}
@@ -61,3 +84,48 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 	fmt.Println(output)
 }
+
+func getScope(scope *apiclient.ApiKeyScope) string {
+	if scope == nil {
+		return "/"
+	}
+
+	scopes := []string{}
+	for _, workspaceId := range scope.WorkspaceIds {
+		scopes = append(scopes, "workspace:"+workspaceId)
+	}
+	for _, projectConfigName := range scope.ProjectConfigNames {
+		scopes = append(scopes, "project-config:"+projectConfigName)
+	}
+
+	if len(scopes) == 0 {
+		return "/"
+	}
+
+	return strings.Join(scopes, ", ")
+}
+
+func getExpiry(expiresAt *string) string {
+	if expiresAt == nil {
+		return "Never"
+	}
+
+	t, err := time.Parse(time.RFC3339, *expiresAt)
+	if err != nil {
+		return "/"
+	}
+
+	if !t.After(time.Now()) {
+		return "Expired"
+	}
+
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func getLastUsed(lastUsedAt *string) string {
+	if lastUsedAt == nil {
+		return "Never"
+	}
+
+	return util.FormatTimestamp(*lastUsedAt)
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type rowData struct {
+	Time     string
+	ApiKey   string
+	Method   string
+	Route    string
+	Resource string
+	Outcome  string
+}
+
+func ListAuditLogs(auditLogs []apiclient.AuditLog) {
+	if len(auditLogs) == 0 {
+		views_util.NotifyEmptyAuditLogList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, a := range auditLogs {
+		data = append(data, getRowFromData(a))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Time", "API Key", "Method", "Route", "Resource", "Outcome",
+	}, nil, func() {
+		renderUnstyledList(auditLogs)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowFromData(a apiclient.AuditLog) []string {
+	var data rowData
+
+	data.Time = util.FormatTimestamp(a.CreatedAt)
+	data.ApiKey = getApiKey(a)
+	data.Method = a.Method
+	data.Route = getRoute(a)
+	data.Resource = a.ResourceId
+	data.Outcome = getOutcome(a)
+
+	return []string{
+		views.DefaultRowDataStyle.Render(data.Time),
+		views.NameStyle.Render(data.ApiKey),
+		views.DefaultRowDataStyle.Render(data.Method),
+		views.DefaultRowDataStyle.Render(data.Route),
+		views.DefaultRowDataStyle.Render(data.Resource),
+		views.DefaultRowDataStyle.Render(data.Outcome),
+	}
+}
+
+func renderUnstyledList(auditLogs []apiclient.AuditLog) {
+	output := "\n"
+
+	for i, a := range auditLogs {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Time: "), a.CreatedAt) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key: "), getApiKey(a)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s %s", views.GetPropertyKey("Request: "), a.Method, a.Path) + "\n\n"
+
+		if a.ResourceId != "" {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Resource: "), a.ResourceId) + "\n\n"
+		}
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Outcome: "), getOutcome(a)) + "\n\n"
+
+		if a.Error != nil && *a.Error != "" {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Error: "), *a.Error) + "\n\n"
+		}
+
+		if i < len(auditLogs)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getApiKey(a apiclient.AuditLog) string {
+	if a.ApiKeyName == "" {
+		return "/"
+	}
+
+	return fmt.Sprintf("%s (%s)", a.ApiKeyName, a.ApiKeyType)
+}
+
+func getRoute(a apiclient.AuditLog) string {
+	if a.Route == "" {
+		return a.Path
+	}
+
+	return a.Route
+}
+
+func getOutcome(a apiclient.AuditLog) string {
+	return fmt.Sprintf("%s (%d)", a.Outcome, a.StatusCode)
+}
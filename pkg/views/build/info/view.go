@@ -35,6 +35,14 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 
 	output += getInfoLine("State", string(b.State)) + "\n"
 
+	if b.QueuePosition != nil {
+		output += getInfoLine("Queue position", fmt.Sprintf("%d", *b.QueuePosition)) + "\n"
+	}
+
+	if b.Priority != nil {
+		output += getInfoLine("Priority", string(*b.Priority)) + "\n"
+	}
+
 	output += getInfoLine("Repository", b.Repository.Url) + "\n"
 
 	if b.Image != nil {
@@ -45,6 +53,27 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 		output += getInfoLine("User", *b.User) + "\n"
 	}
 
+	if b.LayerCache != nil {
+		output += getInfoLine("Layer cache", fmt.Sprintf("%d/%d steps cached", b.LayerCache.CachedSteps, b.LayerCache.TotalSteps)) + "\n"
+	}
+
+	if b.Artifact != nil {
+		output += getInfoLine("Image digest", b.Artifact.ImageDigest) + "\n"
+		output += getInfoLine("Compressed size", util.FormatSize(b.Artifact.CompressedSize)) + "\n"
+
+		if b.Artifact.BaseImage != nil && *b.Artifact.BaseImage != "" {
+			output += getInfoLine("Base image", *b.Artifact.BaseImage) + "\n"
+		}
+
+		if b.Artifact.CommitSha != "" {
+			output += getInfoLine("Commit", b.Artifact.CommitSha) + "\n"
+		}
+
+		if b.Artifact.Sbom != nil {
+			output += getInfoLine("SBOM", fmt.Sprintf("%s (%d packages)", b.Artifact.Sbom.Format, b.Artifact.Sbom.Packages)) + "\n"
+		}
+	}
+
 	if projectconfig_info.GetLabelFromBuild(b.BuildConfig) != "" {
 		projectDefaults := &views_util.ProjectConfigDefaults{
 			Image:     &apiServerConfig.DefaultProjectImage,
@@ -61,6 +90,18 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 		output += getInfoLine("Devcontainer path", b.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if b.BuildConfig != nil && b.BuildConfig.Dockerfile != nil {
+		output += getInfoLine("Dockerfile path", b.BuildConfig.Dockerfile.Path) + "\n"
+	}
+
+	if b.BuildConfig != nil && b.BuildConfig.Nix != nil {
+		output += getInfoLine("Nix file", b.BuildConfig.Nix.FilePath) + "\n"
+	}
+
+	if b.ProjectConfigName != nil && *b.ProjectConfigName != "" {
+		output += getInfoLine("Project config", *b.ProjectConfigName) + "\n"
+	}
+
 	output += getInfoLine("Prebuild ID", b.PrebuildId) + "\n"
 
 	output += getInfoLine("Created", util.FormatTimestamp(b.CreatedAt)) + "\n"
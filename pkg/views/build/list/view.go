@@ -17,6 +17,7 @@ import (
 type rowData struct {
 	Id         string
 	State      string
+	Queue      string
 	PrebuildId string
 	CreatedAt  string
 	UpdatedAt  string
@@ -37,7 +38,7 @@ func ListBuilds(buildList []apiclient.Build, apiServerConfig *apiclient.ServerCo
 	}
 
 	table := views_util.GetTableView(data, []string{
-		"ID", "State", "Prebuild ID", "Created", "Updated",
+		"ID", "State", "Queue", "Prebuild ID", "Created", "Updated",
 	}, nil, func() {
 		renderUnstyledList(buildList, apiServerConfig)
 	})
@@ -68,6 +69,10 @@ func getRowFromRowData(build apiclient.Build) []string {
 
 	data.Id = build.Id + views_util.AdditionalPropertyPadding
 	data.State = string(build.State)
+	data.Queue = "/"
+	if build.QueuePosition != nil {
+		data.Queue = fmt.Sprintf("#%d", *build.QueuePosition)
+	}
 	data.PrebuildId = build.PrebuildId
 	if data.PrebuildId == "" {
 		data.PrebuildId = "/"
@@ -78,6 +83,7 @@ func getRowFromRowData(build apiclient.Build) []string {
 	return []string{
 		views.NameStyle.Render(data.Id),
 		views.DefaultRowDataStyle.Render(data.State),
+		views.DefaultRowDataStyle.Render(data.Queue),
 		views.DefaultRowDataStyle.Render(data.PrebuildId),
 		views.DefaultRowDataStyle.Render(data.CreatedAt),
 		views.DefaultRowDataStyle.Render(data.UpdatedAt),
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package prune
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func Render(result *apiclient.BuildPruneResult) {
+	if len(result.Builds) == 0 {
+		views.RenderInfoMessageBold("No builds exceed the retention of their prebuild")
+		return
+	}
+
+	data := [][]string{}
+	for _, b := range result.Builds {
+		data = append(data, getRow(b))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Project Config", "Prebuild ID", "Size",
+	}, nil, func() {
+		renderUnstyledList(result.Builds)
+	})
+
+	fmt.Println(table)
+
+	reclaimedSpace := util.FormatSize(result.ReclaimedSpace)
+	if result.DryRun {
+		views.RenderInfoMessage(fmt.Sprintf("%d builds would be pruned, reclaiming %s in the builder registry", len(result.Builds), reclaimedSpace))
+		return
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("%d builds have been marked for deletion, reclaiming %s in the builder registry", len(result.Builds), reclaimedSpace))
+}
+
+func renderUnstyledList(builds []apiclient.PrunedBuild) {
+	for _, b := range builds {
+		fmt.Printf("%s\t%s\t%s\t%s\n", b.Id, b.ProjectConfigName, b.PrebuildId, getSizeLabel(b.Size))
+	}
+}
+
+func getRow(b apiclient.PrunedBuild) []string {
+	return []string{
+		views.NameStyle.Render(b.Id + views_util.AdditionalPropertyPadding),
+		views.DefaultRowDataStyle.Render(b.ProjectConfigName),
+		views.DefaultRowDataStyle.Render(b.PrebuildId),
+		views.DefaultRowDataStyle.Render(getSizeLabel(b.Size)),
+	}
+}
+
+// Builds published before image sizes were recorded have no size
+func getSizeLabel(size int64) string {
+	if size == 0 {
+		return "/"
+	}
+
+	return util.FormatSize(size)
+}
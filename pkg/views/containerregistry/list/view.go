@@ -5,7 +5,6 @@ package list
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -44,11 +43,12 @@ func getRowFromData(registry apiclient.ContainerRegistry) []string {
 
 	data.Server = registry.Server
 	data.Username = registry.Username
+	data.Password = views_util.MaskSecret(registry.Password)
 
 	row := []string{
 		views.NameStyle.Render(data.Server),
 		views.DefaultRowDataStyle.Render(data.Username),
-		views.DefaultRowDataStyle.Render(strings.Repeat("*", 10)),
+		views.DefaultRowDataStyle.Render(data.Password),
 	}
 
 	return row
@@ -62,7 +62,7 @@ func renderUnstyledList(registryList []apiclient.ContainerRegistry) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Username: "), registry.Username) + "\n\n"
 
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Password: "), registry.Password) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Password: "), views_util.MaskSecret(registry.Password)) + "\n\n"
 
 		if registry.Server != registryList[len(registryList)-1].Server {
 			output += views.SeparatorString + "\n\n"
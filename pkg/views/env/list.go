@@ -50,7 +50,7 @@ func List(envVars map[string]string) {
 		var rowData *RowData
 		var row []string
 
-		rowData = getRowData(k, v)
+		rowData = getRowData(k, views_util.MaskSecret(v))
 		if rowData == nil {
 			continue
 		}
@@ -91,7 +91,7 @@ func renderUnstyledList(envVars map[string]string) {
 
 	for k, v := range envVars {
 		output += fmt.Sprintf("%s\t%s", views.GetPropertyKey("Key:"), k) + "\n"
-		output += fmt.Sprintf("%s\t%s", views.GetPropertyKey("Value:"), v) + "\n"
+		output += fmt.Sprintf("%s\t%s", views.GetPropertyKey("Value:"), views_util.MaskSecret(v)) + "\n"
 
 		output += "\n\n"
 	}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderEvent(event events.Event) {
+	eventType := views.ActiveStyle.Render(string(event.Type))
+	if event.Error != "" {
+		eventType = views.InactiveStyle.Render(string(event.Type))
+	}
+
+	output := fmt.Sprintf("%s %s %s", views.DefaultRowDataStyle.Render(event.Timestamp.Local().Format(time.DateTime)), eventType, views.NameStyle.Render(event.ResourceId))
+
+	if event.State != "" {
+		output += " " + views.DefaultRowDataStyle.Render(event.State)
+	}
+
+	if event.Error != "" {
+		output += " " + views.InactiveStyle.Render(event.Error)
+	}
+
+	fmt.Println(output)
+}
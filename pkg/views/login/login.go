@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package login
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderDeviceLogin(deviceLogin *apiclient.DeviceLoginDTO) {
+	output := fmt.Sprintf("%s %s", views.GetPropertyKey("Open:"), deviceLogin.VerificationUri) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Enter the code:"), deviceLogin.UserCode) + "\n\n"
+	output += "Waiting for the login to complete..."
+
+	views.RenderBorderedMessage(output)
+}
+
+func RenderLogin(login *apiclient.LoginDTO, profileName string) {
+	views.RenderInfoMessageBold(fmt.Sprintf("Logged in to profile %s as %s with role %s", profileName, login.User, login.Role))
+
+	if login.RefreshToken != nil {
+		views.RenderTip("The API key is refreshed automatically before it expires")
+	} else {
+		views.RenderTip(fmt.Sprintf("The API key expires at %s, run 'daytona login' again afterwards", util.FormatTimestamp(login.ExpiresAt)))
+	}
+}
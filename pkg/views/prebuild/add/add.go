@@ -24,6 +24,9 @@ type PrebuildAddView struct {
 	CommitInterval    string
 	TriggerFiles      []string
 	Retention         string
+	PullRequests      bool
+	ReviewWorkspace   bool
+	ForkPullRequests  bool
 	RunBuildOnAdd     bool
 }
 
@@ -34,7 +34,19 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 
 	output += getInfoLine("Project config", prebuild.ProjectConfigName) + "\n"
 
-	output += getInfoLine("Branch", views.GetBranchNameLabel(prebuild.Branch)) + "\n"
+	if prebuild.GetPullRequests() {
+		output += getInfoLine("Pull requests", GetPullRequestTargetLabel(prebuild.Branch)) + "\n"
+
+		if prebuild.GetReviewWorkspace() {
+			output += getInfoLine("Review workspace", "Created for each pull request") + "\n"
+		}
+
+		if prebuild.GetForkPullRequests() {
+			output += getInfoLine("Forks", "Pull requests from forks are included") + "\n"
+		}
+	} else {
+		output += getInfoLine("Branch", views.GetBranchNameLabel(prebuild.Branch)) + "\n"
+	}
 
 	if prebuild.CommitInterval != nil {
 		output += getInfoLine("Commit interval", fmt.Sprint(*prebuild.CommitInterval)) + "\n"
@@ -42,6 +54,10 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 
 	output += getInfoLine("Build retention", fmt.Sprint(prebuild.Retention)) + "\n"
 
+	if prebuild.BuildTimeout != nil {
+		output += getInfoLine("Build timeout", getBuildTimeoutLabel(*prebuild.BuildTimeout)) + "\n"
+	}
+
 	triggerFileCount := len(prebuild.TriggerFiles)
 
 	if triggerFileCount > 0 {
@@ -68,6 +84,20 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 	renderTUIView(output, views.GetContainerBreakpointWidth(terminalWidth))
 }
 
+func GetPullRequestTargetLabel(baseBranch string) string {
+	if baseBranch == "" {
+		return "All pull requests"
+	}
+	return fmt.Sprintf("Pull requests into %s", baseBranch)
+}
+
+func getBuildTimeoutLabel(minutes int32) string {
+	if minutes == 0 {
+		return "Disabled"
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
+
 func renderUnstyledInfo(output string) {
 	fmt.Println(output)
 }
@@ -59,7 +59,10 @@ func getRowFromData(prebuildConfig apiclient.PrebuildDTO) []string {
 	var data rowData
 
 	data.ProjectConfigName = prebuildConfig.ProjectConfigName + views_util.AdditionalPropertyPadding
-	data.Branch = prebuildConfig.Branch
+	data.Branch = views.GetBranchNameLabel(prebuildConfig.Branch)
+	if prebuildConfig.GetPullRequests() {
+		data.Branch = info.GetPullRequestTargetLabel(prebuildConfig.Branch)
+	}
 	if prebuildConfig.CommitInterval != nil {
 		data.CommitInterval = strconv.Itoa(int(*prebuildConfig.CommitInterval))
 	} else {
@@ -70,7 +73,7 @@ func getRowFromData(prebuildConfig apiclient.PrebuildDTO) []string {
 
 	return []string{
 		views.NameStyle.Render(data.ProjectConfigName),
-		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(data.Branch)),
+		views.DefaultRowDataStyle.Render(data.Branch),
 		views.ActiveStyle.Render(data.CommitInterval),
 		views.DefaultRowDataStyle.Render(data.TriggerFiles),
 		views.DefaultRowDataStyle.Render(data.Retention),
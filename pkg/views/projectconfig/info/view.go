@@ -73,6 +73,18 @@ func Render(projectConfig *apiclient.ProjectConfig, apiServerConfig *apiclient.S
 		output += getInfoLine("Devcontainer path", projectConfig.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Dockerfile != nil {
+		output += getDockerfileInfoLines(projectConfig.BuildConfig.Dockerfile)
+	}
+
+	if projectConfig.BuildConfig != nil && projectConfig.BuildConfig.Nix != nil {
+		output += getInfoLine("Nix file", projectConfig.BuildConfig.Nix.FilePath) + "\n"
+	}
+
+	if resources := views_util.GetResourcesLabel(projectConfig.Resources); resources != "" {
+		output += getInfoLine("Resources", resources) + "\n"
+	}
+
 	prebuildCount := len(projectConfig.Prebuilds)
 
 	if prebuildCount > 0 {
@@ -146,5 +158,27 @@ func GetLabelFromBuild(build *apiclient.BuildConfig) string {
 		return fmt.Sprintf("Devcontainer (%s)", build.Devcontainer.FilePath)
 	}
 
+	if build.Dockerfile != nil {
+		return fmt.Sprintf("Dockerfile (%s)", build.Dockerfile.Path)
+	}
+
+	if build.Nix != nil {
+		return fmt.Sprintf("Nix (%s)", build.Nix.FilePath)
+	}
+
 	return ""
 }
+
+func getDockerfileInfoLines(dockerfile *apiclient.DockerfileConfig) string {
+	output := getInfoLine("Dockerfile path", dockerfile.Path) + "\n"
+
+	if dockerfile.GetContext() != "" {
+		output += getInfoLine("Build context", dockerfile.GetContext()) + "\n"
+	}
+
+	if dockerfile.GetTarget() != "" {
+		output += getInfoLine("Build target", dockerfile.GetTarget()) + "\n"
+	}
+
+	return output
+}
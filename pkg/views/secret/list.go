@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/secret"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListSecrets(secretList []apiclient.Secret) {
+	if len(secretList) == 0 {
+		views_util.NotifyEmptySecretList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range secretList {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Name),
+			views.DefaultRowDataStyle.Render(secret.Reference(s.Name)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(s.UpdatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Reference", "Updated",
+	}, nil, func() {
+		renderUnstyledList(secretList)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledList(secretList []apiclient.Secret) {
+	output := "\n"
+
+	for i, s := range secretList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), s.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Reference: "), secret.Reference(s.Name)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Updated: "), util.FormatTimestamp(s.UpdatedAt)) + "\n\n"
+
+		if i < len(secretList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secret
+
+import (
+	"errors"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func SecretValueInput(name string, value *string) error {
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Value of " + name).
+				EchoMode(huh.EchoModePassword).
+				Value(value).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("value can not be blank")
+					}
+					return nil
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return form.Run()
+}
@@ -5,6 +5,7 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/internal/util"
@@ -27,6 +28,16 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default Project User: "), config.DefaultProjectUser) + "\n\n"
 
+	if config.IdleTimeout > 0 {
+		output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("Idle Timeout: "), config.IdleTimeout) + "\n\n"
+	} else {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Idle Timeout: "), "disabled") + "\n\n"
+	}
+
+	if config.Database != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Database Driver: "), config.Database.Driver) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("FRPS Domain: "), config.Frps.Domain) + "\n\n"
 
 	output += fmt.Sprintf("%s %d", views.GetPropertyKey("FRPS Port: "), config.Frps.Port) + "\n\n"
@@ -61,6 +72,16 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Image Namespace: "), config.BuildImageNamespace) + "\n\n"
 
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Max Concurrent Builds: "), formatBuildLimit(config.MaxConcurrentBuilds)) + "\n\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Max Concurrent Builds Per Project Config: "), formatBuildLimit(config.MaxProjectConfigBuilds)) + "\n\n"
+
+	if config.BuildTimeout > 0 {
+		output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("Build Timeout: "), config.BuildTimeout) + "\n\n"
+	} else {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Timeout: "), "disabled") + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Providers Dir: "), config.ProvidersDir) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Registry URL: "), config.RegistryUrl) + "\n\n"
@@ -82,3 +103,10 @@ func RenderConfig(config *server.Config) {
 
 	views.RenderContainerLayout(views.GetInfoMessage(output))
 }
+
+func formatBuildLimit(limit int) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return strconv.Itoa(limit)
+}
@@ -57,6 +57,10 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	logFileMaxSize := strconv.Itoa(int(m.config.LogFile.MaxSize))
 	logFileMaxBackups := strconv.Itoa(int(m.config.LogFile.MaxBackups))
 	logFileMaxAge := strconv.Itoa(int(m.config.LogFile.MaxAge))
+	idleTimeout := strconv.Itoa(int(m.config.GetIdleTimeout()))
+	maxConcurrentBuilds := strconv.Itoa(int(m.config.GetMaxConcurrentBuilds()))
+	maxProjectConfigBuilds := strconv.Itoa(int(m.config.GetMaxProjectConfigBuilds()))
+	buildTimeout := strconv.Itoa(int(m.config.GetBuildTimeout()))
 
 	return huh.NewForm(
 		huh.NewGroup(
@@ -82,6 +86,18 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 			huh.NewInput().
 				Title("Default Project User").
 				Value(&m.config.DefaultProjectUser),
+			huh.NewInput().
+				Title("Idle Timeout").
+				Description("Minutes of inactivity after which workspaces are stopped. Set to 0 to disable").
+				Value(&idleTimeout).
+				Validate(func(string) error {
+					value, err := strconv.Atoi(idleTimeout)
+					if err != nil || value < 0 {
+						return errors.New("idle timeout must be a non-negative integer")
+					}
+					m.config.SetIdleTimeout(int32(value))
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
@@ -99,6 +115,42 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 				Title("Build Image Namespace").
 				Description("Namespace to be used when tagging and pushing build images").
 				Value(m.config.BuildImageNamespace),
+			huh.NewInput().
+				Title("Max Concurrent Builds").
+				Description("Builds over the limit wait in the queue. Set to 0 to disable").
+				Value(&maxConcurrentBuilds).
+				Validate(func(string) error {
+					value, err := strconv.Atoi(maxConcurrentBuilds)
+					if err != nil || value < 0 {
+						return errors.New("max concurrent builds must be a non-negative integer")
+					}
+					m.config.SetMaxConcurrentBuilds(int32(value))
+					return nil
+				}),
+			huh.NewInput().
+				Title("Max Concurrent Builds Per Project Config").
+				Description("Set to 0 to disable").
+				Value(&maxProjectConfigBuilds).
+				Validate(func(string) error {
+					value, err := strconv.Atoi(maxProjectConfigBuilds)
+					if err != nil || value < 0 {
+						return errors.New("max concurrent builds per project config must be a non-negative integer")
+					}
+					m.config.SetMaxProjectConfigBuilds(int32(value))
+					return nil
+				}),
+			huh.NewInput().
+				Title("Build Timeout").
+				Description("Minutes after which running builds are stopped. Set to 0 to disable").
+				Value(&buildTimeout).
+				Validate(func(string) error {
+					value, err := strconv.Atoi(buildTimeout)
+					if err != nil || value < 0 {
+						return errors.New("build timeout must be a non-negative integer")
+					}
+					m.config.SetBuildTimeout(int32(value))
+					return nil
+				}),
 		),
 		huh.NewGroup(
 			huh.NewInput().
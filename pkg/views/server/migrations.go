@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/db/migrations"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func RenderMigrationStatus(status []migrations.MigrationStatus) {
+	data := [][]string{}
+
+	for _, s := range status {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Id + views_util.AdditionalPropertyPadding),
+			views.DefaultRowDataStyle.Render(getMigrationState(s)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{"Migration", "State"}, nil, func() {
+		renderUnstyledMigrationStatus(status)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledMigrationStatus(status []migrations.MigrationStatus) {
+	output := "\n"
+
+	for _, s := range status {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey(s.Id+": "), getMigrationState(s)) + "\n"
+	}
+
+	fmt.Println(output)
+}
+
+func getMigrationState(s migrations.MigrationStatus) string {
+	if s.Applied {
+		return "Applied"
+	}
+
+	return "Pending"
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/quota"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+// User names are shown for the user IDs of user quotas, user IDs are shown for unknown users
+func ListQuotas(quotas []apiclient.QuotaDTO, userNames map[string]string) {
+	if len(quotas) == 0 {
+		views_util.NotifyEmptyQuotaList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, q := range quotas {
+		row := []string{views.NameStyle.Render(getQuotaName(q)), views.NameStyle.Render(getQuotaUser(q, userNames))}
+		for _, value := range getQuotaValues(q) {
+			row = append(row, views.DefaultRowDataStyle.Render(value))
+		}
+		data = append(data, row)
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"API Key", "User", "Workspaces", "Running Projects", "CPUs", "Memory (MB)", "Disk (GB)",
+	}, nil, func() {
+		renderUnstyledQuotaList(quotas, userNames)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledQuotaList(quotas []apiclient.QuotaDTO, userNames map[string]string) {
+	output := "\n"
+
+	for i, q := range quotas {
+		values := getQuotaValues(q)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key: "), getQuotaName(q)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("User: "), getQuotaUser(q, userNames)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspaces: "), values[0]) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Running Projects: "), values[1]) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("CPUs: "), values[2]) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Memory (MB): "), values[3]) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Disk (GB): "), values[4]) + "\n\n"
+
+		if i < len(quotas)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getQuotaName(q apiclient.QuotaDTO) string {
+	switch q.GetApiKeyName() {
+	case quota.DefaultQuotaName:
+		return "(default)"
+	case "":
+		return "/"
+	}
+
+	return q.GetApiKeyName()
+}
+
+func getQuotaUser(q apiclient.QuotaDTO, userNames map[string]string) string {
+	if q.GetUserId() == "" {
+		return "/"
+	}
+
+	if name, ok := userNames[q.GetUserId()]; ok {
+		return name
+	}
+
+	return q.GetUserId()
+}
+
+// Returns the workspace, running project, CPU, memory and disk limits formatted as "usage / limit"
+func getQuotaValues(q apiclient.QuotaDTO) []string {
+	usage := apiclient.QuotaUsage{}
+	if q.Usage != nil {
+		usage = *q.Usage
+	}
+
+	return []string{
+		formatQuotaValue(q.Usage != nil, fmt.Sprint(usage.Workspaces), q.MaxWorkspaces),
+		formatQuotaValue(q.Usage != nil, fmt.Sprint(usage.RunningProjects), q.MaxRunningProjects),
+		formatQuotaValue(q.Usage != nil, fmt.Sprintf("%g", usage.Cpus), q.MaxCpus),
+		formatQuotaValue(q.Usage != nil, fmt.Sprint(usage.Memory), q.MaxMemory),
+		formatQuotaValue(q.Usage != nil, fmt.Sprint(usage.Disk), q.MaxDisk),
+	}
+}
+
+func formatQuotaValue[T int32 | float32](hasUsage bool, usage string, limit *T) string {
+	formattedLimit := "unlimited"
+	if limit != nil && *limit > 0 {
+		formattedLimit = fmt.Sprint(*limit)
+	}
+
+	if !hasUsage {
+		return formattedLimit
+	}
+
+	return fmt.Sprintf("%s / %s", usage, formattedLimit)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+type rowData struct {
+	Name    string
+	Project string
+	Created string
+}
+
+func ListSnapshots(snapshotList []apiclient.Snapshot) {
+	if len(snapshotList) == 0 {
+		views_util.NotifyEmptySnapshotList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range snapshotList {
+		data = append(data, getRowFromData(s))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Project", "Created",
+	}, nil, func() {
+		renderUnstyledList(snapshotList)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowFromData(s apiclient.Snapshot) []string {
+	var data rowData
+
+	data.Name = s.Name + views_util.AdditionalPropertyPadding
+	data.Project = s.ProjectName
+	data.Created = util.FormatTimestamp(s.CreatedAt)
+
+	return []string{
+		views.NameStyle.Render(data.Name),
+		views.DefaultRowDataStyle.Render(data.Project),
+		views.DefaultRowDataStyle.Render(data.Created),
+	}
+}
+
+func renderUnstyledList(snapshotList []apiclient.Snapshot) {
+	output := "\n"
+
+	for i, s := range snapshotList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), s.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), s.ProjectName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(s.CreatedAt)) + "\n\n"
+
+		if i < len(snapshotList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
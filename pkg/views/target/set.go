@@ -136,11 +136,20 @@ func SetTargetForm(target *TargetView, targetManifest map[string]apiclient.Provi
 		}
 		switch *property.Type {
 		case apiclient.ProviderTargetPropertyTypeInt:
+			// Numeric properties that are left empty are not set
+			if *options[name].(*string) == "" {
+				delete(options, name)
+				continue
+			}
 			options[name], err = strconv.Atoi(*options[name].(*string))
 			if err != nil {
 				return err
 			}
 		case apiclient.ProviderTargetPropertyTypeFloat:
+			if *options[name].(*string) == "" {
+				delete(options, name)
+				continue
+			}
 			options[name], err = strconv.ParseFloat(*options[name].(*string), 64)
 			if err != nil {
 				return err
@@ -173,6 +182,10 @@ func getInput(name string, property apiclient.ProviderProviderTargetProperty, in
 		Description(*property.Description).
 		Value(value).
 		Validate(func(s string) error {
+			if s == "" {
+				return nil
+			}
+
 			switch *property.Type {
 			case apiclient.ProviderTargetPropertyTypeInt:
 				_, err := strconv.Atoi(s)
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListUsers(userList []apiclient.User) {
+	if len(userList) == 0 {
+		views_util.NotifyEmptyUserList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, u := range userList {
+		data = append(data, []string{
+			views.NameStyle.Render(u.Name),
+			views.DefaultRowDataStyle.Render(u.Id),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(u.CreatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "ID", "Created",
+	}, nil, func() {
+		renderUnstyledList(userList)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledList(userList []apiclient.User) {
+	output := "\n"
+
+	for i, u := range userList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), u.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), u.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), util.FormatTimestamp(u.CreatedAt)) + "\n\n"
+
+		if i < len(userList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
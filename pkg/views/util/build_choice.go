@@ -14,6 +14,7 @@ type BuildChoice string
 const (
 	AUTOMATIC    BuildChoice = "auto"
 	DEVCONTAINER BuildChoice = "devcontainer"
+	DOCKERFILE   BuildChoice = "dockerfile"
 	CUSTOMIMAGE  BuildChoice = "custom-image"
 	NONE         BuildChoice = "none"
 )
@@ -38,6 +39,10 @@ func GetProjectBuildChoice(project apiclient.CreateProjectDTO, defaults *Project
 	} else {
 		if project.BuildConfig.Devcontainer != nil {
 			return DEVCONTAINER, "Devcontainer"
+		} else if project.BuildConfig.Dockerfile != nil {
+			return DOCKERFILE, "Dockerfile"
+		} else if project.BuildConfig.Nix != nil {
+			return AUTOMATIC, "Nix"
 		} else {
 			return AUTOMATIC, "Automatic"
 		}
@@ -52,11 +57,11 @@ func (c *BuildChoice) String() string {
 // Set must have pointer receiver so it doesn't change the value of a copy
 func (c *BuildChoice) Set(v string) error {
 	switch v {
-	case string(AUTOMATIC), string(DEVCONTAINER), string(CUSTOMIMAGE), string(NONE):
+	case string(AUTOMATIC), string(DEVCONTAINER), string(DOCKERFILE), string(CUSTOMIMAGE), string(NONE):
 		*c = BuildChoice(v)
 		return nil
 	default:
-		return fmt.Errorf("Build type must be one of %s/%s/%s", AUTOMATIC, DEVCONTAINER, NONE)
+		return fmt.Errorf("Build type must be one of %s/%s/%s/%s", AUTOMATIC, DEVCONTAINER, DOCKERFILE, NONE)
 	}
 }
 
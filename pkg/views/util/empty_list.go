@@ -88,3 +88,59 @@ func NotifyEmptyServerLogList(tip bool) {
 		views.RenderTip("Use 'daytona serve' in order to create server log files")
 	}
 }
+
+func NotifyEmptySnapshotList(tip bool) {
+	views.RenderInfoMessageBold("No snapshots found")
+	if tip {
+		views.RenderTip("Use 'daytona snapshot create' to create a project snapshot")
+	}
+}
+
+func NotifyEmptyAuditLogList(tip bool) {
+	views.RenderInfoMessageBold("No audit logs found")
+	if tip {
+		views.RenderTip("Audit logs are recorded for every POST, PUT, PATCH and DELETE request to the server")
+	}
+}
+
+func NotifyEmptyEventList(tip bool) {
+	views.RenderInfoMessageBold("No recent events found")
+	if tip {
+		views.RenderTip("Use the --follow flag to wait for new events")
+	}
+}
+
+func NotifyEmptyWebhookList(tip bool) {
+	views.RenderInfoMessageBold("No webhooks found")
+	if tip {
+		views.RenderTip("Use 'daytona webhook create' to deliver workspace, project and build events to an HTTP endpoint")
+	}
+}
+
+func NotifyEmptyWebhookDeliveryList(tip bool) {
+	views.RenderInfoMessageBold("No webhook deliveries found")
+	if tip {
+		views.RenderTip("Deliveries are recorded when an event the webhook is subscribed to is published")
+	}
+}
+
+func NotifyEmptyQuotaList(tip bool) {
+	views.RenderInfoMessageBold("No quotas found")
+	if tip {
+		views.RenderTip("Use 'daytona server quota set' to limit the workspaces and resources of client API keys")
+	}
+}
+
+func NotifyEmptyUserList(tip bool) {
+	views.RenderInfoMessageBold("No users found")
+	if tip {
+		views.RenderTip("Use 'daytona user create' to add a user and 'daytona api-key generate --user' to give it access")
+	}
+}
+
+func NotifyEmptySecretList(tip bool) {
+	views.RenderInfoMessageBold("No secrets found")
+	if tip {
+		views.RenderTip("Use 'daytona secret set' to add a secret and reference it in project config env vars as ${secret:NAME}")
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/secret"
+)
+
+// MaskSecret hides the value of a token, password or env var.
+// Secret references such as ${secret:NPM_TOKEN} do not contain the value and are shown as is.
+func MaskSecret(value string) string {
+	if value == "" || secret.IsReference(value) {
+		return value
+	}
+
+	return strings.Repeat("*", 10)
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// Returns a label of the project resource limits or an empty string if no limits are set
+func GetResourcesLabel(resources *apiclient.ProjectResources) string {
+	if resources == nil {
+		return ""
+	}
+
+	limits := []string{}
+
+	if resources.Cpus != nil && *resources.Cpus > 0 {
+		limits = append(limits, fmt.Sprintf("%g CPUs", *resources.Cpus))
+	}
+	if resources.Memory != nil && *resources.Memory > 0 {
+		limits = append(limits, fmt.Sprintf("%d MB memory", *resources.Memory))
+	}
+	if resources.Disk != nil && *resources.Disk > 0 {
+		limits = append(limits, fmt.Sprintf("%d GB disk", *resources.Disk))
+	}
+	if resources.PidsLimit != nil && *resources.PidsLimit > 0 {
+		limits = append(limits, fmt.Sprintf("%d processes", *resources.PidsLimit))
+	}
+
+	return strings.Join(limits, ", ")
+}
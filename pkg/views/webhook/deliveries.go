@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListDeliveries(deliveries []apiclient.WebhookDelivery) {
+	if len(deliveries) == 0 {
+		views_util.NotifyEmptyWebhookDeliveryList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, d := range deliveries {
+		data = append(data, []string{
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(d.CreatedAt)),
+			views.NameStyle.Render(string(d.EventType)),
+			views.DefaultRowDataStyle.Render(d.EventId),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(d.Attempt)),
+			getResult(d),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Time", "Event", "Event ID", "Attempt", "Result",
+	}, nil, func() {
+		renderUnstyledDeliveries(deliveries)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledDeliveries(deliveries []apiclient.WebhookDelivery) {
+	output := "\n"
+
+	for i, d := range deliveries {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Time: "), d.CreatedAt) + "\n\n"
+
+		output += fmt.Sprintf("%s %s (%s)", views.GetPropertyKey("Event: "), d.EventType, d.EventId) + "\n\n"
+
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Attempt: "), d.Attempt) + "\n\n"
+
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Status Code: "), d.StatusCode) + "\n\n"
+
+		if d.Error != nil && *d.Error != "" {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Error: "), *d.Error) + "\n\n"
+		}
+
+		if i < len(deliveries)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getResult(d apiclient.WebhookDelivery) string {
+	if d.Success {
+		return views.ActiveStyle.Render(fmt.Sprintf("delivered (%d)", d.StatusCode))
+	}
+
+	if d.Error != nil && *d.Error != "" {
+		return views.InactiveStyle.Render(*d.Error)
+	}
+
+	return views.InactiveStyle.Render("failed")
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListWebhooks(webhooks []apiclient.Webhook) {
+	if len(webhooks) == 0 {
+		views_util.NotifyEmptyWebhookList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, w := range webhooks {
+		data = append(data, []string{
+			views.NameStyle.Render(w.Id),
+			views.DefaultRowDataStyle.Render(w.Url),
+			views.DefaultRowDataStyle.Render(getEventTypes(w)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(w.CreatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "URL", "Events", "Created",
+	}, nil, func() {
+		renderUnstyledList(webhooks)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledList(webhooks []apiclient.Webhook) {
+	output := "\n"
+
+	for i, w := range webhooks {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), w.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("URL: "), w.Url) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Events: "), getEventTypes(w)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), w.CreatedAt) + "\n\n"
+
+		if i < len(webhooks)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getEventTypes(w apiclient.Webhook) string {
+	if len(w.EventTypes) == 0 {
+		return "all"
+	}
+
+	eventTypes := []string{}
+	for _, e := range w.EventTypes {
+		eventTypes = append(eventTypes, string(e))
+	}
+
+	return strings.Join(eventTypes, ", ")
+}
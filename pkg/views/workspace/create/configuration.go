@@ -21,6 +21,7 @@ import (
 
 const (
 	DEVCONTAINER_FILEPATH = ".devcontainer/devcontainer.json"
+	DOCKERFILE_FILEPATH   = "Dockerfile"
 )
 
 var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("enter: next  f10: advanced configuration")
@@ -28,6 +29,9 @@ var configurationHelpLine = lipgloss.NewStyle().Foreground(views.Gray).Render("e
 type ProjectConfigurationData struct {
 	BuildChoice          string
 	DevcontainerFilePath string
+	DockerfilePath       string
+	DockerfileContext    string
+	DockerfileTarget     string
 	Image                string
 	User                 string
 	EnvVars              map[string]string
@@ -37,6 +41,7 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 	projectConfigurationData := &ProjectConfigurationData{
 		BuildChoice:          string(buildChoice),
 		DevcontainerFilePath: defaults.DevcontainerFilePath,
+		DockerfilePath:       DOCKERFILE_FILEPATH,
 		Image:                *defaults.Image,
 		User:                 *defaults.ImageUser,
 		EnvVars:              map[string]string{},
@@ -54,6 +59,12 @@ func NewConfigurationData(buildChoice views_util.BuildChoice, devContainerFilePa
 		projectConfigurationData.EnvVars = currentProject.EnvVars
 	}
 
+	if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+		projectConfigurationData.DockerfilePath = currentProject.BuildConfig.Dockerfile.Path
+		projectConfigurationData.DockerfileContext = currentProject.BuildConfig.Dockerfile.GetContext()
+		projectConfigurationData.DockerfileTarget = currentProject.BuildConfig.Dockerfile.GetTarget()
+	}
+
 	return projectConfigurationData
 }
 
@@ -80,6 +91,8 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 		if currentProject.BuildConfig.Devcontainer != nil {
 			builderChoice = views_util.DEVCONTAINER
 			devContainerFilePath = currentProject.BuildConfig.Devcontainer.FilePath
+		} else if currentProject.BuildConfig.Dockerfile != nil {
+			builderChoice = views_util.DOCKERFILE
 		}
 	} else {
 		if currentProject.Image == nil && currentProject.User == nil ||
@@ -130,6 +143,26 @@ func RunProjectConfiguration(projectList *[]apiclient.CreateProjectDTO, defaults
 				(*projectList)[i].User = nil
 			}
 
+			if projectConfigurationData.BuildChoice == string(views_util.DOCKERFILE) {
+				dockerfileConfig := &apiclient.DockerfileConfig{
+					Path: projectConfigurationData.DockerfilePath,
+				}
+				if currentProject.BuildConfig != nil && currentProject.BuildConfig.Dockerfile != nil {
+					dockerfileConfig.BuildArgs = currentProject.BuildConfig.Dockerfile.BuildArgs
+				}
+				if projectConfigurationData.DockerfileContext != "" {
+					dockerfileConfig.Context = &projectConfigurationData.DockerfileContext
+				}
+				if projectConfigurationData.DockerfileTarget != "" {
+					dockerfileConfig.Target = &projectConfigurationData.DockerfileTarget
+				}
+				(*projectList)[i].BuildConfig = &apiclient.BuildConfig{
+					Dockerfile: dockerfileConfig,
+				}
+				(*projectList)[i].Image = nil
+				(*projectList)[i].User = nil
+			}
+
 			(*projectList)[i].EnvVars = projectConfigurationData.EnvVars
 		}
 	}
@@ -149,10 +182,18 @@ func validateDevcontainerFilename(filename string) error {
 	return nil
 }
 
+func validateDockerfilePath(filePath string) error {
+	if filePath == "" {
+		return errors.New("dockerfile path can not be blank")
+	}
+	return nil
+}
+
 func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData) *huh.Form {
 	buildOptions := []huh.Option[string]{
 		{Key: "Automatic", Value: string(views_util.AUTOMATIC)},
 		{Key: "Devcontainer", Value: string(views_util.DEVCONTAINER)},
+		{Key: "Dockerfile", Value: string(views_util.DOCKERFILE)},
 		{Key: "Custom image", Value: string(views_util.CUSTOMIMAGE)},
 		{Key: "None", Value: string(views_util.NONE)},
 	}
@@ -183,6 +224,21 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		).WithHeight(5).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.DEVCONTAINER)
 		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Dockerfile path").
+				Value(&projectConfiguration.DockerfilePath).Validate(validateDockerfilePath),
+			huh.NewInput().
+				Title("Build context").
+				Description("Leave blank to use the project root").
+				Value(&projectConfiguration.DockerfileContext),
+			huh.NewInput().
+				Title("Build target").
+				Description("Leave blank to build the last stage").
+				Value(&projectConfiguration.DockerfileTarget),
+		).WithHeight(10).WithHideFunc(func() bool {
+			return projectConfiguration.BuildChoice != string(views_util.DOCKERFILE)
+		}),
 		huh.NewGroup(
 			views.GetEnvVarsInput(&projectConfiguration.EnvVars),
 		).WithHeight(12),
@@ -23,6 +23,7 @@ type ProjectDetail string
 const (
 	Build              ProjectDetail = "Build"
 	DevcontainerConfig ProjectDetail = "Devcontainer Config"
+	Dockerfile         ProjectDetail = "Dockerfile"
 	Image              ProjectDetail = "Image"
 	User               ProjectDetail = "User"
 	EnvVars            ProjectDetail = "Env Vars"
@@ -122,6 +123,11 @@ func renderProjectDetails(project apiclient.CreateProjectDTO, buildChoice views_
 				output += projectDetailOutput(DevcontainerConfig, project.BuildConfig.Devcontainer.FilePath)
 			}
 		}
+	} else if buildChoice == views_util.DOCKERFILE {
+		if project.BuildConfig != nil && project.BuildConfig.Dockerfile != nil {
+			output += "\n"
+			output += projectDetailOutput(Dockerfile, project.BuildConfig.Dockerfile.Path)
+		}
 	} else {
 		if project.Image != nil {
 			if output != "" {
@@ -145,7 +151,7 @@ func renderProjectDetails(project apiclient.CreateProjectDTO, buildChoice views_
 
 		var envVars string
 		for key, val := range project.EnvVars {
-			envVars += fmt.Sprintf("%s=%s; ", key, val)
+			envVars += fmt.Sprintf("%s=%s; ", key, views_util.MaskSecret(val))
 		}
 		output += projectDetailOutput(EnvVars, strings.TrimSuffix(envVars, "; "))
 	}
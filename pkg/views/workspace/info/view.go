@@ -11,6 +11,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"golang.org/x/term"
 )
 
@@ -106,6 +107,10 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	}
 	output += getInfoLine("Repository", repositoryUrl)
 
+	if resources := views_util.GetResourcesLabel(project.Resources); resources != "" && !isCreationView {
+		output += getInfoLine("Resources", resources)
+	}
+
 	if !isCreationView {
 		output += "\n"
 		output += getInfoLine("Project", project.Name)
@@ -128,6 +133,9 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 			output += getInfoLine("Target", project.Target)
 		}
 		output += getInfoLine("Repository", project.Repository.Url)
+		if resources := views_util.GetResourcesLabel(project.Resources); resources != "" && !isCreationView {
+			output += getInfoLine("Resources", resources)
+		}
 		if project.Name != projects[len(projects)-1].Name {
 			output += "\n"
 		}
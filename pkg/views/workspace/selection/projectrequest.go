@@ -25,8 +25,8 @@ var DoneConfiguring = apiclient.CreateProjectDTO{
 
 type projectRequestItem struct {
 	item[apiclient.CreateProjectDTO]
-	name, image, user, devcontainerConfig string
-	project                               apiclient.CreateProjectDTO
+	name, image, user, buildConfig string
+	project                        apiclient.CreateProjectDTO
 }
 
 type projectRequestItemDelegate struct {
@@ -43,7 +43,7 @@ func selectProjectRequestPrompt(projects *[]apiclient.CreateProjectDTO, choiceCh
 		var name string
 		var image string
 		var user string
-		var devcontainerConfig string
+		var buildConfig string
 
 		name = fmt.Sprintf("%s %s", "Project:", project.Name)
 		if project.Image != nil {
@@ -53,10 +53,13 @@ func selectProjectRequestPrompt(projects *[]apiclient.CreateProjectDTO, choiceCh
 			user = fmt.Sprintf("%s %s", "User:", *project.User)
 		}
 		if project.BuildConfig != nil && project.BuildConfig.Devcontainer != nil {
-			devcontainerConfig = fmt.Sprintf("%s %s", "Devcontainer Config:", project.BuildConfig.Devcontainer.FilePath)
+			buildConfig = fmt.Sprintf("%s %s", "Devcontainer Config:", project.BuildConfig.Devcontainer.FilePath)
+		}
+		if project.BuildConfig != nil && project.BuildConfig.Dockerfile != nil {
+			buildConfig = fmt.Sprintf("%s %s", "Dockerfile:", project.BuildConfig.Dockerfile.Path)
 		}
 
-		newItem := projectRequestItem{name: name, image: image, user: user, project: project, devcontainerConfig: devcontainerConfig}
+		newItem := projectRequestItem{name: name, image: image, user: user, project: project, buildConfig: buildConfig}
 
 		newItem.SetId(name)
 
@@ -141,13 +144,13 @@ func (d projectRequestItemDelegate) Render(w io.Writer, m list.Model, index int,
 
 	name := baseStyles.Render(i.Name())
 	imageLine := baseStyles.Render(i.Image())
-	devcontainerConfigLine := baseStyles.Render(i.DevcontainerConfig())
+	buildConfigLine := baseStyles.Render(i.BuildConfig())
 	userLine := baseStyles.Foreground(views.Gray).Render(i.User())
 
 	// Adjust styles as the user moves through the menu
 	if isSelected {
 		name = selectedStyles.Foreground(views.Green).Render(i.Name())
-		devcontainerConfigLine = selectedStyles.Foreground(views.DimmedGreen).Render(i.DevcontainerConfig())
+		buildConfigLine = selectedStyles.Foreground(views.DimmedGreen).Render(i.BuildConfig())
 		imageLine = selectedStyles.Foreground(views.DimmedGreen).Render(i.Image())
 		userLine = selectedStyles.Foreground(views.Gray).Render(i.User())
 	}
@@ -164,8 +167,8 @@ func (d projectRequestItemDelegate) Render(w io.Writer, m list.Model, index int,
 	} else {
 		s.WriteString(name)
 		s.WriteRune('\n')
-		if i.DevcontainerConfig() != "" {
-			s.WriteString(devcontainerConfigLine)
+		if i.BuildConfig() != "" {
+			s.WriteString(buildConfigLine)
 		} else {
 			s.WriteString(imageLine)
 		}
@@ -182,8 +185,8 @@ func (d projectRequestItemDelegate) Height() int {
 	return height
 }
 
-func (i projectRequestItem) Name() string               { return i.name }
-func (i projectRequestItem) Image() string              { return i.image }
-func (i projectRequestItem) User() string               { return i.user }
-func (i projectRequestItem) DevcontainerConfig() string { return i.devcontainerConfig }
-func (i projectRequestItem) SetId(id string)            { i.id = id }
+func (i projectRequestItem) Name() string        { return i.name }
+func (i projectRequestItem) Image() string       { return i.image }
+func (i projectRequestItem) User() string        { return i.user }
+func (i projectRequestItem) BuildConfig() string { return i.buildConfig }
+func (i projectRequestItem) SetId(id string)     { i.id = id }
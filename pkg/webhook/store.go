@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import "errors"
+
+type Store interface {
+	List() ([]*Webhook, error)
+	Find(id string) (*Webhook, error)
+	Save(webhook *Webhook) error
+	Delete(webhook *Webhook) error
+}
+
+// DeliveryStore is append-only, deliveries are only removed together with their webhook
+type DeliveryStore interface {
+	List(filter *DeliveryFilter) ([]*Delivery, error)
+	Save(delivery *Delivery) error
+	DeleteForWebhook(webhookId string) error
+}
+
+type DeliveryFilter struct {
+	WebhookId *string
+	Limit     *int
+}
+
+var (
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+func IsWebhookNotFound(err error) bool {
+	return err.Error() == ErrWebhookNotFound.Error()
+}
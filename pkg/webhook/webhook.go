@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/events"
+)
+
+const (
+	SignatureHeader = "X-Daytona-Signature"
+	EventHeader     = "X-Daytona-Event"
+	DeliveryHeader  = "X-Daytona-Delivery"
+)
+
+type Webhook struct {
+	Id  string `json:"id" validate:"required"`
+	Url string `json:"url" validate:"required"`
+	// The secret is only used to sign payloads and is never returned by the API
+	Secret string `json:"-"`
+	// Empty list subscribes to all event types
+	EventTypes []events.EventType `json:"eventTypes" validate:"required"`
+	CreatedAt  time.Time          `json:"createdAt" validate:"required"`
+} // @name Webhook
+
+func (w *Webhook) Matches(eventType events.EventType) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+// Delivery records a single attempt to deliver an event to a webhook
+type Delivery struct {
+	Id        string           `json:"id" validate:"required"`
+	WebhookId string           `json:"webhookId" validate:"required"`
+	EventId   string           `json:"eventId" validate:"required"`
+	EventType events.EventType `json:"eventType" validate:"required"`
+	Attempt   int              `json:"attempt" validate:"required"`
+	// Status code of the response, 0 if no response was received
+	StatusCode int    `json:"statusCode" validate:"required"`
+	Success    bool   `json:"success" validate:"required"`
+	Error      string `json:"error" validate:"optional"`
+	// Request duration in milliseconds
+	Duration  int64     `json:"duration" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name WebhookDelivery
+
+// Sign returns the value of the signature header for the payload.
+// Receivers should compute the HMAC-SHA256 of the raw request body with the webhook secret and compare.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
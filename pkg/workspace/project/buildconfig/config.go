@@ -5,6 +5,8 @@ package buildconfig
 
 type BuildConfig struct {
 	Devcontainer *DevcontainerConfig `json:"devcontainer,omitempty" validate:"optional"`
+	Dockerfile   *DockerfileConfig   `json:"dockerfile,omitempty" validate:"optional"`
+	Nix          *NixConfig          `json:"nix,omitempty" validate:"optional"`
 	CachedBuild  *CachedBuild        `json:"cachedBuild,omitempty" validate:"optional"`
 } // @name BuildConfig
 
@@ -12,6 +14,21 @@ type DevcontainerConfig struct {
 	FilePath string `json:"filePath" validate:"required"`
 } // @name DevcontainerConfig
 
+// Paths are relative to the project root. The build context defaults to the project root.
+type DockerfileConfig struct {
+	Path      string            `json:"path" validate:"required"`
+	Context   string            `json:"context,omitempty" validate:"optional"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty" validate:"optional"`
+	Target    string            `json:"target,omitempty" validate:"optional"`
+} // @name DockerfileConfig
+
+// FilePath points to either a flake.nix or a shell.nix file relative to the project root.
+// DevShell selects a dev shell of the flake and defaults to the default dev shell.
+type NixConfig struct {
+	FilePath string `json:"filePath" validate:"required"`
+	DevShell string `json:"devShell,omitempty" validate:"optional"`
+} // @name NixConfig
+
 type CachedBuild struct {
 	User  string `json:"user" validate:"required"`
 	Image string `json:"image" validate:"required"`
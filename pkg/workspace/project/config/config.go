@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 )
 
 type ProjectConfig struct {
@@ -19,15 +20,22 @@ type ProjectConfig struct {
 	IsDefault           bool                     `json:"default" validate:"required"`
 	Prebuilds           []*PrebuildConfig        `json:"prebuilds" validate:"optional"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Resources           *resources.Resources     `json:"resources,omitempty" validate:"optional"`
+	// ID of the user that owns the project config
+	OwnerId string `json:"ownerId,omitempty" validate:"optional"`
 } // @name ProjectConfig
 
 func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
 	newPrebuild := PrebuildConfig{
-		Id:             p.Id,
-		Branch:         p.Branch,
-		CommitInterval: p.CommitInterval,
-		TriggerFiles:   p.TriggerFiles,
-		Retention:      p.Retention,
+		Id:               p.Id,
+		Branch:           p.Branch,
+		CommitInterval:   p.CommitInterval,
+		TriggerFiles:     p.TriggerFiles,
+		Retention:        p.Retention,
+		PullRequests:     p.PullRequests,
+		ReviewWorkspace:  p.ReviewWorkspace,
+		ForkPullRequests: p.ForkPullRequests,
+		BuildTimeout:     p.BuildTimeout,
 	}
 
 	for _, pb := range pc.Prebuilds {
@@ -17,6 +17,14 @@ type PrebuildConfig struct {
 	CommitInterval *int     `json:"commitInterval" validate:"required"`
 	TriggerFiles   []string `json:"triggerFiles" validate:"required"`
 	Retention      int      `json:"retention" validate:"required"`
+	// Targets pull requests instead of pushes - Branch then holds the base branch and an empty Branch matches all pull requests
+	PullRequests bool `json:"pullRequests" validate:"optional"`
+	// Creates a workspace for each matching pull request and removes it once the pull request is closed
+	ReviewWorkspace bool `json:"reviewWorkspace" validate:"optional"`
+	// Also targets pull requests from forks. Their code is not trusted and is built and run with the configuration of the project config
+	ForkPullRequests bool `json:"forkPullRequests" validate:"optional"`
+	// Minutes after which builds of the prebuild are stopped - overrides the server build timeout
+	BuildTimeout *int `json:"buildTimeout,omitempty" validate:"optional"`
 } // @name PrebuildConfig
 
 func (p *PrebuildConfig) GenerateId() error {
@@ -36,6 +44,10 @@ func (p *PrebuildConfig) Match(filter *PrebuildFilter) bool {
 		return false
 	}
 
+	if filter.PullRequests != nil && *filter.PullRequests != p.PullRequests {
+		return false
+	}
+
 	if filter.CommitInterval != nil && p.CommitInterval != nil && *filter.CommitInterval != *p.CommitInterval {
 		return false
 	}
@@ -59,3 +71,13 @@ func (p *PrebuildConfig) Match(filter *PrebuildFilter) bool {
 
 	return true
 }
+
+// Returns true if the prebuild targets pull requests into the given base branch.
+// Pull requests from forks only match prebuilds that opt in to them.
+func (p *PrebuildConfig) MatchesPullRequest(baseBranch string, fromFork bool) bool {
+	if fromFork && !p.ForkPullRequests {
+		return false
+	}
+
+	return p.PullRequests && (p.Branch == "" || p.Branch == baseBranch)
+}
@@ -17,6 +17,7 @@ type PrebuildFilter struct {
 	ProjectConfigName *string
 	Id                *string
 	Branch            *string
+	PullRequests      *bool
 	CommitInterval    *int
 	TriggerFiles      *[]string
 }
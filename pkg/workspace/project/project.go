@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/resources"
 )
 
 type Project struct {
@@ -23,6 +24,7 @@ type Project struct {
 	Target              string                     `json:"target" validate:"required"`
 	State               *ProjectState              `json:"state,omitempty" validate:"optional"`
 	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty" validate:"optional"`
+	Resources           *resources.Resources       `json:"resources,omitempty" validate:"optional"`
 } // @name Project
 
 type ProjectInfo struct {
@@ -34,9 +36,11 @@ type ProjectInfo struct {
 } // @name ProjectInfo
 
 type ProjectState struct {
-	UpdatedAt string     `json:"updatedAt" validate:"required"`
-	Uptime    uint64     `json:"uptime" validate:"required"`
-	GitStatus *GitStatus `json:"gitStatus" validate:"optional"`
+	UpdatedAt      string     `json:"updatedAt" validate:"required"`
+	Uptime         uint64     `json:"uptime" validate:"required"`
+	GitStatus      *GitStatus `json:"gitStatus" validate:"optional"`
+	ActiveSessions uint32     `json:"activeSessions" validate:"optional"`
+	LastActivityAt string     `json:"lastActivityAt,omitempty" validate:"optional"`
 } // @name ProjectState
 
 type GitStatus struct {
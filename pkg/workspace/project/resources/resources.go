@@ -0,0 +1,87 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Resource limits of a project container. Zero values are not limited.
+type Resources struct {
+	// Number of CPU cores, fractional values limit the container to a share of a core
+	Cpus float64 `json:"cpus,omitempty" validate:"optional"`
+	// Memory limit in MB
+	Memory int `json:"memory,omitempty" validate:"optional"`
+	// Disk quota in GB
+	Disk int `json:"disk,omitempty" validate:"optional"`
+	// Maximum number of processes in the container
+	PidsLimit int `json:"pidsLimit,omitempty" validate:"optional"`
+} // @name ProjectResources
+
+func (r *Resources) IsEmpty() bool {
+	return r == nil || *r == Resources{}
+}
+
+// Returns a copy of the resources with the unset values taken from the defaults.
+// Returns nil if neither the resources nor the defaults set any value.
+func (r *Resources) WithDefaults(defaults *Resources) *Resources {
+	result := Resources{}
+	if r != nil {
+		result = *r
+	}
+
+	if defaults != nil {
+		if result.Cpus == 0 {
+			result.Cpus = defaults.Cpus
+		}
+		if result.Memory == 0 {
+			result.Memory = defaults.Memory
+		}
+		if result.Disk == 0 {
+			result.Disk = defaults.Disk
+		}
+		if result.PidsLimit == 0 {
+			result.PidsLimit = defaults.PidsLimit
+		}
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+
+	return &result
+}
+
+// Validates that the resources are not negative and do not exceed the set maxima.
+// Unset resources exceed any set maximum since they are not limited.
+func (r *Resources) Validate(maxima *Resources) error {
+	resources := Resources{}
+	if r != nil {
+		resources = *r
+	}
+
+	if resources.Cpus < 0 || resources.Memory < 0 || resources.Disk < 0 || resources.PidsLimit < 0 {
+		return errors.New("resources must not be negative")
+	}
+
+	if maxima == nil {
+		return nil
+	}
+
+	if maxima.Cpus > 0 && (resources.Cpus == 0 || resources.Cpus > maxima.Cpus) {
+		return fmt.Errorf("cpus must be set to at most %g", maxima.Cpus)
+	}
+	if maxima.Memory > 0 && (resources.Memory == 0 || resources.Memory > maxima.Memory) {
+		return fmt.Errorf("memory must be set to at most %d MB", maxima.Memory)
+	}
+	if maxima.Disk > 0 && (resources.Disk == 0 || resources.Disk > maxima.Disk) {
+		return fmt.Errorf("disk must be set to at most %d GB", maxima.Disk)
+	}
+	if maxima.PidsLimit > 0 && (resources.PidsLimit == 0 || resources.PidsLimit > maxima.PidsLimit) {
+		return fmt.Errorf("pids limit must be set to at most %d", maxima.PidsLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDefaults(t *testing.T) {
+	var unset *Resources
+	require.Nil(t, unset.WithDefaults(nil))
+	require.Nil(t, unset.WithDefaults(&Resources{}))
+
+	require.Equal(t, &Resources{Cpus: 4, Memory: 1024}, unset.WithDefaults(&Resources{Cpus: 4, Memory: 1024}))
+
+	r := &Resources{Cpus: 2}
+	require.Equal(t, &Resources{Cpus: 2, Memory: 1024, PidsLimit: 100}, r.WithDefaults(&Resources{Cpus: 4, Memory: 1024, PidsLimit: 100}))
+	require.Equal(t, &Resources{Cpus: 2}, r)
+}
+
+func TestValidate(t *testing.T) {
+	var unset *Resources
+	require.Nil(t, unset.Validate(nil))
+	require.NotNil(t, (&Resources{Memory: -1}).Validate(nil))
+
+	maxima := &Resources{Cpus: 4, Memory: 4096}
+	require.Nil(t, (&Resources{Cpus: 4, Memory: 2048, Disk: 100}).Validate(maxima))
+	require.NotNil(t, (&Resources{Cpus: 8, Memory: 2048}).Validate(maxima))
+	require.NotNil(t, (&Resources{Cpus: 2, Memory: 8192}).Validate(maxima))
+	require.NotNil(t, unset.Validate(maxima))
+}
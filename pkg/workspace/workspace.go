@@ -10,12 +10,17 @@ import (
 )
 
 type Workspace struct {
-	Id       string             `json:"id" validate:"required"`
-	Name     string             `json:"name" validate:"required"`
-	Projects []*project.Project `json:"projects" validate:"required"`
-	Target   string             `json:"target" validate:"required"`
-	ApiKey   string             `json:"-"`
-	EnvVars  map[string]string  `json:"-"`
+	Id          string             `json:"id" validate:"required"`
+	Name        string             `json:"name" validate:"required"`
+	Projects    []*project.Project `json:"projects" validate:"required"`
+	Target      string             `json:"target" validate:"required"`
+	IdleTimeout *int               `json:"idleTimeout,omitempty" validate:"optional"`
+	// ID of the user that owns the workspace
+	OwnerId string `json:"ownerId,omitempty" validate:"optional"`
+	// Name of the client API key that created the workspace
+	CreatedBy string            `json:"createdBy,omitempty" validate:"optional"`
+	ApiKey    string            `json:"-"`
+	EnvVars   map[string]string `json:"-"`
 } // @name Workspace
 
 type WorkspaceInfo struct {